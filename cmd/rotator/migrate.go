@@ -0,0 +1,64 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"github.com/ekhvalov/otus-banners-rotation/internal/app"
+	"github.com/ekhvalov/otus-banners-rotation/internal/environment/config"
+	"github.com/ekhvalov/otus-banners-rotation/internal/environment/idgenerator"
+	"github.com/ekhvalov/otus-banners-rotation/internal/environment/storage/postgres"
+	"github.com/hashicorp/go-multierror"
+	"github.com/spf13/cobra"
+)
+
+var migrateCmd = &cobra.Command{
+	Use:   "migrate",
+	Short: "Apply the migrations of the postgres storage",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return migrate()
+	},
+}
+
+func init() {
+	rotatorCmd.AddCommand(migrateCmd)
+}
+
+func migrate() (err error) {
+	v, err := config.NewViper(cfgFile, configEnvPrefix, config.DefaultEnvKeyReplacer)
+	if err != nil {
+		return fmt.Errorf("create viper error: %w", err)
+	}
+	if driver := v.GetString("storage.driver"); driver != storageDriverPostgres {
+		return fmt.Errorf("storage driver '%s' has no migrations", driver)
+	}
+	// The schema is migrated only, so the delete mode and the clock are not used.
+	storage, err := postgres.NewPostgres(
+		postgres.NewConfig(v), idgenerator.NewUUID(), app.DeleteModeCascade, app.NewSystemClock(),
+	)
+	if err != nil {
+		return fmt.Errorf("create storage error: %w", err)
+	}
+	defer func() {
+		if closeErr := storage.Close(); closeErr != nil {
+			err = multierror.Append(err, closeErr)
+		}
+	}()
+
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM, syscall.SIGHUP)
+	defer cancel()
+
+	applied, err := storage.Migrate(ctx)
+	if err != nil {
+		return err
+	}
+	if len(applied) == 0 {
+		fmt.Println("No migrations to apply")
+	} else {
+		fmt.Println("Applied migrations:", strings.Join(applied, ", "))
+	}
+	return nil
+}
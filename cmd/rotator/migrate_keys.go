@@ -0,0 +1,60 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os/signal"
+	"syscall"
+
+	"github.com/ekhvalov/otus-banners-rotation/internal/app"
+	"github.com/ekhvalov/otus-banners-rotation/internal/environment/config"
+	"github.com/ekhvalov/otus-banners-rotation/internal/environment/idgenerator"
+	"github.com/ekhvalov/otus-banners-rotation/internal/environment/storage/redis"
+	"github.com/hashicorp/go-multierror"
+	"github.com/spf13/cobra"
+)
+
+var (
+	fromKeyPrefix  string
+	migrateKeysCmd = &cobra.Command{
+		Use:   "migrate-keys",
+		Short: "Rename the keys of the redis storage into the redis.key_prefix namespace",
+		Long: "Rename the keys of the redis storage with the --from-prefix prefix (no prefix by default) " +
+			"into the redis.key_prefix namespace. The rotator instances using the keys should be stopped.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return migrateKeys()
+		},
+	}
+)
+
+func init() {
+	migrateKeysCmd.Flags().StringVar(&fromKeyPrefix, "from-prefix", "", "Current prefix of the keys")
+	rotatorCmd.AddCommand(migrateKeysCmd)
+}
+
+func migrateKeys() (err error) {
+	v, err := config.NewViper(cfgFile, configEnvPrefix, config.DefaultEnvKeyReplacer)
+	if err != nil {
+		return fmt.Errorf("create viper error: %w", err)
+	}
+	if driver := v.GetString("storage.driver"); driver != "" && driver != storageDriverRedis {
+		return fmt.Errorf("storage driver '%s' has no keys", driver)
+	}
+	// The keys are renamed only, so the delete mode and the clock are not used.
+	storage, err := redis.NewRedis(redis.NewConfig(v), idgenerator.NewUUID(), app.DeleteModeCascade, app.NewSystemClock())
+	if err != nil {
+		return fmt.Errorf("create storage error: %w", err)
+	}
+	defer func() {
+		if closeErr := storage.Close(); closeErr != nil {
+			err = multierror.Append(err, closeErr)
+		}
+	}()
+
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM, syscall.SIGHUP)
+	defer cancel()
+
+	renamed, err := storage.RenameKeys(ctx, fromKeyPrefix)
+	fmt.Println("Renamed keys:", renamed)
+	return err
+}
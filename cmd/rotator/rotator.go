@@ -3,16 +3,22 @@ package main
 import (
 	"context"
 	"fmt"
+	"io"
 	"os"
 	"os/signal"
 	"syscall"
 	"time"
 
 	"github.com/ekhvalov/otus-banners-rotation/internal/app"
+	"github.com/ekhvalov/otus-banners-rotation/internal/app/strategy"
 	"github.com/ekhvalov/otus-banners-rotation/internal/environment/config"
+	"github.com/ekhvalov/otus-banners-rotation/internal/environment/idgenerator"
 	"github.com/ekhvalov/otus-banners-rotation/internal/environment/logger"
 	"github.com/ekhvalov/otus-banners-rotation/internal/environment/queue/rabbitmq"
 	internalgrpc "github.com/ekhvalov/otus-banners-rotation/internal/environment/server/grpc"
+	"github.com/ekhvalov/otus-banners-rotation/internal/environment/storage/bolt"
+	"github.com/ekhvalov/otus-banners-rotation/internal/environment/storage/memory"
+	"github.com/ekhvalov/otus-banners-rotation/internal/environment/storage/postgres"
 	"github.com/ekhvalov/otus-banners-rotation/internal/environment/storage/redis"
 	"github.com/hashicorp/go-multierror"
 	"github.com/spf13/cobra"
@@ -31,47 +37,124 @@ var (
 
 const configEnvPrefix = "rotator"
 
+const (
+	storageDriverRedis    = "redis"
+	storageDriverMemory   = "memory"
+	storageDriverPostgres = "postgres"
+	storageDriverBolt     = "bolt"
+)
+
 func init() {
 	rotatorCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "Path to config file")
 }
 
-func run() error {
-	var err error
+func run() (err error) {
 	v, err := config.NewViper(cfgFile, configEnvPrefix, config.DefaultEnvKeyReplacer)
 	if err != nil {
 		return fmt.Errorf("create viper error: %w", err)
 	}
 
-	storage := createStorage(v)
-	queue := createEventQueue(v)
 	logg := createLogger(v)
-	rotator := app.NewRotator(storage, queue, logg)
+	// The storage and the rotator share the clock, so the stats intervals of the counters are the same.
+	clock := app.NewSystemClock()
+	storage, err := createStorage(v, logg, clock)
+	if err != nil {
+		return fmt.Errorf("create storage error: %w", err)
+	}
+	// The storage is closed after the server is shut down, so the in-flight requests can use it.
+	if closer, ok := storage.(io.Closer); ok {
+		defer func() {
+			if closeErr := closer.Close(); closeErr != nil {
+				err = multierror.Append(err, closeErr)
+			}
+		}()
+	}
+	strategies, err := createStrategyFactory(v)
+	if err != nil {
+		return fmt.Errorf("create strategy factory error: %w", err)
+	}
+	queue := createEventQueue(v)
+	rotator := app.NewRotator(storage, strategies, queue, logg, createRandom(v), clock)
 	server := internalgrpc.NewServer(internalgrpc.NewConfig(v), rotator, logg)
 
 	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM, syscall.SIGHUP)
 	defer cancel()
 
+	shutdownErrs := make(chan error, 1)
 	go func() {
 		<-ctx.Done()
 
 		shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), time.Second*3)
 		defer shutdownCancel()
 
-		if grpcErr := server.Shutdown(shutdownCtx); grpcErr != nil {
-			err = multierror.Append(err, grpcErr)
-		}
+		shutdownErrs <- server.Shutdown(shutdownCtx)
 	}()
 
 	if serveErr := server.ListenAndServe(); serveErr != nil {
-		err = multierror.Append(err, serveErr)
+		return multierror.Append(err, serveErr)
+	}
+	// The serving stops before the in-flight requests are done, so the shutdown is waited for.
+	if grpcErr := <-shutdownErrs; grpcErr != nil {
+		err = multierror.Append(err, grpcErr)
 	}
 
 	return err
 }
 
-func createStorage(v *viper.Viper) app.Storage {
+// createRandom creates a random source with the `rotator.random_seed` seed or with a time based seed if it is not set.
+func createRandom(v *viper.Viper) app.Random {
+	if v.IsSet("rotator.random_seed") {
+		return app.NewRandom(v.GetInt64("rotator.random_seed"))
+	}
+	return app.NewRandom(time.Now().UnixNano())
+}
+
+// createStorage creates a storage by the `storage.driver` config key, the redis storage is used by default.
+// The `storage.delete_mode` config key is validated here, so it is the storage wide delete mode of every driver.
+func createStorage(v *viper.Viper, logg app.Logger, clock app.Clock) (app.Storage, error) {
+	deleteMode, err := app.ParseDeleteMode(v.GetString("storage.delete_mode"))
+	if err != nil {
+		return nil, err
+	}
+	switch driver := v.GetString("storage.driver"); driver {
+	case "", storageDriverRedis:
+		return createRedisStorage(v, logg, deleteMode, clock)
+	case storageDriverMemory:
+		return memory.NewMemory(idgenerator.NewUUID(), deleteMode, clock), nil
+	case storageDriverPostgres:
+		return postgres.NewPostgres(postgres.NewConfig(v), idgenerator.NewUUID(), deleteMode, clock)
+	case storageDriverBolt:
+		return bolt.NewBolt(bolt.NewConfig(v), idgenerator.NewUUID(), deleteMode, clock)
+	default:
+		return nil, fmt.Errorf("unknown storage driver '%s'", driver)
+	}
+}
+
+func createRedisStorage(
+	v *viper.Viper, logg app.Logger, deleteMode app.DeleteMode, clock app.Clock,
+) (app.Storage, error) {
 	cfg := redis.NewConfig(v)
-	return redis.NewRedis(cfg, redis.NewUUIDGenerator())
+	storage, err := redis.NewRedis(cfg, idgenerator.NewUUID(), deleteMode, clock)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*3)
+	defer cancel()
+	// The scripts are loaded on their first run if the loading fails.
+	if err = storage.LoadScripts(ctx); err != nil {
+		logg.Warn(fmt.Sprintf("load redis scripts error: %v", err))
+	}
+	return storage, nil
+}
+
+func createStrategyFactory(v *viper.Viper) (app.StrategyFactory, error) {
+	cfg := strategy.NewConfig(v)
+	factory := strategy.NewFactory(cfg)
+	// Check the default strategy params, they are used by every slot without own params.
+	if _, _, err := factory.CreateStrategy(app.StrategyParams{}); err != nil {
+		return nil, err
+	}
+	return factory, nil
 }
 
 func createEventQueue(v *viper.Viper) app.EventQueue {
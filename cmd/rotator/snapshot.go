@@ -0,0 +1,160 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/ekhvalov/otus-banners-rotation/internal/app"
+	"github.com/ekhvalov/otus-banners-rotation/internal/environment/config"
+	"github.com/ekhvalov/otus-banners-rotation/internal/environment/logger"
+	"github.com/ekhvalov/otus-banners-rotation/internal/environment/snapshot"
+	"github.com/hashicorp/go-multierror"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var (
+	snapshotOutput string
+	snapshotInput  string
+	snapshotMerge  bool
+	exportCmd      = &cobra.Command{
+		Use:   "export",
+		Short: "Export the state of the storage into a snapshot file",
+		Long: "Export the banners, slots, social groups, attachments, counters and linear stats of the storage " +
+			"into a versioned JSON file. The snapshot is written to the standard output by default.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return exportSnapshot()
+		},
+	}
+	importCmd = &cobra.Command{
+		Use:   "import",
+		Short: "Import a snapshot file into the storage",
+		Long: "Import a snapshot written by the export command into the storage. The storage should be empty, " +
+			"the --merge flag adds the snapshot to a non-empty storage: the entities with the same ids are " +
+			"replaced and the counters are added to the existing ones.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return importSnapshot()
+		},
+	}
+)
+
+func init() {
+	exportCmd.Flags().StringVar(&snapshotOutput, "output", "-", "Path to the snapshot file, '-' for the standard output")
+	importCmd.Flags().StringVar(&snapshotInput, "input", "-", "Path to the snapshot file, '-' for the standard input")
+	importCmd.Flags().BoolVar(&snapshotMerge, "merge", false, "Import the snapshot into a non-empty storage")
+	rotatorCmd.AddCommand(exportCmd, importCmd)
+}
+
+func exportSnapshot() error {
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM, syscall.SIGHUP)
+	defer cancel()
+	v, err := config.NewViper(cfgFile, configEnvPrefix, config.DefaultEnvKeyReplacer)
+	if err != nil {
+		return fmt.Errorf("create viper error: %w", err)
+	}
+	return withStorage(v, func(storage app.Storage) error {
+		exported, err := storage.ExportSnapshot(ctx)
+		if err != nil {
+			return err
+		}
+		if snapshotOutput == "-" {
+			return snapshot.Encode(os.Stdout, exported)
+		}
+		file, err := os.Create(snapshotOutput)
+		if err != nil {
+			return fmt.Errorf("create '%s' error: %w", snapshotOutput, err)
+		}
+		if err = snapshot.Encode(file, exported); err != nil {
+			_ = file.Close()
+			return err
+		}
+		return file.Close()
+	})
+}
+
+func importSnapshot() error {
+	var input io.Reader = os.Stdin
+	if snapshotInput != "-" {
+		file, err := os.Open(snapshotInput)
+		if err != nil {
+			return fmt.Errorf("open '%s' error: %w", snapshotInput, err)
+		}
+		defer file.Close()
+		input = file
+	}
+	imported, err := snapshot.Decode(input)
+	if err != nil {
+		return err
+	}
+	v, err := config.NewViper(cfgFile, configEnvPrefix, config.DefaultEnvKeyReplacer)
+	if err != nil {
+		return fmt.Errorf("create viper error: %w", err)
+	}
+	strategies, err := createStrategyFactory(v)
+	if err != nil {
+		return fmt.Errorf("create strategy factory error: %w", err)
+	}
+	if err = imported.Validate(strategies); err != nil {
+		return fmt.Errorf("invalid snapshot: %w", err)
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM, syscall.SIGHUP)
+	defer cancel()
+	return withStorage(v, func(storage app.Storage) error {
+		if !snapshotMerge {
+			empty, err := isStorageEmpty(ctx, storage)
+			if err != nil {
+				return err
+			}
+			if !empty {
+				return errors.New("storage is not empty, use --merge to import the snapshot into it")
+			}
+		}
+		if err := storage.ImportSnapshot(ctx, imported); err != nil {
+			return err
+		}
+		fmt.Fprintf(os.Stderr, "Imported banners: %d, slots: %d, social groups: %d, counters: %d\n",
+			len(imported.Banners), len(imported.Slots), len(imported.SocialGroups), len(imported.Counters))
+		return nil
+	})
+}
+
+// isStorageEmpty returns true in case of the storage has no banners, slots and social groups.
+func isStorageEmpty(ctx context.Context, storage app.Storage) (bool, error) {
+	filter := app.ListFilter{Limit: 1}
+	banners, err := storage.ListBanners(ctx, filter)
+	if err != nil {
+		return false, err
+	}
+	slots, err := storage.ListSlots(ctx, filter)
+	if err != nil {
+		return false, err
+	}
+	socialGroups, err := storage.ListSocialGroups(ctx, filter)
+	if err != nil {
+		return false, err
+	}
+	return len(banners) == 0 && len(slots) == 0 && len(socialGroups) == 0, nil
+}
+
+// withStorage calls fn with the storage created by the config, the storage is closed after fn returns.
+func withStorage(v *viper.Viper, fn func(storage app.Storage) error) (err error) {
+	// The standard output may be used by the snapshot, so the storage logs into the standard error.
+	storage, err := createStorage(v, logger.NewLogger(logger.NewConfig(v), os.Stderr), app.NewSystemClock())
+	if err != nil {
+		return fmt.Errorf("create storage error: %w", err)
+	}
+	if closer, ok := storage.(io.Closer); ok {
+		defer func() {
+			if closeErr := closer.Close(); closeErr != nil {
+				err = multierror.Append(err, closeErr)
+			}
+		}()
+	}
+	return fn(storage)
+}
@@ -0,0 +1,58 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"mime"
+	"net/url"
+)
+
+var ErrInvalidCreative = errors.New("invalid creative")
+
+// Creative is a content of a banner which is needed to render it. All fields are optional.
+type Creative struct {
+	// TargetURL is an absolute URL a click on the banner leads to.
+	TargetURL string
+	// ImageURL is an absolute URL of the banner image.
+	ImageURL string
+	// Width and Height are dimensions of the banner in pixels. Zero means that the dimension is unknown.
+	Width  int
+	Height int
+	// MIMEType is a media type of the banner image, e.g. "image/png".
+	MIMEType string
+	// Metadata is an arbitrary data of the frontend. The storages return nil in case of it is empty.
+	Metadata map[string]string
+}
+
+// Validate returns ErrInvalidCreative in case of an URL is not absolute, a dimension is negative,
+// the MIME type can not be parsed or a metadata key is empty.
+func (c Creative) Validate() error {
+	if err := checkAbsoluteURL("target url", c.TargetURL); err != nil {
+		return err
+	}
+	if err := checkAbsoluteURL("image url", c.ImageURL); err != nil {
+		return err
+	}
+	if c.Width < 0 || c.Height < 0 {
+		return fmt.Errorf("%w: dimensions %dx%d are negative", ErrInvalidCreative, c.Width, c.Height)
+	}
+	if c.MIMEType != "" {
+		if _, _, err := mime.ParseMediaType(c.MIMEType); err != nil {
+			return fmt.Errorf("%w: mime type '%s' error: %v", ErrInvalidCreative, c.MIMEType, err)
+		}
+	}
+	if _, ok := c.Metadata[""]; ok {
+		return fmt.Errorf("%w: metadata key is empty", ErrInvalidCreative)
+	}
+	return nil
+}
+
+func checkAbsoluteURL(name, value string) error {
+	if value == "" {
+		return nil
+	}
+	if u, err := url.Parse(value); err != nil || !u.IsAbs() || u.Host == "" {
+		return fmt.Errorf("%w: %s '%s' is not an absolute url", ErrInvalidCreative, name, value)
+	}
+	return nil
+}
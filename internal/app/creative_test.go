@@ -0,0 +1,50 @@
+package app_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ekhvalov/otus-banners-rotation/internal/app"
+	"github.com/ekhvalov/otus-banners-rotation/internal/app/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreative_Validate(t *testing.T) {
+	tests := map[string]struct {
+		creative app.Creative
+		wantErr  bool
+	}{
+		"empty":                  {creative: app.Creative{}},
+		"full":                   {creative: creative},
+		"relative target url":    {creative: app.Creative{TargetURL: "/landing"}, wantErr: true},
+		"image url without host": {creative: app.Creative{ImageURL: "file:///banner.png"}, wantErr: true},
+		"malformed url":          {creative: app.Creative{TargetURL: "https://exa mple.com/%%"}, wantErr: true},
+		"negative width":         {creative: app.Creative{Width: -1, Height: 90}, wantErr: true},
+		"negative height":        {creative: app.Creative{Width: 728, Height: -90}, wantErr: true},
+		"mime type parameters":   {creative: app.Creative{MIMEType: "text/html; charset=utf-8"}},
+		"malformed mime type":    {creative: app.Creative{MIMEType: "image/"}, wantErr: true},
+		"empty metadata key":     {creative: app.Creative{Metadata: map[string]string{"": "value"}}, wantErr: true},
+	}
+	for testName, tt := range tests {
+		t.Run(testName, func(t *testing.T) {
+			err := tt.creative.Validate()
+
+			if tt.wantErr {
+				require.ErrorIs(t, err, app.ErrInvalidCreative)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRotator_CreateBanner_Error_InvalidCreative(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	rotator := newInventoryRotator(controller, mock.NewMockStorage(controller))
+
+	_, err := rotator.CreateBanner(context.Background(), description, app.Creative{Width: -1}, app.Flight{})
+
+	require.ErrorIs(t, err, app.ErrInvalidCreative)
+}
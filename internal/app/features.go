@@ -0,0 +1,26 @@
+package app
+
+// FeatureSocialGroup is a feature which is added to the request features by the rotator.
+const FeatureSocialGroup = "social_group"
+
+// Features are optional attributes of a banner selection request, e.g. device, hour of day or locale.
+type Features map[string]string
+
+// LinearStats contains the statistics of a linear model of a banner over the feature vectors.
+// A is a sum of the outer products of the vectors of the banner selects (row-major, dimension x dimension).
+// B is a sum of the vectors of the banner clicks.
+type LinearStats struct {
+	BannerID string
+	A        []float64
+	B        []float64
+}
+
+// withSocialGroup returns a copy of the features with the social group feature.
+func withSocialGroup(features Features, socialGroupID string) Features {
+	result := make(Features, len(features)+1)
+	for name, value := range features {
+		result[name] = value
+	}
+	result[FeatureSocialGroup] = socialGroupID
+	return result
+}
@@ -0,0 +1,45 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+var ErrInvalidFlight = errors.New("invalid flight")
+
+// Flight is a time window in which a banner is selected, e.g. a campaign window. Zero times mean that
+// the window is unbounded. The storages keep the times with a precision of a second.
+type Flight struct {
+	// Start is a time the banner is selected since.
+	Start time.Time
+	// End is a time the banner is not selected since.
+	End time.Time
+}
+
+// IsZero returns true in case of the window is unbounded.
+func (f Flight) IsZero() bool {
+	return f.Start.IsZero() && f.End.IsZero()
+}
+
+// Validate returns ErrInvalidFlight in case of the end is not after the start.
+func (f Flight) Validate() error {
+	if !f.Start.IsZero() && !f.End.IsZero() && !f.End.After(f.Start) {
+		return fmt.Errorf("%w: end %s is not after start %s", ErrInvalidFlight,
+			f.End.Format(time.RFC3339), f.Start.Format(time.RFC3339))
+	}
+	return nil
+}
+
+// Status returns AttachmentStatusScheduled before the start, AttachmentStatusEnded since the end
+// and AttachmentStatusActive within the window.
+func (f Flight) Status(now time.Time) AttachmentStatus {
+	switch {
+	case !f.Start.IsZero() && now.Before(f.Start):
+		return AttachmentStatusScheduled
+	case !f.End.IsZero() && !now.Before(f.End):
+		return AttachmentStatusEnded
+	default:
+		return AttachmentStatusActive
+	}
+}
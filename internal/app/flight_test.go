@@ -0,0 +1,72 @@
+package app_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ekhvalov/otus-banners-rotation/internal/app"
+	"github.com/ekhvalov/otus-banners-rotation/internal/app/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlight_Validate(t *testing.T) {
+	start := clock.Now()
+	tests := map[string]struct {
+		flight  app.Flight
+		wantErr bool
+	}{
+		"unbounded":        {flight: app.Flight{}},
+		"start only":       {flight: app.Flight{Start: start}},
+		"end only":         {flight: app.Flight{End: start}},
+		"window":           {flight: app.Flight{Start: start, End: start.Add(time.Hour)}},
+		"end before start": {flight: app.Flight{Start: start, End: start.Add(-time.Hour)}, wantErr: true},
+		"empty window":     {flight: app.Flight{Start: start, End: start}, wantErr: true},
+	}
+	for testName, tt := range tests {
+		t.Run(testName, func(t *testing.T) {
+			err := tt.flight.Validate()
+
+			if tt.wantErr {
+				require.ErrorIs(t, err, app.ErrInvalidFlight)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestFlight_Status(t *testing.T) {
+	now := clock.Now()
+	tests := map[string]struct {
+		flight app.Flight
+		want   app.AttachmentStatus
+	}{
+		"unbounded":   {flight: app.Flight{}, want: app.AttachmentStatusActive},
+		"started":     {flight: app.Flight{Start: now}, want: app.AttachmentStatusActive},
+		"not started": {flight: app.Flight{Start: now.Add(time.Second)}, want: app.AttachmentStatusScheduled},
+		"not ended":   {flight: app.Flight{End: now.Add(time.Second)}, want: app.AttachmentStatusActive},
+		"ended":       {flight: app.Flight{End: now}, want: app.AttachmentStatusEnded},
+		"within window": {
+			flight: app.Flight{Start: now.Add(-time.Hour), End: now.Add(time.Hour)},
+			want:   app.AttachmentStatusActive,
+		},
+	}
+	for testName, tt := range tests {
+		t.Run(testName, func(t *testing.T) {
+			require.Equal(t, tt.want, tt.flight.Status(now))
+		})
+	}
+}
+
+func TestRotator_CreateBanner_Error_InvalidFlight(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	rotator := newInventoryRotator(controller, mock.NewMockStorage(controller))
+	flight := app.Flight{Start: clock.Now(), End: clock.Now().Add(-time.Hour)}
+
+	_, err := rotator.CreateBanner(context.Background(), description, app.Creative{}, flight)
+
+	require.ErrorIs(t, err, app.ErrInvalidFlight)
+}
@@ -2,6 +2,9 @@
 //go:generate mockgen -destination=./mock/event_queue.gen.go -package mock . EventQueue
 //go:generate mockgen -destination=./mock/rotator.gen.go -package mock . Rotator
 //go:generate mockgen -destination=./mock/logger.gen.go -package mock . Logger
+//go:generate mockgen -destination=./mock/strategy.gen.go -package mock . Strategy
+//go:generate mockgen -destination=./mock/contextual_strategy.gen.go -package mock . ContextualStrategy
+//go:generate mockgen -destination=./mock/strategy_factory.gen.go -package mock . StrategyFactory
 
 package app
 
@@ -9,9 +12,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 )
 
-var ErrNoBannersFound = errors.New("no banners found")
+var (
+	ErrNoBannersFound  = errors.New("no banners found")
+	ErrInvalidStrategy = errors.New("invalid strategy")
+)
 
 func NewErrNotFound(message string) *ErrNotFound {
 	return &ErrNotFound{message: message}
@@ -38,39 +45,191 @@ func (e *ErrBannerNotAttached) Error() string {
 	return fmt.Sprintf("banner id '%s' is not attached to slot id '%s'", e.bannerID, e.slotID)
 }
 
-type Storage interface {
-	// CreateBanner creates new banner.
+func NewErrBannerNotFit(slotID, bannerID string, reason error) *ErrBannerNotFit {
+	return &ErrBannerNotFit{slotID: slotID, bannerID: bannerID, reason: reason}
+}
+
+// ErrBannerNotFit is returned by the attachment of a banner which creative does not fit the format of a slot.
+type ErrBannerNotFit struct {
+	slotID   string
+	bannerID string
+	reason   error
+}
+
+func (e *ErrBannerNotFit) Error() string {
+	return fmt.Sprintf("banner id '%s' does not fit slot id '%s': %s", e.bannerID, e.slotID, e.reason)
+}
+
+func NewErrInUse(message string) *ErrInUse {
+	return &ErrInUse{message: message}
+}
+
+// ErrInUse is returned by the deletion of a slot, banner or social group which is still referenced
+// in the DeleteModeRestrict mode.
+type ErrInUse struct {
+	message string
+}
+
+func (e *ErrInUse) Error() string {
+	return fmt.Sprintf("in use: %s", e.message)
+}
+
+// DeleteMode defines how a storage deletes a slot, banner or social group which is still referenced.
+type DeleteMode string
+
+const (
+	// DeleteModeCascade deletes the attachments and the counters of an entity as if it has never existed.
+	DeleteModeCascade DeleteMode = "cascade"
+	// DeleteModeRestrict rejects the deletion of an entity which is still referenced with ErrInUse:
+	// a slot with attached banners, a banner attached to a slot or a social group with registered counters.
+	DeleteModeRestrict DeleteMode = "restrict"
+)
+
+// ParseDeleteMode parses a delete mode, an empty string means DeleteModeCascade.
+func ParseDeleteMode(mode string) (DeleteMode, error) {
+	switch DeleteMode(mode) {
+	case "", DeleteModeCascade:
+		return DeleteModeCascade, nil
+	case DeleteModeRestrict:
+		return DeleteModeRestrict, nil
+	default:
+		return "", fmt.Errorf("unknown delete mode '%s'", mode)
+	}
+}
+
+type Inventory interface {
+	// CreateBanner creates new banner with the creative and the flight.
 	// Returns id of the created banner or an error
-	CreateBanner(ctx context.Context, description string) (id string, err error)
-	// DeleteBanner deletes banner with specified ID.
-	// Returns ErrNotFound in case of the banner with specified id is not found.
+	CreateBanner(ctx context.Context, description string, creative Creative, flight Flight) (id string, err error)
+	// DeleteBanner deletes banner with specified ID. The deletion of a missing banner is not an error.
+	// The banner is detached from all slots and its counters are deleted according to the DeleteMode.
+	// Returns ErrInUse in case of the banner is attached to a slot in the DeleteModeRestrict mode.
 	DeleteBanner(ctx context.Context, id string) error
-	// CreateSlot creates new slot.
+	// CreateSlot creates new slot with the banner selection strategy and the format of the accepted creatives.
 	// Returns id of the created slot or an error
-	CreateSlot(ctx context.Context, description string) (id string, err error)
-	// DeleteSlot deletes slot with specified ID.
-	// Returns ErrNotFound in case of the slot with specified id is not found.
+	CreateSlot(ctx context.Context, description string, strategy StrategyParams, format SlotFormat) (id string, err error)
+	// DeleteSlot deletes slot with specified ID. The deletion of a missing slot is not an error.
+	// The attachments and the counters of the slot are deleted according to the DeleteMode.
+	// Returns ErrInUse in case of the slot has attached banners in the DeleteModeRestrict mode.
 	DeleteSlot(ctx context.Context, id string) error
 	// CreateSocialGroup creates new socialGroup.
 	// Returns id of the created socialGroup or an error
 	CreateSocialGroup(ctx context.Context, description string) (id string, err error)
-	// DeleteSocialGroup deletes social group with specified ID.
-	// Returns ErrNotFound in case of the social group with specified id is not found.
+	// DeleteSocialGroup deletes social group with specified ID. The deletion of a missing group is not an error.
+	// The counters of the social group are deleted according to the DeleteMode.
+	// Returns ErrInUse in case of the social group has registered counters in the DeleteModeRestrict mode.
 	DeleteSocialGroup(ctx context.Context, id string) error
 	// AttachBanner attaches a banner to a slot.
 	// Returns ErrNotFound in case of a banner or a slot is not found.
+	// The rotator returns ErrBannerNotFit in case of the banner creative does not fit the slot format.
 	AttachBanner(ctx context.Context, slotID, bannerID string) error
 	// DetachBanner attaches a banner to a slot.
 	// Returns ErrNotFound in case of a banner or a slot is not found.
 	DetachBanner(ctx context.Context, slotID, bannerID string) error
-	// SelectBanner selects a banner from a slot for social group.
+	// GetBanner returns a banner with specified ID.
+	// Returns ErrNotFound in case of the banner with specified id is not found.
+	GetBanner(ctx context.Context, id string) (Banner, error)
+	// GetSlot returns a slot with specified ID.
+	// Returns ErrNotFound in case of the slot with specified id is not found.
+	GetSlot(ctx context.Context, id string) (Slot, error)
+	// GetSocialGroup returns a social group with specified ID.
+	// Returns ErrNotFound in case of the social group with specified id is not found.
+	GetSocialGroup(ctx context.Context, id string) (SocialGroup, error)
+	// UpdateBanner changes the description of a banner.
+	// Returns the updated banner or ErrNotFound in case of the banner is not found.
+	UpdateBanner(ctx context.Context, id, description string) (Banner, error)
+	// UpdateSlot changes the description of a slot.
+	// Returns the updated slot or ErrNotFound in case of the slot is not found.
+	UpdateSlot(ctx context.Context, id, description string) (Slot, error)
+	// UpdateSocialGroup changes the description of a social group.
+	// Returns the updated social group or ErrNotFound in case of the social group is not found.
+	UpdateSocialGroup(ctx context.Context, id, description string) (SocialGroup, error)
+}
+
+// Storage keeps the inventory and the counters. The storage clock should be the clock of the rotator,
+// so the stats intervals of the registered counters and of the counters history are the same.
+type Storage interface {
+	Inventory
+	// ListBanners returns the banners selected by the filter ordered by id.
+	ListBanners(ctx context.Context, filter ListFilter) ([]Banner, error)
+	// ListSlots returns the slots selected by the filter ordered by id.
+	ListSlots(ctx context.Context, filter ListFilter) ([]Slot, error)
+	// ListSocialGroups returns the social groups selected by the filter ordered by id.
+	ListSocialGroups(ctx context.Context, filter ListFilter) ([]SocialGroup, error)
+	// ListSlotBanners returns the attachments of the banners to a slot ordered by banner id.
+	// The AfterID of the filter is a banner id, the DescriptionContains is not used.
+	// Returns ErrNotFound in case of the slot is not found.
+	ListSlotBanners(ctx context.Context, slotID string, filter ListFilter) ([]Attachment, error)
+	// ListBannerSlots returns the attachments of a banner to the slots ordered by slot id.
+	// The AfterID of the filter is a slot id, the DescriptionContains is not used.
+	// Returns ErrNotFound in case of the banner is not found.
+	ListBannerSlots(ctx context.Context, bannerID string, filter ListFilter) ([]Attachment, error)
+	// GetBannerFlights returns the flights of the banners by their ids.
+	// The banners without the flights and the missing banners are not returned.
+	GetBannerFlights(ctx context.Context, bannerIDs []string) (map[string]Flight, error)
+	// GetSlotStats returns selects and clicks counters of the banners attached to a slot for social group.
+	// Returns ErrNotFound in case of a slot or social group is not found.
+	// Returns ErrNoBannersFound in case of no banners are attached to a slot.
+	GetSlotStats(ctx context.Context, slotID, socialGroupID string) (SlotStats, error)
+	// GetSlotStatsHistory returns counters of the banners attached to a slot for social group
+	// registered in every StatsInterval since the specified time up to now by the storage clock.
+	// The history is limited by MaxStatsHistory.
+	// Returns ErrInvalidStatsPeriod in case of the specified time is after now.
+	// Returns ErrNotFound in case of a slot or social group is not found.
+	// Returns ErrNoBannersFound in case of no banners are attached to a slot.
+	GetSlotStatsHistory(ctx context.Context, slotID, socialGroupID string, since time.Time) ([]IntervalStats, error)
+	// RegisterSelect registers a select of a banner in a slot by social group.
+	// The counters of the stats interval which contains now by the storage clock are incremented too.
 	// Returns ErrNotFound in case of a banner or slot or social group is not found.
 	// Returns ErrBannerNotAttached in case of a banner is not attached to a slot.
-	SelectBanner(ctx context.Context, slotID, socialGroupID string) (bannerID string, err error)
-	// ClickBanner registers a click on a banner in a slot by social group.
+	RegisterSelect(ctx context.Context, slotID, bannerID, socialGroupID string) error
+	// RegisterClick registers a click on a banner in a slot by social group.
+	// The counters of the stats interval which contains now by the storage clock are incremented too.
 	// Returns ErrNotFound in case of a banner or slot or social group is not found.
 	// Returns ErrBannerNotAttached in case of a banner is not attached to a slot.
-	ClickBanner(ctx context.Context, slotID, bannerID, socialGroupID string) error
+	RegisterClick(ctx context.Context, slotID, bannerID, socialGroupID string) error
+	// GetLinearStats returns linear models statistics of the banners attached to a slot.
+	// Returns ErrNotFound in case of a slot is not found.
+	// Returns ErrNoBannersFound in case of no banners are attached to a slot.
+	GetLinearStats(ctx context.Context, slotID string, dimension int) ([]LinearStats, error)
+	// RegisterLinearSelect adds the outer product of the feature vector to the linear model of a banner in a slot.
+	// Returns ErrNotFound in case of a banner or slot is not found.
+	// Returns ErrBannerNotAttached in case of a banner is not attached to a slot.
+	RegisterLinearSelect(ctx context.Context, slotID, bannerID string, vector []float64) error
+	// RegisterLinearClick adds the feature vector to the linear model of a banner in a slot.
+	// Returns ErrNotFound in case of a banner or slot is not found.
+	// Returns ErrBannerNotAttached in case of a banner is not attached to a slot.
+	RegisterLinearClick(ctx context.Context, slotID, bannerID string, vector []float64) error
+	// ExportSnapshot returns the entities, the attachments, the counters and the linear models of the storage.
+	ExportSnapshot(ctx context.Context) (Snapshot, error)
+	// ImportSnapshot adds the entities, the attachments, the counters and the linear models to the storage.
+	// The entities with the same ids are replaced, the counters and the linear models are added to the existing
+	// ones, so a snapshot is restored by the import into an empty storage. The snapshot is expected to be valid,
+	// see Snapshot.Validate. The expired interval counters are skipped. An existing attachment is kept,
+	// an attachment without the AttachedAt is attached at the time of the import.
+	ImportSnapshot(ctx context.Context, snapshot Snapshot) error
+}
+
+type Strategy interface {
+	// SelectBanner chooses one of the banners by their selects and clicks counters.
+	// Randomized strategies use the random source only, so the selection is reproducible.
+	// Returns an empty string in case of stats contain no banners.
+	SelectBanner(stats SlotStats, random Random) (bannerID string)
+}
+
+// ContextualStrategy is a strategy which chooses a banner by the request features instead of the counters.
+type ContextualStrategy interface {
+	// Vectorize converts the features to a feature vector.
+	Vectorize(features Features) []float64
+	// SelectContextualBanner chooses one of the banners by their linear models and the feature vector.
+	// Returns an empty string in case of stats contain no banners.
+	SelectContextualBanner(stats []LinearStats, vector []float64) (bannerID string)
+}
+
+type StrategyFactory interface {
+	// CreateStrategy creates a strategy by the slot params, either a Strategy or a ContextualStrategy is returned.
+	// Returns ErrInvalidStrategy in case of the params are invalid.
+	CreateStrategy(params StrategyParams) (Strategy, ContextualStrategy, error)
 }
 
 type EventQueue interface {
@@ -78,7 +237,41 @@ type EventQueue interface {
 }
 
 type Rotator interface {
-	Storage
+	Inventory
+	// ListBanners returns a page of the banners ordered by id and the token of the next page.
+	// The token is empty in case of it is the last page.
+	// Returns ErrInvalidPageSize or ErrInvalidPageToken in case of the query is invalid.
+	ListBanners(ctx context.Context, query ListQuery) (banners []Banner, nextPageToken string, err error)
+	// ListSlots returns a page of the slots ordered by id and the token of the next page.
+	// The token is empty in case of it is the last page.
+	// Returns ErrInvalidPageSize or ErrInvalidPageToken in case of the query is invalid.
+	ListSlots(ctx context.Context, query ListQuery) (slots []Slot, nextPageToken string, err error)
+	// ListSocialGroups returns a page of the social groups ordered by id and the token of the next page.
+	// The token is empty in case of it is the last page.
+	// Returns ErrInvalidPageSize or ErrInvalidPageToken in case of the query is invalid.
+	ListSocialGroups(ctx context.Context, query ListQuery) (socialGroups []SocialGroup, nextPageToken string, err error)
+	// ListSlotBanners returns a page of the banners attached to a slot ordered by banner id and the token
+	// of the next page. The DescriptionContains of the query is not used.
+	// Returns ErrNotFound in case of the slot is not found.
+	// Returns ErrInvalidPageSize or ErrInvalidPageToken in case of the query is invalid.
+	ListSlotBanners(ctx context.Context, slotID string, query ListQuery) ([]AttachmentInfo, string, error)
+	// ListBannerSlots returns a page of the slots a banner is attached to ordered by slot id and the token
+	// of the next page. The DescriptionContains of the query is not used.
+	// Returns ErrNotFound in case of the banner is not found.
+	// Returns ErrInvalidPageSize or ErrInvalidPageToken in case of the query is invalid.
+	ListBannerSlots(ctx context.Context, bannerID string, query ListQuery) ([]AttachmentInfo, string, error)
+	// SelectBanner selects a banner from a slot for social group and returns it with its creative.
+	// Returns ErrNotFound in case of a banner or slot or social group is not found.
+	// Returns ErrBannerNotAttached in case of a banner is not attached to a slot.
+	// The features are optional and are used by contextual strategies only.
+	// The banners outside of their flights are not selected, ErrNoBannersFound is returned in case of
+	// no attached banners are in their flights.
+	SelectBanner(ctx context.Context, slotID, socialGroupID string, features Features) (Banner, error)
+	// ClickBanner registers a click on a banner in a slot by social group.
+	// The features should be the same as the ones the banner was selected with.
+	// Returns ErrNotFound in case of a banner or slot or social group is not found.
+	// Returns ErrBannerNotAttached in case of a banner is not attached to a slot.
+	ClickBanner(ctx context.Context, slotID, bannerID, socialGroupID string, features Features) error
 }
 
 type Logger interface {
@@ -0,0 +1,130 @@
+package app
+
+import (
+	"encoding/base64"
+	"errors"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultPageSize is a page size of the lists in case of the page size is not set.
+	DefaultPageSize = 50
+	// MaxPageSize is a maximum page size of the lists, a bigger page size is reduced to it.
+	MaxPageSize = 1000
+)
+
+var (
+	ErrInvalidPageSize  = errors.New("invalid page size")
+	ErrInvalidPageToken = errors.New("invalid page token")
+)
+
+// Banner is an advertisement element.
+type Banner struct {
+	ID          string
+	Description string
+	Creative    Creative
+	// Flight limits the time the banner is selected in. The counters of the banner are kept outside of it.
+	Flight Flight
+}
+
+// SocialGroup is a segmented group of website visitors.
+type SocialGroup struct {
+	ID          string
+	Description string
+}
+
+// Attachment is a banner attached to a slot.
+type Attachment struct {
+	SlotID   string
+	BannerID string
+	// AttachedAt is a time the banner was attached to the slot with a precision of a second.
+	// Zero means that the time is unknown, e.g. the banner was attached before the time was kept.
+	AttachedAt time.Time
+}
+
+// AttachmentStatus is a current status of a banner attached to a slot.
+type AttachmentStatus string
+
+const (
+	// AttachmentStatusActive means that the banner is selected by the strategy of the slot.
+	AttachmentStatusActive AttachmentStatus = "active"
+	// AttachmentStatusScheduled means that the flight of the banner has not started yet.
+	AttachmentStatusScheduled AttachmentStatus = "scheduled"
+	// AttachmentStatusEnded means that the flight of the banner has ended.
+	AttachmentStatusEnded AttachmentStatus = "ended"
+)
+
+// AttachmentInfo is an attachment with its current status.
+type AttachmentInfo struct {
+	Attachment
+	Status AttachmentStatus
+}
+
+// ListFilter selects the banners, slots or social groups of a storage. The entities are ordered by id.
+type ListFilter struct {
+	// AfterID skips the entities with ids less than or equal to it. Empty means from the first entity.
+	AfterID string
+	// Limit is a maximum number of the entities. Zero means no limit.
+	Limit int
+	// DescriptionContains keeps the entities which description contains it. Empty means all entities.
+	DescriptionContains string
+}
+
+// Match returns whether the entity with id and description is selected by the filter, the Limit is not checked.
+func (f ListFilter) Match(id, description string) bool {
+	return id > f.AfterID && strings.Contains(description, f.DescriptionContains)
+}
+
+// ListQuery is a request of a page of the banners, slots or social groups ordered by id.
+type ListQuery struct {
+	// PageSize is a maximum number of the entities of the page. Zero means DefaultPageSize.
+	PageSize int
+	// PageToken is a token of the next page returned with the previous page. Empty means the first page.
+	PageToken string
+	// DescriptionContains keeps the entities which description contains it. Empty means all entities.
+	DescriptionContains string
+}
+
+// filter returns the storage filter of the page. The filter selects one more entity than the page size,
+// so the rotator knows whether there is the next page.
+func (q ListQuery) filter() (ListFilter, error) {
+	pageSize := q.PageSize
+	switch {
+	case pageSize < 0:
+		return ListFilter{}, ErrInvalidPageSize
+	case pageSize == 0:
+		pageSize = DefaultPageSize
+	case pageSize > MaxPageSize:
+		pageSize = MaxPageSize
+	}
+	var afterID string
+	if q.PageToken != "" {
+		decoded, err := base64.RawURLEncoding.DecodeString(q.PageToken)
+		if err != nil || len(decoded) == 0 {
+			return ListFilter{}, ErrInvalidPageToken
+		}
+		afterID = string(decoded)
+	}
+	return ListFilter{AfterID: afterID, Limit: pageSize + 1, DescriptionContains: q.DescriptionContains}, nil
+}
+
+// page returns the number of the listed entities which belong to the page and the token of the next page.
+// The token is empty in case of it is the last page.
+func (f ListFilter) page(listed int, idOf func(i int) string) (size int, nextPageToken string) {
+	if listed < f.Limit {
+		return listed, ""
+	}
+	size = f.Limit - 1
+	return size, base64.RawURLEncoding.EncodeToString([]byte(idOf(size - 1)))
+}
+
+// makeAttachmentInfos adds the current statuses to the attachments. An attached banner is selected
+// by the strategy of the slot within its flight.
+func makeAttachmentInfos(attachments []Attachment, flights map[string]Flight, now time.Time) []AttachmentInfo {
+	infos := make([]AttachmentInfo, len(attachments))
+	for i, attachment := range attachments {
+		infos[i] = AttachmentInfo{Attachment: attachment, Status: flights[attachment.BannerID].Status(now)}
+	}
+	return infos
+}
@@ -0,0 +1,274 @@
+package app_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ekhvalov/otus-banners-rotation/internal/app"
+	"github.com/ekhvalov/otus-banners-rotation/internal/app/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func newInventoryRotator(controller *gomock.Controller, storage app.Storage) app.Rotator {
+	return app.NewRotator(
+		storage,
+		mock.NewMockStrategyFactory(controller),
+		mock.NewMockEventQueue(controller),
+		mock.NewMockLogger(controller),
+		app.NewRandom(seed),
+		clock,
+	)
+}
+
+func TestRotator_GetBanner(t *testing.T) {
+	errNotFound := app.NewErrNotFound("banner is not found")
+	tests := map[string]struct {
+		id            string
+		mockReturnErr error
+		want          app.Banner
+		err           error
+	}{
+		"empty id": {
+			id:  emptyID,
+			err: app.ErrEmptyID,
+		},
+		"not found": {
+			id:            id,
+			mockReturnErr: errNotFound,
+			err:           errNotFound,
+		},
+		"no error": {
+			id:   id,
+			want: app.Banner{ID: id, Description: description},
+		},
+	}
+	for testName, tt := range tests {
+		t.Run(testName, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+			storage := mock.NewMockStorage(controller)
+			if tt.id != "" {
+				storage.EXPECT().GetBanner(context.Background(), tt.id).Return(tt.want, tt.mockReturnErr)
+			}
+
+			got, err := newInventoryRotator(controller, storage).GetBanner(context.Background(), tt.id)
+
+			if tt.err == nil {
+				require.NoError(t, err)
+				require.Equal(t, tt.want, got)
+			} else {
+				require.ErrorIs(t, err, tt.err)
+			}
+		})
+	}
+}
+
+func TestRotator_UpdateX_Error(t *testing.T) {
+	tests := map[string]struct {
+		id          string
+		description string
+		err         error
+	}{
+		"empty id":          {id: emptyID, description: description, err: app.ErrEmptyID},
+		"empty description": {id: id, description: emptyDescription, err: app.ErrEmptyDescription},
+	}
+	for testName, tt := range tests {
+		t.Run(testName, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+			rotator := newInventoryRotator(controller, mock.NewMockStorage(controller))
+
+			_, err := rotator.UpdateBanner(context.Background(), tt.id, tt.description)
+			require.ErrorIs(t, err, tt.err)
+			_, err = rotator.UpdateSlot(context.Background(), tt.id, tt.description)
+			require.ErrorIs(t, err, tt.err)
+			_, err = rotator.UpdateSocialGroup(context.Background(), tt.id, tt.description)
+			require.ErrorIs(t, err, tt.err)
+		})
+	}
+}
+
+func TestRotator_UpdateSlot(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	slot := app.Slot{ID: slotID, Description: description, Strategy: app.StrategyParams{Name: "ucb1"}}
+	storage := mock.NewMockStorage(controller)
+	storage.EXPECT().UpdateSlot(context.Background(), slotID, description).Return(slot, nil)
+
+	got, err := newInventoryRotator(controller, storage).UpdateSlot(context.Background(), slotID, description)
+
+	require.NoError(t, err)
+	require.Equal(t, slot, got)
+}
+
+func TestRotator_ListBanners_Pages(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	banners := []app.Banner{{ID: "1", Description: "a"}, {ID: "2", Description: "b"}, {ID: "3", Description: "c"}}
+	storage := mock.NewMockStorage(controller)
+	storage.EXPECT().
+		ListBanners(context.Background(), app.ListFilter{Limit: 3, DescriptionContains: "x"}).
+		Return(banners, nil)
+	storage.EXPECT().
+		ListBanners(context.Background(), app.ListFilter{AfterID: "2", Limit: 3, DescriptionContains: "x"}).
+		Return(banners[2:], nil)
+	rotator := newInventoryRotator(controller, storage)
+
+	firstPage, nextPageToken, err := rotator.ListBanners(
+		context.Background(),
+		app.ListQuery{PageSize: 2, DescriptionContains: "x"},
+	)
+	require.NoError(t, err)
+	require.Equal(t, banners[:2], firstPage)
+	require.NotEmpty(t, nextPageToken)
+
+	lastPage, nextPageToken, err := rotator.ListBanners(
+		context.Background(),
+		app.ListQuery{PageSize: 2, PageToken: nextPageToken, DescriptionContains: "x"},
+	)
+	require.NoError(t, err)
+	require.Equal(t, banners[2:], lastPage)
+	require.Empty(t, nextPageToken)
+}
+
+func TestRotator_ListX_PageSize(t *testing.T) {
+	tests := map[string]struct {
+		pageSize  int
+		wantLimit int
+	}{
+		"default page size": {pageSize: 0, wantLimit: app.DefaultPageSize + 1},
+		"page size":         {pageSize: 10, wantLimit: 11},
+		"reduced page size": {pageSize: app.MaxPageSize + 1, wantLimit: app.MaxPageSize + 1},
+	}
+	for testName, tt := range tests {
+		t.Run(testName, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+			storage := mock.NewMockStorage(controller)
+			filter := app.ListFilter{Limit: tt.wantLimit}
+			storage.EXPECT().ListBanners(context.Background(), filter).Return(nil, nil)
+			storage.EXPECT().ListSlots(context.Background(), filter).Return(nil, nil)
+			storage.EXPECT().ListSocialGroups(context.Background(), filter).Return(nil, nil)
+			rotator := newInventoryRotator(controller, storage)
+			query := app.ListQuery{PageSize: tt.pageSize}
+
+			_, _, err := rotator.ListBanners(context.Background(), query)
+			require.NoError(t, err)
+			_, _, err = rotator.ListSlots(context.Background(), query)
+			require.NoError(t, err)
+			_, nextPageToken, err := rotator.ListSocialGroups(context.Background(), query)
+			require.NoError(t, err)
+			require.Empty(t, nextPageToken)
+		})
+	}
+}
+
+func TestRotator_ListX_Error(t *testing.T) {
+	tests := map[string]struct {
+		query app.ListQuery
+		err   error
+	}{
+		"negative page size":   {query: app.ListQuery{PageSize: -1}, err: app.ErrInvalidPageSize},
+		"malformed page token": {query: app.ListQuery{PageToken: "%%%"}, err: app.ErrInvalidPageToken},
+	}
+	for testName, tt := range tests {
+		t.Run(testName, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+			rotator := newInventoryRotator(controller, mock.NewMockStorage(controller))
+
+			_, _, err := rotator.ListBanners(context.Background(), tt.query)
+			require.ErrorIs(t, err, tt.err)
+			_, _, err = rotator.ListSlots(context.Background(), tt.query)
+			require.ErrorIs(t, err, tt.err)
+			_, _, err = rotator.ListSocialGroups(context.Background(), tt.query)
+			require.ErrorIs(t, err, tt.err)
+		})
+	}
+}
+
+func TestRotator_ListSlotBanners_Pages(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	attachedAt := time.Unix(1668945600, 0)
+	attachments := []app.Attachment{
+		{SlotID: slotID, BannerID: "1", AttachedAt: attachedAt},
+		{SlotID: slotID, BannerID: "2"},
+	}
+	storage := mock.NewMockStorage(controller)
+	storage.EXPECT().ListSlotBanners(context.Background(), slotID, app.ListFilter{Limit: 2}).Return(attachments, nil)
+	storage.EXPECT().ListSlotBanners(context.Background(), slotID, app.ListFilter{AfterID: "1", Limit: 2}).
+		Return(attachments[1:], nil)
+	storage.EXPECT().GetBannerFlights(context.Background(), []string{"1"}).
+		Return(map[string]app.Flight{"1": {Start: clock.Now().Add(time.Hour)}}, nil)
+	storage.EXPECT().GetBannerFlights(context.Background(), []string{"2"}).Return(nil, nil)
+	rotator := newInventoryRotator(controller, storage)
+
+	firstPage, nextPageToken, err := rotator.ListSlotBanners(context.Background(), slotID, app.ListQuery{PageSize: 1})
+	require.NoError(t, err)
+	require.Equal(t, []app.AttachmentInfo{{Attachment: attachments[0], Status: app.AttachmentStatusScheduled}}, firstPage)
+	require.NotEmpty(t, nextPageToken)
+
+	lastPage, nextPageToken, err := rotator.ListSlotBanners(
+		context.Background(),
+		slotID,
+		app.ListQuery{PageSize: 1, PageToken: nextPageToken},
+	)
+	require.NoError(t, err)
+	require.Equal(t, []app.AttachmentInfo{{Attachment: attachments[1], Status: app.AttachmentStatusActive}}, lastPage)
+	require.Empty(t, nextPageToken)
+}
+
+func TestRotator_ListBannerSlots_Pages(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	attachments := []app.Attachment{{SlotID: "1", BannerID: bannerID}, {SlotID: "2", BannerID: bannerID}}
+	storage := mock.NewMockStorage(controller)
+	storage.EXPECT().ListBannerSlots(context.Background(), bannerID, app.ListFilter{Limit: 2}).Return(attachments, nil)
+	storage.EXPECT().GetBannerFlights(context.Background(), []string{bannerID}).
+		Return(map[string]app.Flight{bannerID: {End: clock.Now()}}, nil)
+	rotator := newInventoryRotator(controller, storage)
+
+	page, nextPageToken, err := rotator.ListBannerSlots(context.Background(), bannerID, app.ListQuery{PageSize: 1})
+
+	require.NoError(t, err)
+	require.Len(t, page, 1)
+	require.Equal(t, "1", page[0].SlotID)
+	require.Equal(t, app.AttachmentStatusEnded, page[0].Status)
+	require.NotEmpty(t, nextPageToken)
+}
+
+func TestRotator_ListAttachments_Error(t *testing.T) {
+	errNotFound := app.NewErrNotFound("not found")
+	tests := map[string]struct {
+		id            string
+		query         app.ListQuery
+		mockReturnErr error
+		err           error
+	}{
+		"empty id":             {id: emptyID, err: app.ErrEmptyID},
+		"negative page size":   {id: id, query: app.ListQuery{PageSize: -1}, err: app.ErrInvalidPageSize},
+		"malformed page token": {id: id, query: app.ListQuery{PageToken: "%%%"}, err: app.ErrInvalidPageToken},
+		"not found":            {id: id, mockReturnErr: errNotFound, err: errNotFound},
+	}
+	for testName, tt := range tests {
+		t.Run(testName, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+			storage := mock.NewMockStorage(controller)
+			if tt.mockReturnErr != nil {
+				filter := app.ListFilter{Limit: app.DefaultPageSize + 1}
+				storage.EXPECT().ListSlotBanners(context.Background(), tt.id, filter).Return(nil, tt.mockReturnErr)
+				storage.EXPECT().ListBannerSlots(context.Background(), tt.id, filter).Return(nil, tt.mockReturnErr)
+			}
+			rotator := newInventoryRotator(controller, storage)
+
+			_, _, err := rotator.ListSlotBanners(context.Background(), tt.id, tt.query)
+			require.ErrorIs(t, err, tt.err)
+			_, _, err = rotator.ListBannerSlots(context.Background(), tt.id, tt.query)
+			require.ErrorIs(t, err, tt.err)
+		})
+	}
+}
@@ -0,0 +1,63 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/ekhvalov/otus-banners-rotation/internal/app (interfaces: ContextualStrategy)
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	reflect "reflect"
+
+	app "github.com/ekhvalov/otus-banners-rotation/internal/app"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockContextualStrategy is a mock of ContextualStrategy interface.
+type MockContextualStrategy struct {
+	ctrl     *gomock.Controller
+	recorder *MockContextualStrategyMockRecorder
+}
+
+// MockContextualStrategyMockRecorder is the mock recorder for MockContextualStrategy.
+type MockContextualStrategyMockRecorder struct {
+	mock *MockContextualStrategy
+}
+
+// NewMockContextualStrategy creates a new mock instance.
+func NewMockContextualStrategy(ctrl *gomock.Controller) *MockContextualStrategy {
+	mock := &MockContextualStrategy{ctrl: ctrl}
+	mock.recorder = &MockContextualStrategyMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockContextualStrategy) EXPECT() *MockContextualStrategyMockRecorder {
+	return m.recorder
+}
+
+// SelectContextualBanner mocks base method.
+func (m *MockContextualStrategy) SelectContextualBanner(arg0 []app.LinearStats, arg1 []float64) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectContextualBanner", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// SelectContextualBanner indicates an expected call of SelectContextualBanner.
+func (mr *MockContextualStrategyMockRecorder) SelectContextualBanner(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectContextualBanner", reflect.TypeOf((*MockContextualStrategy)(nil).SelectContextualBanner), arg0, arg1)
+}
+
+// Vectorize mocks base method.
+func (m *MockContextualStrategy) Vectorize(arg0 app.Features) []float64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Vectorize", arg0)
+	ret0, _ := ret[0].([]float64)
+	return ret0
+}
+
+// Vectorize indicates an expected call of Vectorize.
+func (mr *MockContextualStrategyMockRecorder) Vectorize(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Vectorize", reflect.TypeOf((*MockContextualStrategy)(nil).Vectorize), arg0)
+}
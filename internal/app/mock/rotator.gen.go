@@ -8,6 +8,7 @@ import (
 	context "context"
 	reflect "reflect"
 
+	app "github.com/ekhvalov/otus-banners-rotation/internal/app"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -49,47 +50,47 @@ func (mr *MockRotatorMockRecorder) AttachBanner(arg0, arg1, arg2 interface{}) *g
 }
 
 // ClickBanner mocks base method.
-func (m *MockRotator) ClickBanner(arg0 context.Context, arg1, arg2, arg3 string) error {
+func (m *MockRotator) ClickBanner(arg0 context.Context, arg1, arg2, arg3 string, arg4 app.Features) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ClickBanner", arg0, arg1, arg2, arg3)
+	ret := m.ctrl.Call(m, "ClickBanner", arg0, arg1, arg2, arg3, arg4)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // ClickBanner indicates an expected call of ClickBanner.
-func (mr *MockRotatorMockRecorder) ClickBanner(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+func (mr *MockRotatorMockRecorder) ClickBanner(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClickBanner", reflect.TypeOf((*MockRotator)(nil).ClickBanner), arg0, arg1, arg2, arg3)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClickBanner", reflect.TypeOf((*MockRotator)(nil).ClickBanner), arg0, arg1, arg2, arg3, arg4)
 }
 
 // CreateBanner mocks base method.
-func (m *MockRotator) CreateBanner(arg0 context.Context, arg1 string) (string, error) {
+func (m *MockRotator) CreateBanner(arg0 context.Context, arg1 string, arg2 app.Creative, arg3 app.Flight) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateBanner", arg0, arg1)
+	ret := m.ctrl.Call(m, "CreateBanner", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateBanner indicates an expected call of CreateBanner.
-func (mr *MockRotatorMockRecorder) CreateBanner(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockRotatorMockRecorder) CreateBanner(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBanner", reflect.TypeOf((*MockRotator)(nil).CreateBanner), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBanner", reflect.TypeOf((*MockRotator)(nil).CreateBanner), arg0, arg1, arg2, arg3)
 }
 
 // CreateSlot mocks base method.
-func (m *MockRotator) CreateSlot(arg0 context.Context, arg1 string) (string, error) {
+func (m *MockRotator) CreateSlot(arg0 context.Context, arg1 string, arg2 app.StrategyParams, arg3 app.SlotFormat) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateSlot", arg0, arg1)
+	ret := m.ctrl.Call(m, "CreateSlot", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateSlot indicates an expected call of CreateSlot.
-func (mr *MockRotatorMockRecorder) CreateSlot(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockRotatorMockRecorder) CreateSlot(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSlot", reflect.TypeOf((*MockRotator)(nil).CreateSlot), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSlot", reflect.TypeOf((*MockRotator)(nil).CreateSlot), arg0, arg1, arg2, arg3)
 }
 
 // CreateSocialGroup mocks base method.
@@ -163,17 +164,187 @@ func (mr *MockRotatorMockRecorder) DetachBanner(arg0, arg1, arg2 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetachBanner", reflect.TypeOf((*MockRotator)(nil).DetachBanner), arg0, arg1, arg2)
 }
 
+// GetBanner mocks base method.
+func (m *MockRotator) GetBanner(arg0 context.Context, arg1 string) (app.Banner, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBanner", arg0, arg1)
+	ret0, _ := ret[0].(app.Banner)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBanner indicates an expected call of GetBanner.
+func (mr *MockRotatorMockRecorder) GetBanner(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBanner", reflect.TypeOf((*MockRotator)(nil).GetBanner), arg0, arg1)
+}
+
+// GetSlot mocks base method.
+func (m *MockRotator) GetSlot(arg0 context.Context, arg1 string) (app.Slot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSlot", arg0, arg1)
+	ret0, _ := ret[0].(app.Slot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSlot indicates an expected call of GetSlot.
+func (mr *MockRotatorMockRecorder) GetSlot(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSlot", reflect.TypeOf((*MockRotator)(nil).GetSlot), arg0, arg1)
+}
+
+// GetSocialGroup mocks base method.
+func (m *MockRotator) GetSocialGroup(arg0 context.Context, arg1 string) (app.SocialGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSocialGroup", arg0, arg1)
+	ret0, _ := ret[0].(app.SocialGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSocialGroup indicates an expected call of GetSocialGroup.
+func (mr *MockRotatorMockRecorder) GetSocialGroup(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSocialGroup", reflect.TypeOf((*MockRotator)(nil).GetSocialGroup), arg0, arg1)
+}
+
+// ListBannerSlots mocks base method.
+func (m *MockRotator) ListBannerSlots(arg0 context.Context, arg1 string, arg2 app.ListQuery) ([]app.AttachmentInfo, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBannerSlots", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]app.AttachmentInfo)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListBannerSlots indicates an expected call of ListBannerSlots.
+func (mr *MockRotatorMockRecorder) ListBannerSlots(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBannerSlots", reflect.TypeOf((*MockRotator)(nil).ListBannerSlots), arg0, arg1, arg2)
+}
+
+// ListBanners mocks base method.
+func (m *MockRotator) ListBanners(arg0 context.Context, arg1 app.ListQuery) ([]app.Banner, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBanners", arg0, arg1)
+	ret0, _ := ret[0].([]app.Banner)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListBanners indicates an expected call of ListBanners.
+func (mr *MockRotatorMockRecorder) ListBanners(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBanners", reflect.TypeOf((*MockRotator)(nil).ListBanners), arg0, arg1)
+}
+
+// ListSlotBanners mocks base method.
+func (m *MockRotator) ListSlotBanners(arg0 context.Context, arg1 string, arg2 app.ListQuery) ([]app.AttachmentInfo, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSlotBanners", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]app.AttachmentInfo)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListSlotBanners indicates an expected call of ListSlotBanners.
+func (mr *MockRotatorMockRecorder) ListSlotBanners(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSlotBanners", reflect.TypeOf((*MockRotator)(nil).ListSlotBanners), arg0, arg1, arg2)
+}
+
+// ListSlots mocks base method.
+func (m *MockRotator) ListSlots(arg0 context.Context, arg1 app.ListQuery) ([]app.Slot, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSlots", arg0, arg1)
+	ret0, _ := ret[0].([]app.Slot)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListSlots indicates an expected call of ListSlots.
+func (mr *MockRotatorMockRecorder) ListSlots(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSlots", reflect.TypeOf((*MockRotator)(nil).ListSlots), arg0, arg1)
+}
+
+// ListSocialGroups mocks base method.
+func (m *MockRotator) ListSocialGroups(arg0 context.Context, arg1 app.ListQuery) ([]app.SocialGroup, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSocialGroups", arg0, arg1)
+	ret0, _ := ret[0].([]app.SocialGroup)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListSocialGroups indicates an expected call of ListSocialGroups.
+func (mr *MockRotatorMockRecorder) ListSocialGroups(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSocialGroups", reflect.TypeOf((*MockRotator)(nil).ListSocialGroups), arg0, arg1)
+}
+
 // SelectBanner mocks base method.
-func (m *MockRotator) SelectBanner(arg0 context.Context, arg1, arg2 string) (string, error) {
+func (m *MockRotator) SelectBanner(arg0 context.Context, arg1, arg2 string, arg3 app.Features) (app.Banner, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SelectBanner", arg0, arg1, arg2)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "SelectBanner", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(app.Banner)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // SelectBanner indicates an expected call of SelectBanner.
-func (mr *MockRotatorMockRecorder) SelectBanner(arg0, arg1, arg2 interface{}) *gomock.Call {
+func (mr *MockRotatorMockRecorder) SelectBanner(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectBanner", reflect.TypeOf((*MockRotator)(nil).SelectBanner), arg0, arg1, arg2, arg3)
+}
+
+// UpdateBanner mocks base method.
+func (m *MockRotator) UpdateBanner(arg0 context.Context, arg1, arg2 string) (app.Banner, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateBanner", arg0, arg1, arg2)
+	ret0, _ := ret[0].(app.Banner)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateBanner indicates an expected call of UpdateBanner.
+func (mr *MockRotatorMockRecorder) UpdateBanner(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBanner", reflect.TypeOf((*MockRotator)(nil).UpdateBanner), arg0, arg1, arg2)
+}
+
+// UpdateSlot mocks base method.
+func (m *MockRotator) UpdateSlot(arg0 context.Context, arg1, arg2 string) (app.Slot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSlot", arg0, arg1, arg2)
+	ret0, _ := ret[0].(app.Slot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSlot indicates an expected call of UpdateSlot.
+func (mr *MockRotatorMockRecorder) UpdateSlot(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSlot", reflect.TypeOf((*MockRotator)(nil).UpdateSlot), arg0, arg1, arg2)
+}
+
+// UpdateSocialGroup mocks base method.
+func (m *MockRotator) UpdateSocialGroup(arg0 context.Context, arg1, arg2 string) (app.SocialGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSocialGroup", arg0, arg1, arg2)
+	ret0, _ := ret[0].(app.SocialGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSocialGroup indicates an expected call of UpdateSocialGroup.
+func (mr *MockRotatorMockRecorder) UpdateSocialGroup(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectBanner", reflect.TypeOf((*MockRotator)(nil).SelectBanner), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSocialGroup", reflect.TypeOf((*MockRotator)(nil).UpdateSocialGroup), arg0, arg1, arg2)
 }
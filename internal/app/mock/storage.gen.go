@@ -7,7 +7,9 @@ package mock
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
+	app "github.com/ekhvalov/otus-banners-rotation/internal/app"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -48,48 +50,34 @@ func (mr *MockStorageMockRecorder) AttachBanner(arg0, arg1, arg2 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttachBanner", reflect.TypeOf((*MockStorage)(nil).AttachBanner), arg0, arg1, arg2)
 }
 
-// ClickBanner mocks base method.
-func (m *MockStorage) ClickBanner(arg0 context.Context, arg1, arg2, arg3 string) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ClickBanner", arg0, arg1, arg2, arg3)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// ClickBanner indicates an expected call of ClickBanner.
-func (mr *MockStorageMockRecorder) ClickBanner(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClickBanner", reflect.TypeOf((*MockStorage)(nil).ClickBanner), arg0, arg1, arg2, arg3)
-}
-
 // CreateBanner mocks base method.
-func (m *MockStorage) CreateBanner(arg0 context.Context, arg1 string) (string, error) {
+func (m *MockStorage) CreateBanner(arg0 context.Context, arg1 string, arg2 app.Creative, arg3 app.Flight) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateBanner", arg0, arg1)
+	ret := m.ctrl.Call(m, "CreateBanner", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateBanner indicates an expected call of CreateBanner.
-func (mr *MockStorageMockRecorder) CreateBanner(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockStorageMockRecorder) CreateBanner(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBanner", reflect.TypeOf((*MockStorage)(nil).CreateBanner), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBanner", reflect.TypeOf((*MockStorage)(nil).CreateBanner), arg0, arg1, arg2, arg3)
 }
 
 // CreateSlot mocks base method.
-func (m *MockStorage) CreateSlot(arg0 context.Context, arg1 string) (string, error) {
+func (m *MockStorage) CreateSlot(arg0 context.Context, arg1 string, arg2 app.StrategyParams, arg3 app.SlotFormat) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateSlot", arg0, arg1)
+	ret := m.ctrl.Call(m, "CreateSlot", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateSlot indicates an expected call of CreateSlot.
-func (mr *MockStorageMockRecorder) CreateSlot(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockStorageMockRecorder) CreateSlot(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSlot", reflect.TypeOf((*MockStorage)(nil).CreateSlot), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSlot", reflect.TypeOf((*MockStorage)(nil).CreateSlot), arg0, arg1, arg2, arg3)
 }
 
 // CreateSocialGroup mocks base method.
@@ -163,17 +151,312 @@ func (mr *MockStorageMockRecorder) DetachBanner(arg0, arg1, arg2 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetachBanner", reflect.TypeOf((*MockStorage)(nil).DetachBanner), arg0, arg1, arg2)
 }
 
-// SelectBanner mocks base method.
-func (m *MockStorage) SelectBanner(arg0 context.Context, arg1, arg2 string) (string, error) {
+// ExportSnapshot mocks base method.
+func (m *MockStorage) ExportSnapshot(arg0 context.Context) (app.Snapshot, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SelectBanner", arg0, arg1, arg2)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "ExportSnapshot", arg0)
+	ret0, _ := ret[0].(app.Snapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportSnapshot indicates an expected call of ExportSnapshot.
+func (mr *MockStorageMockRecorder) ExportSnapshot(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportSnapshot", reflect.TypeOf((*MockStorage)(nil).ExportSnapshot), arg0)
+}
+
+// GetBanner mocks base method.
+func (m *MockStorage) GetBanner(arg0 context.Context, arg1 string) (app.Banner, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBanner", arg0, arg1)
+	ret0, _ := ret[0].(app.Banner)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBanner indicates an expected call of GetBanner.
+func (mr *MockStorageMockRecorder) GetBanner(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBanner", reflect.TypeOf((*MockStorage)(nil).GetBanner), arg0, arg1)
+}
+
+// GetBannerFlights mocks base method.
+func (m *MockStorage) GetBannerFlights(arg0 context.Context, arg1 []string) (map[string]app.Flight, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBannerFlights", arg0, arg1)
+	ret0, _ := ret[0].(map[string]app.Flight)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBannerFlights indicates an expected call of GetBannerFlights.
+func (mr *MockStorageMockRecorder) GetBannerFlights(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBannerFlights", reflect.TypeOf((*MockStorage)(nil).GetBannerFlights), arg0, arg1)
+}
+
+// GetLinearStats mocks base method.
+func (m *MockStorage) GetLinearStats(arg0 context.Context, arg1 string, arg2 int) ([]app.LinearStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLinearStats", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]app.LinearStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLinearStats indicates an expected call of GetLinearStats.
+func (mr *MockStorageMockRecorder) GetLinearStats(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLinearStats", reflect.TypeOf((*MockStorage)(nil).GetLinearStats), arg0, arg1, arg2)
+}
+
+// GetSlot mocks base method.
+func (m *MockStorage) GetSlot(arg0 context.Context, arg1 string) (app.Slot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSlot", arg0, arg1)
+	ret0, _ := ret[0].(app.Slot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSlot indicates an expected call of GetSlot.
+func (mr *MockStorageMockRecorder) GetSlot(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSlot", reflect.TypeOf((*MockStorage)(nil).GetSlot), arg0, arg1)
+}
+
+// GetSlotStats mocks base method.
+func (m *MockStorage) GetSlotStats(arg0 context.Context, arg1, arg2 string) (app.SlotStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSlotStats", arg0, arg1, arg2)
+	ret0, _ := ret[0].(app.SlotStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSlotStats indicates an expected call of GetSlotStats.
+func (mr *MockStorageMockRecorder) GetSlotStats(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSlotStats", reflect.TypeOf((*MockStorage)(nil).GetSlotStats), arg0, arg1, arg2)
+}
+
+// GetSlotStatsHistory mocks base method.
+func (m *MockStorage) GetSlotStatsHistory(arg0 context.Context, arg1, arg2 string, arg3 time.Time) ([]app.IntervalStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSlotStatsHistory", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]app.IntervalStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSlotStatsHistory indicates an expected call of GetSlotStatsHistory.
+func (mr *MockStorageMockRecorder) GetSlotStatsHistory(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSlotStatsHistory", reflect.TypeOf((*MockStorage)(nil).GetSlotStatsHistory), arg0, arg1, arg2, arg3)
+}
+
+// GetSocialGroup mocks base method.
+func (m *MockStorage) GetSocialGroup(arg0 context.Context, arg1 string) (app.SocialGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSocialGroup", arg0, arg1)
+	ret0, _ := ret[0].(app.SocialGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSocialGroup indicates an expected call of GetSocialGroup.
+func (mr *MockStorageMockRecorder) GetSocialGroup(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSocialGroup", reflect.TypeOf((*MockStorage)(nil).GetSocialGroup), arg0, arg1)
+}
+
+// ImportSnapshot mocks base method.
+func (m *MockStorage) ImportSnapshot(arg0 context.Context, arg1 app.Snapshot) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportSnapshot", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ImportSnapshot indicates an expected call of ImportSnapshot.
+func (mr *MockStorageMockRecorder) ImportSnapshot(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportSnapshot", reflect.TypeOf((*MockStorage)(nil).ImportSnapshot), arg0, arg1)
+}
+
+// ListBannerSlots mocks base method.
+func (m *MockStorage) ListBannerSlots(arg0 context.Context, arg1 string, arg2 app.ListFilter) ([]app.Attachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBannerSlots", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]app.Attachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBannerSlots indicates an expected call of ListBannerSlots.
+func (mr *MockStorageMockRecorder) ListBannerSlots(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBannerSlots", reflect.TypeOf((*MockStorage)(nil).ListBannerSlots), arg0, arg1, arg2)
+}
+
+// ListBanners mocks base method.
+func (m *MockStorage) ListBanners(arg0 context.Context, arg1 app.ListFilter) ([]app.Banner, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBanners", arg0, arg1)
+	ret0, _ := ret[0].([]app.Banner)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBanners indicates an expected call of ListBanners.
+func (mr *MockStorageMockRecorder) ListBanners(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBanners", reflect.TypeOf((*MockStorage)(nil).ListBanners), arg0, arg1)
+}
+
+// ListSlotBanners mocks base method.
+func (m *MockStorage) ListSlotBanners(arg0 context.Context, arg1 string, arg2 app.ListFilter) ([]app.Attachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSlotBanners", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]app.Attachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSlotBanners indicates an expected call of ListSlotBanners.
+func (mr *MockStorageMockRecorder) ListSlotBanners(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSlotBanners", reflect.TypeOf((*MockStorage)(nil).ListSlotBanners), arg0, arg1, arg2)
+}
+
+// ListSlots mocks base method.
+func (m *MockStorage) ListSlots(arg0 context.Context, arg1 app.ListFilter) ([]app.Slot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSlots", arg0, arg1)
+	ret0, _ := ret[0].([]app.Slot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSlots indicates an expected call of ListSlots.
+func (mr *MockStorageMockRecorder) ListSlots(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSlots", reflect.TypeOf((*MockStorage)(nil).ListSlots), arg0, arg1)
+}
+
+// ListSocialGroups mocks base method.
+func (m *MockStorage) ListSocialGroups(arg0 context.Context, arg1 app.ListFilter) ([]app.SocialGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSocialGroups", arg0, arg1)
+	ret0, _ := ret[0].([]app.SocialGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSocialGroups indicates an expected call of ListSocialGroups.
+func (mr *MockStorageMockRecorder) ListSocialGroups(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSocialGroups", reflect.TypeOf((*MockStorage)(nil).ListSocialGroups), arg0, arg1)
+}
+
+// RegisterClick mocks base method.
+func (m *MockStorage) RegisterClick(arg0 context.Context, arg1, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterClick", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RegisterClick indicates an expected call of RegisterClick.
+func (mr *MockStorageMockRecorder) RegisterClick(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterClick", reflect.TypeOf((*MockStorage)(nil).RegisterClick), arg0, arg1, arg2, arg3)
+}
+
+// RegisterLinearClick mocks base method.
+func (m *MockStorage) RegisterLinearClick(arg0 context.Context, arg1, arg2 string, arg3 []float64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterLinearClick", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RegisterLinearClick indicates an expected call of RegisterLinearClick.
+func (mr *MockStorageMockRecorder) RegisterLinearClick(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterLinearClick", reflect.TypeOf((*MockStorage)(nil).RegisterLinearClick), arg0, arg1, arg2, arg3)
+}
+
+// RegisterLinearSelect mocks base method.
+func (m *MockStorage) RegisterLinearSelect(arg0 context.Context, arg1, arg2 string, arg3 []float64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterLinearSelect", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RegisterLinearSelect indicates an expected call of RegisterLinearSelect.
+func (mr *MockStorageMockRecorder) RegisterLinearSelect(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterLinearSelect", reflect.TypeOf((*MockStorage)(nil).RegisterLinearSelect), arg0, arg1, arg2, arg3)
+}
+
+// RegisterSelect mocks base method.
+func (m *MockStorage) RegisterSelect(arg0 context.Context, arg1, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterSelect", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RegisterSelect indicates an expected call of RegisterSelect.
+func (mr *MockStorageMockRecorder) RegisterSelect(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterSelect", reflect.TypeOf((*MockStorage)(nil).RegisterSelect), arg0, arg1, arg2, arg3)
+}
+
+// UpdateBanner mocks base method.
+func (m *MockStorage) UpdateBanner(arg0 context.Context, arg1, arg2 string) (app.Banner, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateBanner", arg0, arg1, arg2)
+	ret0, _ := ret[0].(app.Banner)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateBanner indicates an expected call of UpdateBanner.
+func (mr *MockStorageMockRecorder) UpdateBanner(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBanner", reflect.TypeOf((*MockStorage)(nil).UpdateBanner), arg0, arg1, arg2)
+}
+
+// UpdateSlot mocks base method.
+func (m *MockStorage) UpdateSlot(arg0 context.Context, arg1, arg2 string) (app.Slot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSlot", arg0, arg1, arg2)
+	ret0, _ := ret[0].(app.Slot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSlot indicates an expected call of UpdateSlot.
+func (mr *MockStorageMockRecorder) UpdateSlot(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSlot", reflect.TypeOf((*MockStorage)(nil).UpdateSlot), arg0, arg1, arg2)
+}
+
+// UpdateSocialGroup mocks base method.
+func (m *MockStorage) UpdateSocialGroup(arg0 context.Context, arg1, arg2 string) (app.SocialGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSocialGroup", arg0, arg1, arg2)
+	ret0, _ := ret[0].(app.SocialGroup)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// SelectBanner indicates an expected call of SelectBanner.
-func (mr *MockStorageMockRecorder) SelectBanner(arg0, arg1, arg2 interface{}) *gomock.Call {
+// UpdateSocialGroup indicates an expected call of UpdateSocialGroup.
+func (mr *MockStorageMockRecorder) UpdateSocialGroup(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectBanner", reflect.TypeOf((*MockStorage)(nil).SelectBanner), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSocialGroup", reflect.TypeOf((*MockStorage)(nil).UpdateSocialGroup), arg0, arg1, arg2)
 }
@@ -0,0 +1,49 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/ekhvalov/otus-banners-rotation/internal/app (interfaces: Strategy)
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	reflect "reflect"
+
+	app "github.com/ekhvalov/otus-banners-rotation/internal/app"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockStrategy is a mock of Strategy interface.
+type MockStrategy struct {
+	ctrl     *gomock.Controller
+	recorder *MockStrategyMockRecorder
+}
+
+// MockStrategyMockRecorder is the mock recorder for MockStrategy.
+type MockStrategyMockRecorder struct {
+	mock *MockStrategy
+}
+
+// NewMockStrategy creates a new mock instance.
+func NewMockStrategy(ctrl *gomock.Controller) *MockStrategy {
+	mock := &MockStrategy{ctrl: ctrl}
+	mock.recorder = &MockStrategyMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStrategy) EXPECT() *MockStrategyMockRecorder {
+	return m.recorder
+}
+
+// SelectBanner mocks base method.
+func (m *MockStrategy) SelectBanner(arg0 app.SlotStats, arg1 app.Random) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectBanner", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// SelectBanner indicates an expected call of SelectBanner.
+func (mr *MockStrategyMockRecorder) SelectBanner(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectBanner", reflect.TypeOf((*MockStrategy)(nil).SelectBanner), arg0, arg1)
+}
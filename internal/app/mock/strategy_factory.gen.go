@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/ekhvalov/otus-banners-rotation/internal/app (interfaces: StrategyFactory)
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	reflect "reflect"
+
+	app "github.com/ekhvalov/otus-banners-rotation/internal/app"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockStrategyFactory is a mock of StrategyFactory interface.
+type MockStrategyFactory struct {
+	ctrl     *gomock.Controller
+	recorder *MockStrategyFactoryMockRecorder
+}
+
+// MockStrategyFactoryMockRecorder is the mock recorder for MockStrategyFactory.
+type MockStrategyFactoryMockRecorder struct {
+	mock *MockStrategyFactory
+}
+
+// NewMockStrategyFactory creates a new mock instance.
+func NewMockStrategyFactory(ctrl *gomock.Controller) *MockStrategyFactory {
+	mock := &MockStrategyFactory{ctrl: ctrl}
+	mock.recorder = &MockStrategyFactoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStrategyFactory) EXPECT() *MockStrategyFactoryMockRecorder {
+	return m.recorder
+}
+
+// CreateStrategy mocks base method.
+func (m *MockStrategyFactory) CreateStrategy(arg0 app.StrategyParams) (app.Strategy, app.ContextualStrategy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateStrategy", arg0)
+	ret0, _ := ret[0].(app.Strategy)
+	ret1, _ := ret[1].(app.ContextualStrategy)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateStrategy indicates an expected call of CreateStrategy.
+func (mr *MockStrategyFactoryMockRecorder) CreateStrategy(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateStrategy", reflect.TypeOf((*MockStrategyFactory)(nil).CreateStrategy), arg0)
+}
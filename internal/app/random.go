@@ -0,0 +1,59 @@
+package app
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// Random is a source of random numbers of the strategies.
+type Random interface {
+	Float64() float64
+	Intn(n int) int
+	NormFloat64() float64
+}
+
+// Clock is a source of the current time.
+type Clock interface {
+	Now() time.Time
+}
+
+// NewRandom creates a random source which is safe for concurrent use.
+// The same seed produces the same sequence of numbers.
+func NewRandom(seed int64) Random {
+	return &lockedRandom{random: rand.New(rand.NewSource(seed))} //nolint:gosec // Not used for security purposes
+}
+
+type lockedRandom struct {
+	mu     sync.Mutex
+	random *rand.Rand
+}
+
+func (r *lockedRandom) Float64() float64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.random.Float64()
+}
+
+func (r *lockedRandom) Intn(n int) int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.random.Intn(n)
+}
+
+func (r *lockedRandom) NormFloat64() float64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.random.NormFloat64()
+}
+
+// NewSystemClock creates a clock which returns the system time.
+func NewSystemClock() Clock {
+	return systemClock{}
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
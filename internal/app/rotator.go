@@ -4,7 +4,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"time"
 )
 
 var (
@@ -12,21 +11,51 @@ var (
 	ErrEmptyID          = errors.New("id is empty")
 )
 
-func NewRotator(storage Storage, eventQueue EventQueue, logger Logger) Rotator {
-	return rotator{storage: storage, eventQueue: eventQueue, logger: logger}
+// NewRotator creates a rotator. The random source is used by the strategies
+// and the clock is used for the events timestamps and the stats history.
+func NewRotator(
+	storage Storage,
+	strategies StrategyFactory,
+	eventQueue EventQueue,
+	logger Logger,
+	random Random,
+	clock Clock,
+) Rotator {
+	return rotator{
+		storage:    storage,
+		strategies: strategies,
+		eventQueue: eventQueue,
+		logger:     logger,
+		random:     random,
+		clock:      clock,
+	}
 }
 
 type rotator struct {
 	storage    Storage
+	strategies StrategyFactory
 	eventQueue EventQueue
 	logger     Logger
+	random     Random
+	clock      Clock
 }
 
-func (r rotator) CreateBanner(ctx context.Context, description string) (string, error) {
+func (r rotator) CreateBanner(
+	ctx context.Context,
+	description string,
+	creative Creative,
+	flight Flight,
+) (string, error) {
 	if description == "" {
 		return "", ErrEmptyDescription
 	}
-	id, err := r.storage.CreateBanner(ctx, description)
+	if err := creative.Validate(); err != nil {
+		return "", fmt.Errorf("create banner error: %w", err)
+	}
+	if err := flight.Validate(); err != nil {
+		return "", fmt.Errorf("create banner error: %w", err)
+	}
+	id, err := r.storage.CreateBanner(ctx, description, creative, flight)
 	if err != nil {
 		return "", fmt.Errorf("create banner error: %w", err)
 	}
@@ -44,11 +73,22 @@ func (r rotator) DeleteBanner(ctx context.Context, id string) error {
 	return nil
 }
 
-func (r rotator) CreateSlot(ctx context.Context, description string) (string, error) {
+func (r rotator) CreateSlot(
+	ctx context.Context,
+	description string,
+	strategy StrategyParams,
+	format SlotFormat,
+) (string, error) {
 	if description == "" {
 		return "", ErrEmptyDescription
 	}
-	id, err := r.storage.CreateSlot(ctx, description)
+	if _, _, err := r.strategies.CreateStrategy(strategy); err != nil {
+		return "", fmt.Errorf("create slot error: %w", err)
+	}
+	if err := format.Validate(); err != nil {
+		return "", fmt.Errorf("create slot error: %w", err)
+	}
+	id, err := r.storage.CreateSlot(ctx, description, strategy, format)
 	if err != nil {
 		return "", fmt.Errorf("create slot error: %w", err)
 	}
@@ -93,12 +133,35 @@ func (r rotator) AttachBanner(ctx context.Context, slotID, bannerID string) erro
 	if bannerID == "" {
 		return fmt.Errorf("banner id error: %w", ErrEmptyID)
 	}
+	if err := r.checkBannerFit(ctx, slotID, bannerID); err != nil {
+		return fmt.Errorf("attach banner error: %w", err)
+	}
 	if err := r.storage.AttachBanner(ctx, slotID, bannerID); err != nil {
 		return fmt.Errorf("attach banner error: %w", err)
 	}
 	return nil
 }
 
+// checkBannerFit returns ErrBannerNotFit in case of the banner creative does not fit the slot format.
+// The format and the creative are never changed, so the check is not a part of the storage attachment.
+func (r rotator) checkBannerFit(ctx context.Context, slotID, bannerID string) error {
+	slot, err := r.storage.GetSlot(ctx, slotID)
+	if err != nil {
+		return err
+	}
+	if slot.Format.IsEmpty() {
+		return nil
+	}
+	banner, err := r.storage.GetBanner(ctx, bannerID)
+	if err != nil {
+		return err
+	}
+	if err = slot.Format.Check(banner.Creative); err != nil {
+		return NewErrBannerNotFit(slotID, bannerID, err)
+	}
+	return nil
+}
+
 func (r rotator) DetachBanner(ctx context.Context, slotID, bannerID string) error {
 	if slotID == "" {
 		return fmt.Errorf("slot id error: %w", ErrEmptyID)
@@ -112,31 +175,332 @@ func (r rotator) DetachBanner(ctx context.Context, slotID, bannerID string) erro
 	return nil
 }
 
-func (r rotator) SelectBanner(ctx context.Context, slotID, socialGroupID string) (string, error) {
+func (r rotator) GetBanner(ctx context.Context, id string) (Banner, error) {
+	if id == "" {
+		return Banner{}, ErrEmptyID
+	}
+	banner, err := r.storage.GetBanner(ctx, id)
+	if err != nil {
+		return Banner{}, fmt.Errorf("get banner error: %w", err)
+	}
+	return banner, nil
+}
+
+func (r rotator) GetSlot(ctx context.Context, id string) (Slot, error) {
+	if id == "" {
+		return Slot{}, ErrEmptyID
+	}
+	slot, err := r.storage.GetSlot(ctx, id)
+	if err != nil {
+		return Slot{}, fmt.Errorf("get slot error: %w", err)
+	}
+	return slot, nil
+}
+
+func (r rotator) GetSocialGroup(ctx context.Context, id string) (SocialGroup, error) {
+	if id == "" {
+		return SocialGroup{}, ErrEmptyID
+	}
+	socialGroup, err := r.storage.GetSocialGroup(ctx, id)
+	if err != nil {
+		return SocialGroup{}, fmt.Errorf("get social group error: %w", err)
+	}
+	return socialGroup, nil
+}
+
+func (r rotator) ListBanners(ctx context.Context, query ListQuery) ([]Banner, string, error) {
+	filter, err := query.filter()
+	if err != nil {
+		return nil, "", fmt.Errorf("list banners error: %w", err)
+	}
+	banners, err := r.storage.ListBanners(ctx, filter)
+	if err != nil {
+		return nil, "", fmt.Errorf("list banners error: %w", err)
+	}
+	size, nextPageToken := filter.page(len(banners), func(i int) string { return banners[i].ID })
+	return banners[:size], nextPageToken, nil
+}
+
+func (r rotator) ListSlots(ctx context.Context, query ListQuery) ([]Slot, string, error) {
+	filter, err := query.filter()
+	if err != nil {
+		return nil, "", fmt.Errorf("list slots error: %w", err)
+	}
+	slots, err := r.storage.ListSlots(ctx, filter)
+	if err != nil {
+		return nil, "", fmt.Errorf("list slots error: %w", err)
+	}
+	size, nextPageToken := filter.page(len(slots), func(i int) string { return slots[i].ID })
+	return slots[:size], nextPageToken, nil
+}
+
+func (r rotator) ListSocialGroups(ctx context.Context, query ListQuery) ([]SocialGroup, string, error) {
+	filter, err := query.filter()
+	if err != nil {
+		return nil, "", fmt.Errorf("list social groups error: %w", err)
+	}
+	socialGroups, err := r.storage.ListSocialGroups(ctx, filter)
+	if err != nil {
+		return nil, "", fmt.Errorf("list social groups error: %w", err)
+	}
+	size, nextPageToken := filter.page(len(socialGroups), func(i int) string { return socialGroups[i].ID })
+	return socialGroups[:size], nextPageToken, nil
+}
+
+func (r rotator) ListSlotBanners(
+	ctx context.Context,
+	slotID string,
+	query ListQuery,
+) ([]AttachmentInfo, string, error) {
 	if slotID == "" {
-		return "", fmt.Errorf("slot id error: %w", ErrEmptyID)
+		return nil, "", fmt.Errorf("slot id error: %w", ErrEmptyID)
+	}
+	filter, err := query.filter()
+	if err != nil {
+		return nil, "", fmt.Errorf("list slot banners error: %w", err)
+	}
+	attachments, err := r.storage.ListSlotBanners(ctx, slotID, filter)
+	if err != nil {
+		return nil, "", fmt.Errorf("list slot banners error: %w", err)
+	}
+	size, nextPageToken := filter.page(len(attachments), func(i int) string { return attachments[i].BannerID })
+	infos, err := r.makeAttachmentInfos(ctx, attachments[:size])
+	if err != nil {
+		return nil, "", fmt.Errorf("list slot banners error: %w", err)
+	}
+	return infos, nextPageToken, nil
+}
+
+func (r rotator) ListBannerSlots(
+	ctx context.Context,
+	bannerID string,
+	query ListQuery,
+) ([]AttachmentInfo, string, error) {
+	if bannerID == "" {
+		return nil, "", fmt.Errorf("banner id error: %w", ErrEmptyID)
+	}
+	filter, err := query.filter()
+	if err != nil {
+		return nil, "", fmt.Errorf("list banner slots error: %w", err)
+	}
+	attachments, err := r.storage.ListBannerSlots(ctx, bannerID, filter)
+	if err != nil {
+		return nil, "", fmt.Errorf("list banner slots error: %w", err)
+	}
+	size, nextPageToken := filter.page(len(attachments), func(i int) string { return attachments[i].SlotID })
+	infos, err := r.makeAttachmentInfos(ctx, attachments[:size])
+	if err != nil {
+		return nil, "", fmt.Errorf("list banner slots error: %w", err)
+	}
+	return infos, nextPageToken, nil
+}
+
+// makeAttachmentInfos adds the current statuses by the flights of the banners to the attachments.
+func (r rotator) makeAttachmentInfos(ctx context.Context, attachments []Attachment) ([]AttachmentInfo, error) {
+	var bannerIDs []string
+	seen := make(map[string]bool, len(attachments))
+	for _, attachment := range attachments {
+		if !seen[attachment.BannerID] {
+			seen[attachment.BannerID] = true
+			bannerIDs = append(bannerIDs, attachment.BannerID)
+		}
+	}
+	flights, err := r.storage.GetBannerFlights(ctx, bannerIDs)
+	if err != nil {
+		return nil, err
+	}
+	return makeAttachmentInfos(attachments, flights, r.clock.Now()), nil
+}
+
+func (r rotator) UpdateBanner(ctx context.Context, id, description string) (Banner, error) {
+	if id == "" {
+		return Banner{}, ErrEmptyID
+	}
+	if description == "" {
+		return Banner{}, ErrEmptyDescription
+	}
+	banner, err := r.storage.UpdateBanner(ctx, id, description)
+	if err != nil {
+		return Banner{}, fmt.Errorf("update banner error: %w", err)
+	}
+	return banner, nil
+}
+
+func (r rotator) UpdateSlot(ctx context.Context, id, description string) (Slot, error) {
+	if id == "" {
+		return Slot{}, ErrEmptyID
+	}
+	if description == "" {
+		return Slot{}, ErrEmptyDescription
+	}
+	slot, err := r.storage.UpdateSlot(ctx, id, description)
+	if err != nil {
+		return Slot{}, fmt.Errorf("update slot error: %w", err)
+	}
+	return slot, nil
+}
+
+func (r rotator) UpdateSocialGroup(ctx context.Context, id, description string) (SocialGroup, error) {
+	if id == "" {
+		return SocialGroup{}, ErrEmptyID
+	}
+	if description == "" {
+		return SocialGroup{}, ErrEmptyDescription
+	}
+	socialGroup, err := r.storage.UpdateSocialGroup(ctx, id, description)
+	if err != nil {
+		return SocialGroup{}, fmt.Errorf("update social group error: %w", err)
+	}
+	return socialGroup, nil
+}
+
+func (r rotator) SelectBanner(
+	ctx context.Context,
+	slotID, socialGroupID string,
+	features Features,
+) (Banner, error) {
+	if slotID == "" {
+		return Banner{}, fmt.Errorf("slot id error: %w", ErrEmptyID)
 	}
 	if socialGroupID == "" {
-		return "", fmt.Errorf("social group id error: %w", ErrEmptyID)
+		return Banner{}, fmt.Errorf("social group id error: %w", ErrEmptyID)
+	}
+	slot, err := r.storage.GetSlot(ctx, slotID)
+	if err != nil {
+		return Banner{}, fmt.Errorf("select banner error: %w", err)
+	}
+	strategy, contextual, err := r.strategies.CreateStrategy(slot.Strategy)
+	if err != nil {
+		return Banner{}, fmt.Errorf("select banner error: %w", err)
+	}
+	var bannerID string
+	var vector []float64
+	if contextual != nil {
+		vector = contextual.Vectorize(withSocialGroup(features, socialGroupID))
+		bannerID, err = r.selectContextualBanner(ctx, slotID, contextual, vector)
+	} else {
+		bannerID, err = r.selectBanner(ctx, slot, socialGroupID, strategy)
+	}
+	if err != nil {
+		return Banner{}, fmt.Errorf("select banner error: %w", err)
+	}
+	if bannerID == "" {
+		return Banner{}, fmt.Errorf("select banner error: %w", ErrNoBannersFound)
 	}
-	bannerID, err := r.storage.SelectBanner(ctx, slotID, socialGroupID)
+	// The banner is read before the select is registered, so a select of a banner which is deleted concurrently
+	// is not counted.
+	banner, err := r.storage.GetBanner(ctx, bannerID)
 	if err != nil {
-		return "", fmt.Errorf("select banner error: %w", err)
+		return Banner{}, fmt.Errorf("select banner error: %w", err)
+	}
+	if err = r.storage.RegisterSelect(ctx, slotID, bannerID, socialGroupID); err != nil {
+		return Banner{}, fmt.Errorf("select banner error: %w", err)
+	}
+	if vector != nil {
+		if err = r.storage.RegisterLinearSelect(ctx, slotID, bannerID, vector); err != nil {
+			return Banner{}, fmt.Errorf("select banner error: %w", err)
+		}
 	}
 	event := Event{
 		Type:           EventSelect,
 		SlotID:         slotID,
 		BannerID:       bannerID,
 		SocialGroupID:  socialGroupID,
-		TimestampMicro: time.Now().UnixMicro(),
+		TimestampMicro: r.clock.Now().UnixMicro(),
 	}
 	if err = r.eventQueue.Put(ctx, event); err != nil {
 		r.logger.Error(fmt.Sprintf("put EventSelect to queue error: %v", err))
 	}
-	return bannerID, nil
+	return banner, nil
+}
+
+func (r rotator) selectBanner(ctx context.Context, slot Slot, socialGroupID string, strategy Strategy) (string, error) {
+	stats, err := r.getSlotStats(ctx, slot, socialGroupID)
+	if err != nil {
+		return "", err
+	}
+	bannerIDs := make([]string, len(stats.Banners))
+	for i, banner := range stats.Banners {
+		bannerIDs[i] = banner.BannerID
+	}
+	outOfFlight, err := r.getOutOfFlightBanners(ctx, bannerIDs)
+	if err != nil {
+		return "", err
+	}
+	if len(outOfFlight) > 0 {
+		banners := make([]BannerStats, 0, len(stats.Banners))
+		for _, banner := range stats.Banners {
+			if !outOfFlight[banner.BannerID] {
+				banners = append(banners, banner)
+			}
+		}
+		stats.Banners = banners
+	}
+	return strategy.SelectBanner(stats, r.random), nil
 }
 
-func (r rotator) ClickBanner(ctx context.Context, slotID, bannerID, socialGroupID string) error {
+func (r rotator) selectContextualBanner(
+	ctx context.Context,
+	slotID string,
+	strategy ContextualStrategy,
+	vector []float64,
+) (string, error) {
+	stats, err := r.storage.GetLinearStats(ctx, slotID, len(vector))
+	if err != nil {
+		return "", err
+	}
+	bannerIDs := make([]string, len(stats))
+	for i, banner := range stats {
+		bannerIDs[i] = banner.BannerID
+	}
+	outOfFlight, err := r.getOutOfFlightBanners(ctx, bannerIDs)
+	if err != nil {
+		return "", err
+	}
+	if len(outOfFlight) > 0 {
+		banners := make([]LinearStats, 0, len(stats))
+		for _, banner := range stats {
+			if !outOfFlight[banner.BannerID] {
+				banners = append(banners, banner)
+			}
+		}
+		stats = banners
+	}
+	return strategy.SelectContextualBanner(stats, vector), nil
+}
+
+// getOutOfFlightBanners returns the ids of the banners which are not selected now because of their flights.
+// The banners are skipped by the selection only, so their counters are kept.
+func (r rotator) getOutOfFlightBanners(ctx context.Context, bannerIDs []string) (map[string]bool, error) {
+	flights, err := r.storage.GetBannerFlights(ctx, bannerIDs)
+	if err != nil {
+		return nil, err
+	}
+	now := r.clock.Now()
+	outOfFlight := make(map[string]bool)
+	for bannerID, flight := range flights {
+		if flight.Status(now) != AttachmentStatusActive {
+			outOfFlight[bannerID] = true
+		}
+	}
+	return outOfFlight, nil
+}
+
+// getSlotStats returns all-time counters or aggregated recent counters depending on the slot strategy.
+func (r rotator) getSlotStats(ctx context.Context, slot Slot, socialGroupID string) (SlotStats, error) {
+	period := statsHistoryPeriod(slot.Strategy)
+	if period == 0 {
+		return r.storage.GetSlotStats(ctx, slot.ID, socialGroupID)
+	}
+	now := r.clock.Now()
+	history, err := r.storage.GetSlotStatsHistory(ctx, slot.ID, socialGroupID, now.Add(-period))
+	if err != nil {
+		return SlotStats{}, err
+	}
+	return AggregateStats(history, now, slot.Strategy.Discount), nil
+}
+
+func (r rotator) ClickBanner(ctx context.Context, slotID, bannerID, socialGroupID string, features Features) error {
 	if slotID == "" {
 		return fmt.Errorf("slot id error: %w", ErrEmptyID)
 	}
@@ -146,7 +510,10 @@ func (r rotator) ClickBanner(ctx context.Context, slotID, bannerID, socialGroupI
 	if socialGroupID == "" {
 		return fmt.Errorf("social group id error: %w", ErrEmptyID)
 	}
-	if err := r.storage.ClickBanner(ctx, slotID, bannerID, socialGroupID); err != nil {
+	if err := r.storage.RegisterClick(ctx, slotID, bannerID, socialGroupID); err != nil {
+		return fmt.Errorf("click banner error: %w", err)
+	}
+	if err := r.registerLinearClick(ctx, slotID, bannerID, socialGroupID, features); err != nil {
 		return fmt.Errorf("click banner error: %w", err)
 	}
 	event := Event{
@@ -154,10 +521,31 @@ func (r rotator) ClickBanner(ctx context.Context, slotID, bannerID, socialGroupI
 		SlotID:         slotID,
 		BannerID:       bannerID,
 		SocialGroupID:  socialGroupID,
-		TimestampMicro: time.Now().UnixMicro(),
+		TimestampMicro: r.clock.Now().UnixMicro(),
 	}
 	if err := r.eventQueue.Put(ctx, event); err != nil {
 		r.logger.Error(fmt.Sprintf("put EventClick to queue error: %v", err))
 	}
 	return nil
 }
+
+// registerLinearClick updates the linear model of a banner in case of the slot uses a contextual strategy.
+func (r rotator) registerLinearClick(
+	ctx context.Context,
+	slotID, bannerID, socialGroupID string,
+	features Features,
+) error {
+	slot, err := r.storage.GetSlot(ctx, slotID)
+	if err != nil {
+		return err
+	}
+	_, contextual, err := r.strategies.CreateStrategy(slot.Strategy)
+	if err != nil {
+		return err
+	}
+	if contextual == nil {
+		return nil
+	}
+	vector := contextual.Vectorize(withSocialGroup(features, socialGroupID))
+	return r.storage.RegisterLinearClick(ctx, slotID, bannerID, vector)
+}
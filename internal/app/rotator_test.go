@@ -10,6 +10,9 @@ import (
 
 	"github.com/ekhvalov/otus-banners-rotation/internal/app"
 	"github.com/ekhvalov/otus-banners-rotation/internal/app/mock"
+	"github.com/ekhvalov/otus-banners-rotation/internal/app/strategy"
+	"github.com/ekhvalov/otus-banners-rotation/internal/environment/idgenerator"
+	"github.com/ekhvalov/otus-banners-rotation/internal/environment/storage/memory"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 )
@@ -23,8 +26,27 @@ var (
 	slotID           = "100600"
 	socialGroupID    = "100700"
 	errStorage       = errors.New("storage error")
+	features         = app.Features{"device": "mobile"}
+	seed             = int64(100800)
+	clock            = fixedClock{now: time.Date(2022, time.October, 1, 12, 0, 0, 0, time.UTC)}
+	creative         = app.Creative{
+		TargetURL: "https://example.com/landing",
+		ImageURL:  "https://cdn.example.com/banner.png",
+		Width:     300,
+		Height:    250,
+		MIMEType:  "image/png",
+		Metadata:  map[string]string{"campaign": "autumn"},
+	}
 )
 
+type fixedClock struct {
+	now time.Time
+}
+
+func (c fixedClock) Now() time.Time {
+	return c.now
+}
+
 var testsCreateX = map[string]struct {
 	isMockExpected        bool
 	mockExpectDescription string
@@ -56,7 +78,7 @@ var testsCreateX = map[string]struct {
 	},
 }
 
-//nolint:dupl // Ignore duplication with TestRotator_CreateSlot
+//nolint:dupl // Ignore duplication with TestRotator_CreateSocialGroup
 func TestRotator_CreateBanner(t *testing.T) {
 	for testName, tt := range testsCreateX {
 		t.Run(testName, func(t *testing.T) {
@@ -65,12 +87,19 @@ func TestRotator_CreateBanner(t *testing.T) {
 			storage := mock.NewMockStorage(controller)
 			if tt.isMockExpected {
 				storage.EXPECT().
-					CreateBanner(context.Background(), tt.mockExpectDescription).
+					CreateBanner(context.Background(), tt.mockExpectDescription, creative, app.Flight{}).
 					Return(tt.mockReturnID, tt.mockReturnErr)
 			}
-			rotator := app.NewRotator(storage, mock.NewMockEventQueue(controller), mock.NewMockLogger(controller))
+			rotator := app.NewRotator(
+				storage,
+				mock.NewMockStrategyFactory(controller),
+				mock.NewMockEventQueue(controller),
+				mock.NewMockLogger(controller),
+				app.NewRandom(seed),
+				clock,
+			)
 
-			got, err := rotator.CreateBanner(context.Background(), tt.description)
+			got, err := rotator.CreateBanner(context.Background(), tt.description, creative, app.Flight{})
 
 			if tt.err == nil {
 				require.NoError(t, err)
@@ -83,21 +112,31 @@ func TestRotator_CreateBanner(t *testing.T) {
 	}
 }
 
-//nolint:dupl // Ignore duplication with TestRotator_CreateSocialGroup
 func TestRotator_CreateSlot(t *testing.T) {
 	for testName, tt := range testsCreateX {
 		t.Run(testName, func(t *testing.T) {
 			controller := gomock.NewController(t)
 			defer controller.Finish()
 			storage := mock.NewMockStorage(controller)
+			strategies := mock.NewMockStrategyFactory(controller)
 			if tt.isMockExpected {
+				strategies.EXPECT().
+					CreateStrategy(app.StrategyParams{}).
+					Return(mock.NewMockStrategy(controller), nil, nil)
 				storage.EXPECT().
-					CreateSlot(context.Background(), tt.mockExpectDescription).
+					CreateSlot(context.Background(), tt.mockExpectDescription, app.StrategyParams{}, app.SlotFormat{}).
 					Return(tt.mockReturnID, tt.mockReturnErr)
 			}
-			rotator := app.NewRotator(storage, mock.NewMockEventQueue(controller), mock.NewMockLogger(controller))
+			rotator := app.NewRotator(
+				storage,
+				strategies,
+				mock.NewMockEventQueue(controller),
+				mock.NewMockLogger(controller),
+				app.NewRandom(seed),
+				clock,
+			)
 
-			got, err := rotator.CreateSlot(context.Background(), tt.description)
+			got, err := rotator.CreateSlot(context.Background(), tt.description, app.StrategyParams{}, app.SlotFormat{})
 
 			if tt.err == nil {
 				require.NoError(t, err)
@@ -110,6 +149,29 @@ func TestRotator_CreateSlot(t *testing.T) {
 	}
 }
 
+func TestRotator_CreateSlot_InvalidStrategy(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	params := app.StrategyParams{Name: "unknown"}
+	strategies := mock.NewMockStrategyFactory(controller)
+	strategies.EXPECT().
+		CreateStrategy(params).
+		Return(nil, nil, app.ErrInvalidStrategy)
+	rotator := app.NewRotator(
+		mock.NewMockStorage(controller),
+		strategies,
+		mock.NewMockEventQueue(controller),
+		mock.NewMockLogger(controller),
+		app.NewRandom(seed),
+		clock,
+	)
+
+	got, err := rotator.CreateSlot(context.Background(), description, params, app.SlotFormat{})
+
+	require.ErrorIs(t, err, app.ErrInvalidStrategy)
+	require.Empty(t, got)
+}
+
 //nolint:dupl // Ignore duplication with TestRotator_CreateBanner
 func TestRotator_CreateSocialGroup(t *testing.T) {
 	for testName, tt := range testsCreateX {
@@ -122,7 +184,14 @@ func TestRotator_CreateSocialGroup(t *testing.T) {
 					CreateSocialGroup(context.Background(), tt.mockExpectDescription).
 					Return(tt.mockReturnID, tt.mockReturnErr)
 			}
-			rotator := app.NewRotator(storage, mock.NewMockEventQueue(controller), mock.NewMockLogger(controller))
+			rotator := app.NewRotator(
+				storage,
+				mock.NewMockStrategyFactory(controller),
+				mock.NewMockEventQueue(controller),
+				mock.NewMockLogger(controller),
+				app.NewRandom(seed),
+				clock,
+			)
 
 			got, err := rotator.CreateSocialGroup(context.Background(), tt.description)
 
@@ -175,7 +244,14 @@ func TestRotator_DeleteBanner(t *testing.T) {
 					DeleteBanner(context.Background(), tt.mockExpectID).
 					Return(tt.mockReturnErr)
 			}
-			rotator := app.NewRotator(storage, mock.NewMockEventQueue(controller), mock.NewMockLogger(controller))
+			rotator := app.NewRotator(
+				storage,
+				mock.NewMockStrategyFactory(controller),
+				mock.NewMockEventQueue(controller),
+				mock.NewMockLogger(controller),
+				app.NewRandom(seed),
+				clock,
+			)
 
 			err := rotator.DeleteBanner(context.Background(), tt.id)
 
@@ -199,7 +275,14 @@ func TestRotator_DeleteSlot(t *testing.T) {
 					DeleteSlot(context.Background(), tt.mockExpectID).
 					Return(tt.mockReturnErr)
 			}
-			rotator := app.NewRotator(storage, mock.NewMockEventQueue(controller), mock.NewMockLogger(controller))
+			rotator := app.NewRotator(
+				storage,
+				mock.NewMockStrategyFactory(controller),
+				mock.NewMockEventQueue(controller),
+				mock.NewMockLogger(controller),
+				app.NewRandom(seed),
+				clock,
+			)
 
 			err := rotator.DeleteSlot(context.Background(), tt.id)
 
@@ -223,7 +306,14 @@ func TestRotator_DeleteSocialGroup(t *testing.T) {
 					DeleteSocialGroup(context.Background(), tt.mockExpectID).
 					Return(tt.mockReturnErr)
 			}
-			rotator := app.NewRotator(storage, mock.NewMockEventQueue(controller), mock.NewMockLogger(controller))
+			rotator := app.NewRotator(
+				storage,
+				mock.NewMockStrategyFactory(controller),
+				mock.NewMockEventQueue(controller),
+				mock.NewMockLogger(controller),
+				app.NewRandom(seed),
+				clock,
+			)
 
 			err := rotator.DeleteSocialGroup(context.Background(), tt.id)
 
@@ -282,11 +372,21 @@ func TestRotator_AttachBanner(t *testing.T) {
 			defer controller.Finish()
 			storage := mock.NewMockStorage(controller)
 			if tt.isMockExpected {
+				storage.EXPECT().
+					GetSlot(context.Background(), tt.mockExpectSlotID).
+					Return(app.Slot{ID: tt.mockExpectSlotID}, nil)
 				storage.EXPECT().
 					AttachBanner(context.Background(), tt.mockExpectSlotID, tt.mockExpectBannerID).
 					Return(tt.mockReturnErr)
 			}
-			rotator := app.NewRotator(storage, mock.NewMockEventQueue(controller), mock.NewMockLogger(controller))
+			rotator := app.NewRotator(
+				storage,
+				mock.NewMockStrategyFactory(controller),
+				mock.NewMockEventQueue(controller),
+				mock.NewMockLogger(controller),
+				app.NewRandom(seed),
+				clock,
+			)
 
 			err := rotator.AttachBanner(context.Background(), tt.slotID, tt.bannerID)
 
@@ -310,7 +410,14 @@ func TestRotator_DetachBanner(t *testing.T) {
 					DetachBanner(context.Background(), tt.mockExpectSlotID, tt.mockExpectBannerID).
 					Return(tt.mockReturnErr)
 			}
-			rotator := app.NewRotator(storage, mock.NewMockEventQueue(controller), mock.NewMockLogger(controller))
+			rotator := app.NewRotator(
+				storage,
+				mock.NewMockStrategyFactory(controller),
+				mock.NewMockEventQueue(controller),
+				mock.NewMockLogger(controller),
+				app.NewRandom(seed),
+				clock,
+			)
 
 			err := rotator.DetachBanner(context.Background(), tt.slotID, tt.bannerID)
 
@@ -349,13 +456,7 @@ func (m eventMatcher) Matches(x interface{}) bool {
 	if m.event.SocialGroupID != event.SocialGroupID {
 		return false
 	}
-	if event.TimestampMicro == 0 {
-		return false
-	}
-	if event.TimestampMicro < m.event.TimestampMicro {
-		return false
-	}
-	return true
+	return m.event.TimestampMicro == event.TimestampMicro
 }
 
 func (m eventMatcher) String() string {
@@ -363,12 +464,24 @@ func (m eventMatcher) String() string {
 }
 
 func TestRotator_SelectBanner(t *testing.T) {
+	stats := app.SlotStats{
+		Banners:      []app.BannerStats{{BannerID: bannerID, Selects: 1, Clicks: 1}},
+		TotalSelects: 1,
+	}
+	slot := app.Slot{ID: slotID, Description: description, Strategy: app.StrategyParams{Name: "ucb1"}}
+	windowSlot := app.Slot{ID: slotID, Description: description, Strategy: app.StrategyParams{Window: time.Hour}}
+	contextualSlot := app.Slot{ID: slotID, Description: description, Strategy: app.StrategyParams{Name: "lin_ucb"}}
+	vector := []float64{1, 0, 1}
+	linearStats := []app.LinearStats{{BannerID: bannerID}}
+	endedBannerID := "100800"
+	banner := app.Banner{ID: bannerID, Description: description, Creative: creative}
 	tests := map[string]struct {
 		mockStorage    func(controller *gomock.Controller) app.Storage
+		mockStrategies func(controller *gomock.Controller) app.StrategyFactory
 		mockEventQueue func(controller *gomock.Controller) app.EventQueue
 		slotID         string
 		socialGroupID  string
-		wantID         string
+		want           app.Banner
 		err            error
 	}{
 		"empty slot id": {
@@ -381,26 +494,345 @@ func TestRotator_SelectBanner(t *testing.T) {
 			socialGroupID: emptyID,
 			err:           app.ErrEmptyID,
 		},
-		"storage error": {
+		"storage get slot error": {
+			mockStorage: func(controller *gomock.Controller) app.Storage {
+				storage := mock.NewMockStorage(controller)
+				storage.EXPECT().
+					GetSlot(context.Background(), slotID).
+					Return(app.Slot{}, errStorage)
+				return storage
+			},
+			slotID:        slotID,
+			socialGroupID: socialGroupID,
+			err:           errStorage,
+		},
+		"invalid strategy": {
+			mockStorage: func(controller *gomock.Controller) app.Storage {
+				storage := mock.NewMockStorage(controller)
+				storage.EXPECT().
+					GetSlot(context.Background(), slotID).
+					Return(slot, nil)
+				return storage
+			},
+			mockStrategies: func(controller *gomock.Controller) app.StrategyFactory {
+				strategies := mock.NewMockStrategyFactory(controller)
+				strategies.EXPECT().CreateStrategy(slot.Strategy).Return(nil, nil, app.ErrInvalidStrategy)
+				return strategies
+			},
+			slotID:        slotID,
+			socialGroupID: socialGroupID,
+			err:           app.ErrInvalidStrategy,
+		},
+		"storage get stats error": {
+			mockStorage: func(controller *gomock.Controller) app.Storage {
+				storage := mock.NewMockStorage(controller)
+				storage.EXPECT().
+					GetSlot(context.Background(), slotID).
+					Return(slot, nil)
+				storage.EXPECT().
+					GetSlotStats(context.Background(), slotID, socialGroupID).
+					Return(app.SlotStats{}, errStorage)
+				return storage
+			},
+			mockStrategies: func(controller *gomock.Controller) app.StrategyFactory {
+				strategies := mock.NewMockStrategyFactory(controller)
+				strategies.EXPECT().CreateStrategy(slot.Strategy).Return(mock.NewMockStrategy(controller), nil, nil)
+				return strategies
+			},
+			slotID:        slotID,
+			socialGroupID: socialGroupID,
+			err:           errStorage,
+		},
+		"storage get stats history error": {
+			mockStorage: func(controller *gomock.Controller) app.Storage {
+				storage := mock.NewMockStorage(controller)
+				storage.EXPECT().
+					GetSlot(context.Background(), slotID).
+					Return(windowSlot, nil)
+				storage.EXPECT().
+					GetSlotStatsHistory(context.Background(), slotID, socialGroupID, gomock.Any()).
+					Return(nil, errStorage)
+				return storage
+			},
+			mockStrategies: func(controller *gomock.Controller) app.StrategyFactory {
+				strategies := mock.NewMockStrategyFactory(controller)
+				strategies.EXPECT().CreateStrategy(windowSlot.Strategy).Return(mock.NewMockStrategy(controller), nil, nil)
+				return strategies
+			},
+			slotID:        slotID,
+			socialGroupID: socialGroupID,
+			err:           errStorage,
+		},
+		"sliding window": {
+			mockStorage: func(controller *gomock.Controller) app.Storage {
+				storage := mock.NewMockStorage(controller)
+				storage.EXPECT().
+					GetSlot(context.Background(), slotID).
+					Return(windowSlot, nil)
+				storage.EXPECT().
+					GetSlotStatsHistory(context.Background(), slotID, socialGroupID, gomock.Any()).
+					Return([]app.IntervalStats{{Start: clock.Now(), Stats: stats}, {Start: clock.Now(), Stats: stats}}, nil)
+				storage.EXPECT().
+					GetBannerFlights(context.Background(), []string{bannerID}).
+					Return(nil, nil)
+				storage.EXPECT().
+					GetBanner(context.Background(), bannerID).
+					Return(banner, nil)
+				storage.EXPECT().
+					RegisterSelect(context.Background(), slotID, bannerID, socialGroupID).
+					Return(nil)
+				return storage
+			},
+			mockStrategies: func(controller *gomock.Controller) app.StrategyFactory {
+				windowStats := app.SlotStats{
+					Banners:      []app.BannerStats{{BannerID: bannerID, Selects: 2, Clicks: 2}},
+					TotalSelects: 2,
+				}
+				return mockStrategies(controller, windowSlot.Strategy, windowStats, bannerID)
+			},
+			mockEventQueue: func(controller *gomock.Controller) app.EventQueue {
+				eventQueue := mock.NewMockEventQueue(controller)
+				eventQueue.EXPECT().Put(context.Background(), gomock.Any()).Return(nil)
+				return eventQueue
+			},
+			slotID:        slotID,
+			socialGroupID: socialGroupID,
+			want:          banner,
+			err:           nil,
+		},
+		"strategy selects nothing": {
+			mockStorage: func(controller *gomock.Controller) app.Storage {
+				storage := mock.NewMockStorage(controller)
+				storage.EXPECT().
+					GetSlot(context.Background(), slotID).
+					Return(slot, nil)
+				storage.EXPECT().
+					GetSlotStats(context.Background(), slotID, socialGroupID).
+					Return(stats, nil)
+				storage.EXPECT().
+					GetBannerFlights(context.Background(), []string{bannerID}).
+					Return(nil, nil)
+				return storage
+			},
+			mockStrategies: func(controller *gomock.Controller) app.StrategyFactory {
+				return mockStrategies(controller, slot.Strategy, stats, emptyID)
+			},
+			slotID:        slotID,
+			socialGroupID: socialGroupID,
+			err:           app.ErrNoBannersFound,
+		},
+		"banner out of flight": {
+			mockStorage: func(controller *gomock.Controller) app.Storage {
+				storage := mock.NewMockStorage(controller)
+				storage.EXPECT().
+					GetSlot(context.Background(), slotID).
+					Return(slot, nil)
+				storage.EXPECT().
+					GetSlotStats(context.Background(), slotID, socialGroupID).
+					Return(app.SlotStats{
+						Banners:      append([]app.BannerStats{{BannerID: endedBannerID, Selects: 5}}, stats.Banners...),
+						TotalSelects: 6,
+					}, nil)
+				storage.EXPECT().
+					GetBannerFlights(context.Background(), []string{endedBannerID, bannerID}).
+					Return(map[string]app.Flight{
+						endedBannerID: {End: clock.Now()},
+						bannerID:      {Start: clock.Now().Add(-time.Hour)},
+					}, nil)
+				storage.EXPECT().
+					GetBanner(context.Background(), bannerID).
+					Return(banner, nil)
+				storage.EXPECT().
+					RegisterSelect(context.Background(), slotID, bannerID, socialGroupID).
+					Return(nil)
+				return storage
+			},
+			mockStrategies: func(controller *gomock.Controller) app.StrategyFactory {
+				return mockStrategies(controller, slot.Strategy, app.SlotStats{Banners: stats.Banners, TotalSelects: 6}, bannerID)
+			},
+			mockEventQueue: func(controller *gomock.Controller) app.EventQueue {
+				eventQueue := mock.NewMockEventQueue(controller)
+				eventQueue.EXPECT().Put(context.Background(), gomock.Any()).Return(nil)
+				return eventQueue
+			},
+			slotID:        slotID,
+			socialGroupID: socialGroupID,
+			want:          banner,
+			err:           nil,
+		},
+		"all banners out of flight": {
+			mockStorage: func(controller *gomock.Controller) app.Storage {
+				storage := mock.NewMockStorage(controller)
+				storage.EXPECT().
+					GetSlot(context.Background(), slotID).
+					Return(slot, nil)
+				storage.EXPECT().
+					GetSlotStats(context.Background(), slotID, socialGroupID).
+					Return(stats, nil)
+				storage.EXPECT().
+					GetBannerFlights(context.Background(), []string{bannerID}).
+					Return(map[string]app.Flight{bannerID: {Start: clock.Now().Add(time.Hour)}}, nil)
+				return storage
+			},
+			mockStrategies: func(controller *gomock.Controller) app.StrategyFactory {
+				return mockStrategies(controller, slot.Strategy, app.SlotStats{Banners: []app.BannerStats{}, TotalSelects: 1},
+					emptyID)
+			},
+			slotID:        slotID,
+			socialGroupID: socialGroupID,
+			err:           app.ErrNoBannersFound,
+		},
+		"storage get flights error": {
+			mockStorage: func(controller *gomock.Controller) app.Storage {
+				storage := mock.NewMockStorage(controller)
+				storage.EXPECT().
+					GetSlot(context.Background(), slotID).
+					Return(slot, nil)
+				storage.EXPECT().
+					GetSlotStats(context.Background(), slotID, socialGroupID).
+					Return(stats, nil)
+				storage.EXPECT().
+					GetBannerFlights(context.Background(), []string{bannerID}).
+					Return(nil, errStorage)
+				return storage
+			},
+			mockStrategies: func(controller *gomock.Controller) app.StrategyFactory {
+				strategies := mock.NewMockStrategyFactory(controller)
+				strategies.EXPECT().CreateStrategy(slot.Strategy).Return(mock.NewMockStrategy(controller), nil, nil)
+				return strategies
+			},
+			slotID:        slotID,
+			socialGroupID: socialGroupID,
+			err:           errStorage,
+		},
+		"storage get banner error": {
+			mockStorage: func(controller *gomock.Controller) app.Storage {
+				storage := mock.NewMockStorage(controller)
+				storage.EXPECT().
+					GetSlot(context.Background(), slotID).
+					Return(slot, nil)
+				storage.EXPECT().
+					GetSlotStats(context.Background(), slotID, socialGroupID).
+					Return(stats, nil)
+				storage.EXPECT().
+					GetBannerFlights(context.Background(), []string{bannerID}).
+					Return(nil, nil)
+				storage.EXPECT().
+					GetBanner(context.Background(), bannerID).
+					Return(app.Banner{}, errStorage)
+				return storage
+			},
+			mockStrategies: func(controller *gomock.Controller) app.StrategyFactory {
+				return mockStrategies(controller, slot.Strategy, stats, bannerID)
+			},
+			slotID:        slotID,
+			socialGroupID: socialGroupID,
+			err:           errStorage,
+		},
+		"storage register select error": {
+			mockStorage: func(controller *gomock.Controller) app.Storage {
+				storage := mock.NewMockStorage(controller)
+				storage.EXPECT().
+					GetSlot(context.Background(), slotID).
+					Return(slot, nil)
+				storage.EXPECT().
+					GetSlotStats(context.Background(), slotID, socialGroupID).
+					Return(stats, nil)
+				storage.EXPECT().
+					GetBannerFlights(context.Background(), []string{bannerID}).
+					Return(nil, nil)
+				storage.EXPECT().
+					GetBanner(context.Background(), bannerID).
+					Return(banner, nil)
+				storage.EXPECT().
+					RegisterSelect(context.Background(), slotID, bannerID, socialGroupID).
+					Return(errStorage)
+				return storage
+			},
+			mockStrategies: func(controller *gomock.Controller) app.StrategyFactory {
+				return mockStrategies(controller, slot.Strategy, stats, bannerID)
+			},
+			slotID:        slotID,
+			socialGroupID: socialGroupID,
+			err:           errStorage,
+		},
+		"storage get linear stats error": {
 			mockStorage: func(controller *gomock.Controller) app.Storage {
 				storage := mock.NewMockStorage(controller)
 				storage.EXPECT().
-					SelectBanner(context.Background(), slotID, socialGroupID).
-					Return(emptyID, errStorage)
+					GetSlot(context.Background(), slotID).
+					Return(contextualSlot, nil)
+				storage.EXPECT().
+					GetLinearStats(context.Background(), slotID, len(vector)).
+					Return(nil, errStorage)
 				return storage
 			},
+			mockStrategies: func(controller *gomock.Controller) app.StrategyFactory {
+				return mockContextualStrategies(controller, contextualSlot.Strategy, vector)
+			},
 			slotID:        slotID,
 			socialGroupID: socialGroupID,
 			err:           errStorage,
 		},
+		"contextual": {
+			mockStorage: func(controller *gomock.Controller) app.Storage {
+				storage := mock.NewMockStorage(controller)
+				storage.EXPECT().
+					GetSlot(context.Background(), slotID).
+					Return(contextualSlot, nil)
+				storage.EXPECT().
+					GetLinearStats(context.Background(), slotID, len(vector)).
+					Return(linearStats, nil)
+				storage.EXPECT().
+					GetBannerFlights(context.Background(), []string{bannerID}).
+					Return(nil, nil)
+				storage.EXPECT().
+					GetBanner(context.Background(), bannerID).
+					Return(banner, nil)
+				storage.EXPECT().
+					RegisterSelect(context.Background(), slotID, bannerID, socialGroupID).
+					Return(nil)
+				storage.EXPECT().
+					RegisterLinearSelect(context.Background(), slotID, bannerID, vector).
+					Return(nil)
+				return storage
+			},
+			mockStrategies: func(controller *gomock.Controller) app.StrategyFactory {
+				return mockContextualStrategies(controller, contextualSlot.Strategy, vector)
+			},
+			mockEventQueue: func(controller *gomock.Controller) app.EventQueue {
+				eventQueue := mock.NewMockEventQueue(controller)
+				eventQueue.EXPECT().Put(context.Background(), gomock.Any()).Return(nil)
+				return eventQueue
+			},
+			slotID:        slotID,
+			socialGroupID: socialGroupID,
+			want:          banner,
+			err:           nil,
+		},
 		"no error": {
 			mockStorage: func(controller *gomock.Controller) app.Storage {
 				storage := mock.NewMockStorage(controller)
 				storage.EXPECT().
-					SelectBanner(context.Background(), slotID, socialGroupID).
-					Return(bannerID, nil)
+					GetSlot(context.Background(), slotID).
+					Return(slot, nil)
+				storage.EXPECT().
+					GetSlotStats(context.Background(), slotID, socialGroupID).
+					Return(stats, nil)
+				storage.EXPECT().
+					GetBannerFlights(context.Background(), []string{bannerID}).
+					Return(nil, nil)
+				storage.EXPECT().
+					GetBanner(context.Background(), bannerID).
+					Return(banner, nil)
+				storage.EXPECT().
+					RegisterSelect(context.Background(), slotID, bannerID, socialGroupID).
+					Return(nil)
 				return storage
 			},
+			mockStrategies: func(controller *gomock.Controller) app.StrategyFactory {
+				return mockStrategies(controller, slot.Strategy, stats, bannerID)
+			},
 			mockEventQueue: func(controller *gomock.Controller) app.EventQueue {
 				eventQueue := mock.NewMockEventQueue(controller)
 				eventQueue.EXPECT().
@@ -409,14 +841,14 @@ func TestRotator_SelectBanner(t *testing.T) {
 						SlotID:         slotID,
 						BannerID:       bannerID,
 						SocialGroupID:  socialGroupID,
-						TimestampMicro: time.Now().UnixMicro(),
+						TimestampMicro: clock.Now().UnixMicro(),
 					}}).
 					Return(nil)
 				return eventQueue
 			},
 			slotID:        slotID,
 			socialGroupID: socialGroupID,
-			wantID:        bannerID,
+			want:          banner,
 			err:           nil,
 		},
 	}
@@ -428,28 +860,69 @@ func TestRotator_SelectBanner(t *testing.T) {
 			if tt.mockStorage != nil {
 				storage = tt.mockStorage(controller)
 			}
+			var strategies app.StrategyFactory = mock.NewMockStrategyFactory(controller)
+			if tt.mockStrategies != nil {
+				strategies = tt.mockStrategies(controller)
+			}
 			var eventQueue app.EventQueue = mock.NewMockEventQueue(controller)
 			if tt.mockEventQueue != nil {
 				eventQueue = tt.mockEventQueue(controller)
 			}
-			rotator := app.NewRotator(storage, eventQueue, mock.NewMockLogger(controller))
+			logger := mock.NewMockLogger(controller)
+			rotator := app.NewRotator(storage, strategies, eventQueue, logger, app.NewRandom(seed), clock)
 
-			gotID, err := rotator.SelectBanner(context.Background(), tt.slotID, tt.socialGroupID)
+			got, err := rotator.SelectBanner(context.Background(), tt.slotID, tt.socialGroupID, features)
 
 			if tt.err == nil {
 				require.NoError(t, err)
-				require.Equal(t, tt.wantID, gotID)
+				require.Equal(t, tt.want, got)
 			} else {
 				require.ErrorIs(t, err, tt.err)
-				require.Empty(t, gotID)
+				require.Empty(t, got)
 			}
 		})
 	}
 }
 
+func mockStrategies(
+	controller *gomock.Controller,
+	params app.StrategyParams,
+	stats app.SlotStats,
+	selectedBannerID string,
+) app.StrategyFactory {
+	strategy := mock.NewMockStrategy(controller)
+	strategy.EXPECT().SelectBanner(stats, gomock.Any()).Return(selectedBannerID)
+	strategies := mock.NewMockStrategyFactory(controller)
+	strategies.EXPECT().CreateStrategy(params).Return(strategy, nil, nil)
+	return strategies
+}
+
+// mockContextualStrategies mocks a contextual strategy which vectorizes the test features with the social group.
+func mockContextualStrategies(
+	controller *gomock.Controller,
+	params app.StrategyParams,
+	vector []float64,
+) app.StrategyFactory {
+	strategy := mock.NewMockContextualStrategy(controller)
+	strategy.EXPECT().
+		Vectorize(app.Features{"device": "mobile", app.FeatureSocialGroup: socialGroupID}).
+		Return(vector)
+	strategy.EXPECT().
+		SelectContextualBanner(gomock.Any(), vector).
+		Return(bannerID).
+		AnyTimes()
+	strategies := mock.NewMockStrategyFactory(controller)
+	strategies.EXPECT().CreateStrategy(params).Return(nil, strategy, nil)
+	return strategies
+}
+
 func TestRotator_ClickBanner(t *testing.T) {
+	slot := app.Slot{ID: slotID, Description: description}
+	contextualSlot := app.Slot{ID: slotID, Description: description, Strategy: app.StrategyParams{Name: "lin_ucb"}}
+	vector := []float64{1, 0, 1}
 	tests := map[string]struct {
 		mockStorage    func(controller *gomock.Controller) app.Storage
+		mockStrategies func(controller *gomock.Controller) app.StrategyFactory
 		mockEventQueue func(controller *gomock.Controller) app.EventQueue
 		slotID         string
 		bannerID       string
@@ -478,23 +951,96 @@ func TestRotator_ClickBanner(t *testing.T) {
 			mockStorage: func(controller *gomock.Controller) app.Storage {
 				storage := mock.NewMockStorage(controller)
 				storage.EXPECT().
-					ClickBanner(context.Background(), slotID, bannerID, socialGroupID).
+					RegisterClick(context.Background(), slotID, bannerID, socialGroupID).
+					Return(errStorage)
+				return storage
+			},
+			slotID:        slotID,
+			bannerID:      bannerID,
+			socialGroupID: socialGroupID,
+			err:           errStorage,
+		},
+		"storage get slot error": {
+			mockStorage: func(controller *gomock.Controller) app.Storage {
+				storage := mock.NewMockStorage(controller)
+				storage.EXPECT().
+					RegisterClick(context.Background(), slotID, bannerID, socialGroupID).
+					Return(nil)
+				storage.EXPECT().
+					GetSlot(context.Background(), slotID).
+					Return(app.Slot{}, errStorage)
+				return storage
+			},
+			slotID:        slotID,
+			bannerID:      bannerID,
+			socialGroupID: socialGroupID,
+			err:           errStorage,
+		},
+		"storage register linear click error": {
+			mockStorage: func(controller *gomock.Controller) app.Storage {
+				storage := mock.NewMockStorage(controller)
+				storage.EXPECT().
+					RegisterClick(context.Background(), slotID, bannerID, socialGroupID).
+					Return(nil)
+				storage.EXPECT().
+					GetSlot(context.Background(), slotID).
+					Return(contextualSlot, nil)
+				storage.EXPECT().
+					RegisterLinearClick(context.Background(), slotID, bannerID, vector).
 					Return(errStorage)
 				return storage
 			},
+			mockStrategies: func(controller *gomock.Controller) app.StrategyFactory {
+				return mockContextualStrategies(controller, contextualSlot.Strategy, vector)
+			},
 			slotID:        slotID,
 			bannerID:      bannerID,
 			socialGroupID: socialGroupID,
 			err:           errStorage,
 		},
+		"contextual": {
+			mockStorage: func(controller *gomock.Controller) app.Storage {
+				storage := mock.NewMockStorage(controller)
+				storage.EXPECT().
+					RegisterClick(context.Background(), slotID, bannerID, socialGroupID).
+					Return(nil)
+				storage.EXPECT().
+					GetSlot(context.Background(), slotID).
+					Return(contextualSlot, nil)
+				storage.EXPECT().
+					RegisterLinearClick(context.Background(), slotID, bannerID, vector).
+					Return(nil)
+				return storage
+			},
+			mockStrategies: func(controller *gomock.Controller) app.StrategyFactory {
+				return mockContextualStrategies(controller, contextualSlot.Strategy, vector)
+			},
+			mockEventQueue: func(controller *gomock.Controller) app.EventQueue {
+				eventQueue := mock.NewMockEventQueue(controller)
+				eventQueue.EXPECT().Put(context.Background(), gomock.Any()).Return(nil)
+				return eventQueue
+			},
+			slotID:        slotID,
+			bannerID:      bannerID,
+			socialGroupID: socialGroupID,
+			err:           nil,
+		},
 		"no error": {
 			mockStorage: func(controller *gomock.Controller) app.Storage {
 				storage := mock.NewMockStorage(controller)
 				storage.EXPECT().
-					ClickBanner(context.Background(), slotID, bannerID, socialGroupID).
+					RegisterClick(context.Background(), slotID, bannerID, socialGroupID).
 					Return(nil)
+				storage.EXPECT().
+					GetSlot(context.Background(), slotID).
+					Return(slot, nil)
 				return storage
 			},
+			mockStrategies: func(controller *gomock.Controller) app.StrategyFactory {
+				strategies := mock.NewMockStrategyFactory(controller)
+				strategies.EXPECT().CreateStrategy(slot.Strategy).Return(mock.NewMockStrategy(controller), nil, nil)
+				return strategies
+			},
 			mockEventQueue: func(controller *gomock.Controller) app.EventQueue {
 				eventQueue := mock.NewMockEventQueue(controller)
 				eventQueue.EXPECT().
@@ -503,7 +1049,7 @@ func TestRotator_ClickBanner(t *testing.T) {
 						SlotID:         slotID,
 						BannerID:       bannerID,
 						SocialGroupID:  socialGroupID,
-						TimestampMicro: time.Now().UnixMicro(),
+						TimestampMicro: clock.Now().UnixMicro(),
 					}}).
 					Return(nil)
 				return eventQueue
@@ -522,13 +1068,18 @@ func TestRotator_ClickBanner(t *testing.T) {
 			if tt.mockStorage != nil {
 				storage = tt.mockStorage(controller)
 			}
+			var strategies app.StrategyFactory = mock.NewMockStrategyFactory(controller)
+			if tt.mockStrategies != nil {
+				strategies = tt.mockStrategies(controller)
+			}
 			var eventQueue app.EventQueue = mock.NewMockEventQueue(controller)
 			if tt.mockEventQueue != nil {
 				eventQueue = tt.mockEventQueue(controller)
 			}
-			rotator := app.NewRotator(storage, eventQueue, mock.NewMockLogger(controller))
+			logger := mock.NewMockLogger(controller)
+			rotator := app.NewRotator(storage, strategies, eventQueue, logger, app.NewRandom(seed), clock)
 
-			err := rotator.ClickBanner(context.Background(), tt.slotID, tt.bannerID, tt.socialGroupID)
+			err := rotator.ClickBanner(context.Background(), tt.slotID, tt.bannerID, tt.socialGroupID, features)
 
 			if tt.err == nil {
 				require.NoError(t, err)
@@ -538,3 +1089,94 @@ func TestRotator_ClickBanner(t *testing.T) {
 		})
 	}
 }
+
+func TestRotator_SelectBanner_Reproducible(t *testing.T) {
+	stats := app.SlotStats{
+		Banners: []app.BannerStats{
+			{BannerID: "1", Selects: 100, Clicks: 10},
+			{BannerID: "2", Selects: 100, Clicks: 12},
+			{BannerID: "3", Selects: 100, Clicks: 11},
+		},
+		TotalSelects: 300,
+	}
+	slot := app.Slot{ID: slotID, Description: description, Strategy: app.StrategyParams{Name: "thompson_sampling"}}
+	selectSequence := func(seed int64) []string {
+		controller := gomock.NewController(t)
+		defer controller.Finish()
+		storage := mock.NewMockStorage(controller)
+		storage.EXPECT().GetSlot(context.Background(), slotID).Return(slot, nil).AnyTimes()
+		storage.EXPECT().GetSlotStats(context.Background(), slotID, socialGroupID).Return(stats, nil).AnyTimes()
+		storage.EXPECT().GetBannerFlights(context.Background(), []string{"1", "2", "3"}).Return(nil, nil).AnyTimes()
+		storage.EXPECT().GetBanner(context.Background(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, id string) (app.Banner, error) {
+				return app.Banner{ID: id}, nil
+			}).
+			AnyTimes()
+		storage.EXPECT().RegisterSelect(context.Background(), slotID, gomock.Any(), socialGroupID).Return(nil).AnyTimes()
+		strategies := mock.NewMockStrategyFactory(controller)
+		strategies.EXPECT().CreateStrategy(slot.Strategy).Return(strategy.NewThompsonSampling(1, 1), nil, nil).AnyTimes()
+		eventQueue := mock.NewMockEventQueue(controller)
+		eventQueue.EXPECT().Put(context.Background(), gomock.Any()).Return(nil).AnyTimes()
+		rotator := app.NewRotator(storage, strategies, eventQueue, mock.NewMockLogger(controller), app.NewRandom(seed), clock)
+
+		sequence := make([]string, 50)
+		for i := range sequence {
+			banner, err := rotator.SelectBanner(context.Background(), slotID, socialGroupID, features)
+			require.NoError(t, err)
+			sequence[i] = banner.ID
+		}
+		return sequence
+	}
+
+	require.Equal(t, selectSequence(seed), selectSequence(seed))
+	require.NotEqual(t, selectSequence(seed), selectSequence(seed+1))
+}
+
+func TestRotator_SelectBanner_StatsWindowByClock(t *testing.T) {
+	// The clock is far from the wall time, so the counters are found only if the storage uses the same clock.
+	futureClock := fixedClock{now: time.Date(2100, time.January, 1, 12, 30, 0, 0, time.UTC)}
+	ctx := context.Background()
+	storage := memory.NewMemory(idgenerator.NewUUID(), app.DeleteModeCascade, futureClock)
+	bannerID, err := storage.CreateBanner(ctx, description, app.Creative{}, app.Flight{})
+	require.NoError(t, err)
+	params := app.StrategyParams{Name: "ucb1", Window: time.Hour}
+	slotID, err := storage.CreateSlot(ctx, description, params, app.SlotFormat{})
+	require.NoError(t, err)
+	socialGroupID, err := storage.CreateSocialGroup(ctx, description)
+	require.NoError(t, err)
+	require.NoError(t, storage.AttachBanner(ctx, slotID, bannerID))
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	var selectedStats []app.SlotStats
+	selectStrategy := mock.NewMockStrategy(controller)
+	selectStrategy.EXPECT().SelectBanner(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(stats app.SlotStats, _ app.Random) string {
+			selectedStats = append(selectedStats, stats)
+			return bannerID
+		}).
+		Times(3)
+	strategies := mock.NewMockStrategyFactory(controller)
+	strategies.EXPECT().CreateStrategy(params).Return(selectStrategy, nil, nil).AnyTimes()
+	eventQueue := mock.NewMockEventQueue(controller)
+	eventQueue.EXPECT().Put(ctx, gomock.Any()).Return(nil).AnyTimes()
+	rotator := app.NewRotator(
+		storage, strategies, eventQueue, mock.NewMockLogger(controller), app.NewRandom(seed), futureClock,
+	)
+
+	for i := 0; i < 2; i++ {
+		_, err = rotator.SelectBanner(ctx, slotID, socialGroupID, features)
+		require.NoError(t, err)
+	}
+	require.NoError(t, rotator.ClickBanner(ctx, slotID, bannerID, socialGroupID, features))
+	_, err = rotator.SelectBanner(ctx, slotID, socialGroupID, features)
+	require.NoError(t, err)
+
+	require.Len(t, selectedStats, 3)
+	require.Equal(t, app.SlotStats{
+		Banners: []app.BannerStats{
+			{BannerID: bannerID, Selects: 2, Clicks: 1, PooledSelects: 2, PooledClicks: 1},
+		},
+		TotalSelects:       2,
+		PooledTotalSelects: 2,
+	}, selectedStats[2])
+}
@@ -0,0 +1,137 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"mime"
+	"strings"
+	"time"
+)
+
+var ErrInvalidSlotFormat = errors.New("invalid slot format")
+
+type Slot struct {
+	ID          string
+	Description string
+	Strategy    StrategyParams
+	// Format limits the creatives of the banners which may be attached to the slot.
+	Format SlotFormat
+}
+
+// SlotFormat describes the creatives which are accepted by a slot. Empty fields mean that any creative is accepted.
+type SlotFormat struct {
+	// Sizes are the accepted dimensions of a creative. The storages return nil in case of it is empty.
+	Sizes []Size
+	// MIMETypes are the accepted media types of a creative without parameters, e.g. "image/png".
+	// The "image/*" type accepts any image. The storages return nil in case of it is empty.
+	MIMETypes []string
+}
+
+// Size is dimensions of a creative in pixels.
+type Size struct {
+	Width  int
+	Height int
+}
+
+func (s Size) String() string {
+	return fmt.Sprintf("%dx%d", s.Width, s.Height)
+}
+
+// IsEmpty returns true in case of the format accepts any creative.
+func (f SlotFormat) IsEmpty() bool {
+	return len(f.Sizes) == 0 && len(f.MIMETypes) == 0
+}
+
+// Validate returns ErrInvalidSlotFormat in case of a size is not positive or a MIME type is not a media type
+// without parameters.
+func (f SlotFormat) Validate() error {
+	for _, size := range f.Sizes {
+		if size.Width <= 0 || size.Height <= 0 {
+			return fmt.Errorf("%w: size %s is not positive", ErrInvalidSlotFormat, size)
+		}
+	}
+	for _, mimeType := range f.MIMETypes {
+		mediaType, params, err := mime.ParseMediaType(mimeType)
+		if err != nil || len(params) > 0 || strings.Count(mediaType, "/") != 1 || strings.HasPrefix(mediaType, "*") {
+			return fmt.Errorf("%w: mime type '%s' is not a media type", ErrInvalidSlotFormat, mimeType)
+		}
+	}
+	return nil
+}
+
+// Check returns an error which describes the mismatch in case of the creative does not fit the format.
+// A creative with an unknown size or MIME type does not fit the format which limits it.
+func (f SlotFormat) Check(creative Creative) error {
+	if len(f.Sizes) > 0 && !f.acceptsSize(Size{Width: creative.Width, Height: creative.Height}) {
+		return fmt.Errorf("size %dx%d is not accepted", creative.Width, creative.Height)
+	}
+	if len(f.MIMETypes) > 0 && !f.acceptsMIMEType(creative.MIMEType) {
+		return fmt.Errorf("mime type '%s' is not accepted", creative.MIMEType)
+	}
+	return nil
+}
+
+func (f SlotFormat) acceptsSize(size Size) bool {
+	for _, accepted := range f.Sizes {
+		if accepted == size {
+			return true
+		}
+	}
+	return false
+}
+
+func (f SlotFormat) acceptsMIMEType(mimeType string) bool {
+	mediaType, _, err := mime.ParseMediaType(mimeType)
+	if err != nil {
+		return false
+	}
+	for _, accepted := range f.MIMETypes {
+		accepted = strings.ToLower(accepted)
+		if accepted == mediaType {
+			return true
+		}
+		if prefix := strings.TrimSuffix(accepted, "*"); prefix != accepted && strings.HasPrefix(mediaType, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// StrategyParams describes a banner selection strategy of a slot.
+// Zero values mean that the rotator defaults are used.
+type StrategyParams struct {
+	Name string
+	// UCBExploration is an exploration coefficient of the UCB strategies.
+	// Nil means that the rotator default is used, so zero disables the exploration.
+	UCBExploration *float64
+	// Epsilon is a probability to select a random banner of the epsilon-greedy strategies.
+	// Nil means that the rotator default is used, so zero gives the pure greedy selection.
+	Epsilon      *float64
+	EpsilonDecay float64
+	Alpha        float64
+	Beta         float64
+	// Window limits the stats to the counters registered during the last window (sliding window).
+	// Zero means that all-time counters are used.
+	Window time.Duration
+	// Discount is a factor the counters are multiplied by for every StatsInterval of their age.
+	// Zero means that the counters are not discounted.
+	Discount float64
+	// Prior is a way to make the pseudo-counters which are added to the banners counters,
+	// so a new banner does not get all the traffic before it has any evidence.
+	Prior string
+	// PriorSelects is a number of the pseudo-selects.
+	// Nil means that the rotator default is used, so zero disables the prior.
+	PriorSelects *float64
+	// PriorClicks is a number of the pseudo-clicks of the pseudo counts prior.
+	// Nil means that the rotator default is used, so zero gives the pessimistic prior.
+	PriorClicks *float64
+	// Pooling is a way to share the knowledge between the social groups of a slot.
+	Pooling string
+	// PoolingStrength is a number of the pseudo-selects with the slot-wide click-through ratio of a banner
+	// which are added to the social group counters.
+	// Nil means that the rotator default is used, so zero disables the shrinkage.
+	PoolingStrength *float64
+	// PoolingMinSelects is a number of the social group selects below which the slot-wide counters are used.
+	// Nil means that the rotator default is used, so zero disables the fallback to the slot-wide counters.
+	PoolingMinSelects *float64
+}
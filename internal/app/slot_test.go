@@ -0,0 +1,186 @@
+package app_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ekhvalov/otus-banners-rotation/internal/app"
+	"github.com/ekhvalov/otus-banners-rotation/internal/app/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+var slotFormat = app.SlotFormat{
+	Sizes:     []app.Size{{Width: 300, Height: 250}, {Width: 336, Height: 280}},
+	MIMETypes: []string{"image/png", "video/*"},
+}
+
+func TestSlotFormat_Validate(t *testing.T) {
+	tests := map[string]struct {
+		format  app.SlotFormat
+		wantErr bool
+	}{
+		"empty":                 {format: app.SlotFormat{}},
+		"full":                  {format: slotFormat},
+		"zero width":            {format: app.SlotFormat{Sizes: []app.Size{{Width: 0, Height: 250}}}, wantErr: true},
+		"negative height":       {format: app.SlotFormat{Sizes: []app.Size{{Width: 300, Height: -1}}}, wantErr: true},
+		"mime type parameters":  {format: app.SlotFormat{MIMETypes: []string{"text/html; charset=utf-8"}}, wantErr: true},
+		"mime type without sub": {format: app.SlotFormat{MIMETypes: []string{"image"}}, wantErr: true},
+		"any mime type":         {format: app.SlotFormat{MIMETypes: []string{"*/*"}}, wantErr: true},
+		"malformed mime type":   {format: app.SlotFormat{MIMETypes: []string{"image/"}}, wantErr: true},
+		"list of mime types":    {format: app.SlotFormat{MIMETypes: []string{"image/png,image/gif"}}, wantErr: true},
+	}
+	for testName, tt := range tests {
+		t.Run(testName, func(t *testing.T) {
+			err := tt.format.Validate()
+
+			if tt.wantErr {
+				require.ErrorIs(t, err, app.ErrInvalidSlotFormat)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSlotFormat_Check(t *testing.T) {
+	sizeOnly := app.SlotFormat{Sizes: slotFormat.Sizes}
+	tests := map[string]struct {
+		format   app.SlotFormat
+		creative app.Creative
+		wantErr  bool
+	}{
+		"empty format":       {format: app.SlotFormat{}, creative: app.Creative{}},
+		"fit":                {format: slotFormat, creative: app.Creative{Width: 336, Height: 280, MIMEType: "image/png"}},
+		"wildcard mime type": {format: slotFormat, creative: app.Creative{Width: 300, Height: 250, MIMEType: "video/mp4"}},
+		"mime type parameters": {
+			format:   slotFormat,
+			creative: app.Creative{Width: 300, Height: 250, MIMEType: "Image/PNG; q=1"},
+		},
+		"size only": {format: sizeOnly, creative: app.Creative{Width: 300, Height: 250}},
+		"wrong size": {
+			format:   slotFormat,
+			creative: app.Creative{Width: 728, Height: 90, MIMEType: "image/png"},
+			wantErr:  true,
+		},
+		"unknown size": {format: slotFormat, creative: app.Creative{MIMEType: "image/png"}, wantErr: true},
+		"wrong mime type": {
+			format:   slotFormat,
+			creative: app.Creative{Width: 300, Height: 250, MIMEType: "image/gif"},
+			wantErr:  true,
+		},
+		"unknown mime type": {format: slotFormat, creative: app.Creative{Width: 300, Height: 250}, wantErr: true},
+		"wildcard prefix only": {
+			format:   slotFormat,
+			creative: app.Creative{Width: 300, Height: 250, MIMEType: "videos/mp4"},
+			wantErr:  true,
+		},
+	}
+	for testName, tt := range tests {
+		t.Run(testName, func(t *testing.T) {
+			err := tt.format.Check(tt.creative)
+
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRotator_CreateSlot_Error_InvalidFormat(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	strategies := mock.NewMockStrategyFactory(controller)
+	strategies.EXPECT().
+		CreateStrategy(app.StrategyParams{}).
+		Return(mock.NewMockStrategy(controller), nil, nil)
+	rotator := app.NewRotator(
+		mock.NewMockStorage(controller),
+		strategies,
+		mock.NewMockEventQueue(controller),
+		mock.NewMockLogger(controller),
+		app.NewRandom(seed),
+		clock,
+	)
+	format := app.SlotFormat{Sizes: []app.Size{{Width: -300, Height: 250}}}
+
+	_, err := rotator.CreateSlot(context.Background(), description, app.StrategyParams{}, format)
+
+	require.ErrorIs(t, err, app.ErrInvalidSlotFormat)
+}
+
+func TestRotator_AttachBanner_Format(t *testing.T) {
+	errNotFound := app.NewErrNotFound("banner is not found")
+	tests := map[string]struct {
+		format           app.SlotFormat
+		getBannerErr     error
+		creative         app.Creative
+		isAttachExpected bool
+		wantNotFit       bool
+		wantErr          error
+	}{
+		"fit": {
+			format:           slotFormat,
+			creative:         app.Creative{Width: 300, Height: 250, MIMEType: "image/png"},
+			isAttachExpected: true,
+		},
+		"not fit": {
+			format:     slotFormat,
+			creative:   app.Creative{Width: 728, Height: 90, MIMEType: "image/png"},
+			wantNotFit: true,
+		},
+		"banner not found": {
+			format:       slotFormat,
+			getBannerErr: errNotFound,
+			wantErr:      errNotFound,
+		},
+	}
+	for testName, tt := range tests {
+		t.Run(testName, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+			storage := mock.NewMockStorage(controller)
+			storage.EXPECT().
+				GetSlot(context.Background(), slotID).
+				Return(app.Slot{ID: slotID, Format: tt.format}, nil)
+			storage.EXPECT().
+				GetBanner(context.Background(), bannerID).
+				Return(app.Banner{ID: bannerID, Creative: tt.creative}, tt.getBannerErr)
+			if tt.isAttachExpected {
+				storage.EXPECT().
+					AttachBanner(context.Background(), slotID, bannerID).
+					Return(nil)
+			}
+			rotator := newInventoryRotator(controller, storage)
+
+			err := rotator.AttachBanner(context.Background(), slotID, bannerID)
+
+			var errNotFit *app.ErrBannerNotFit
+			switch {
+			case tt.wantNotFit:
+				require.ErrorAs(t, err, &errNotFit)
+			case tt.wantErr != nil:
+				require.ErrorIs(t, err, tt.wantErr)
+			default:
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRotator_AttachBanner_Error_SlotNotFound(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	errNotFound := app.NewErrNotFound("slot is not found")
+	storage := mock.NewMockStorage(controller)
+	storage.EXPECT().
+		GetSlot(context.Background(), slotID).
+		Return(app.Slot{}, errNotFound)
+	rotator := newInventoryRotator(controller, storage)
+
+	err := rotator.AttachBanner(context.Background(), slotID, bannerID)
+
+	require.ErrorIs(t, err, errNotFound)
+}
@@ -0,0 +1,176 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+)
+
+var ErrInvalidCounter = errors.New("invalid counter")
+
+// Snapshot is the state of a storage. The pooled counters and the totals are not kept,
+// they are the sums of the counters.
+type Snapshot struct {
+	Banners      []Banner
+	Slots        []Slot
+	SocialGroups []SocialGroup
+	Attachments  []Attachment
+	Counters     []Counter
+	LinearModels []LinearModel
+}
+
+// Counter contains selects and clicks of a banner in a slot by a social group. A zero Start means
+// all-time counters, otherwise the counters are registered during the StatsInterval starting at Start.
+type Counter struct {
+	SlotID        string
+	SocialGroupID string
+	BannerID      string
+	Start         time.Time
+	Selects       int64
+	Clicks        int64
+}
+
+// LinearModel contains the non-zero elements of the linear stats of a banner in a slot.
+type LinearModel struct {
+	SlotID   string
+	BannerID string
+	A        []LinearElement
+	B        []LinearElement
+}
+
+// LinearElement is an element of the A matrix at I row and J column or of the B vector at I index.
+type LinearElement struct {
+	I     int
+	J     int
+	Value float64
+}
+
+// Validate checks that the entities of the snapshot are valid and that the attachments, the counters and
+// the linear models refer to them. The strategy params of the slots are checked by the strategies.
+// Returns ErrNotFound in case of a referenced entity is not found, ErrInvalidCreative, ErrInvalidFlight,
+// ErrInvalidStrategy or ErrInvalidSlotFormat in case of an entity is invalid and ErrInvalidCounter in case of
+// a counter is negative or the all-time clicks exceed the selects.
+func (s Snapshot) Validate(strategies StrategyFactory) error {
+	banners := make(map[string]struct{}, len(s.Banners))
+	for _, banner := range s.Banners {
+		if err := banner.Creative.Validate(); err != nil {
+			return fmt.Errorf("banner with id '%s' error: %w", banner.ID, err)
+		}
+		if err := banner.Flight.Validate(); err != nil {
+			return fmt.Errorf("banner with id '%s' error: %w", banner.ID, err)
+		}
+		banners[banner.ID] = struct{}{}
+	}
+	slots := make(map[string]struct{}, len(s.Slots))
+	for _, slot := range s.Slots {
+		if _, _, err := strategies.CreateStrategy(slot.Strategy); err != nil {
+			return fmt.Errorf("slot with id '%s' error: %w", slot.ID, err)
+		}
+		if err := slot.Format.Validate(); err != nil {
+			return fmt.Errorf("slot with id '%s' error: %w", slot.ID, err)
+		}
+		slots[slot.ID] = struct{}{}
+	}
+	socialGroups := make(map[string]struct{}, len(s.SocialGroups))
+	for _, socialGroup := range s.SocialGroups {
+		socialGroups[socialGroup.ID] = struct{}{}
+	}
+	check := func(ids map[string]struct{}, entity, id string) error {
+		if _, ok := ids[id]; !ok {
+			return NewErrNotFound(fmt.Sprintf("%s with id '%s' is not found in snapshot", entity, id))
+		}
+		return nil
+	}
+	for _, a := range s.Attachments {
+		if err := check(slots, "slot", a.SlotID); err != nil {
+			return err
+		}
+		if err := check(banners, "banner", a.BannerID); err != nil {
+			return err
+		}
+	}
+	for _, c := range s.Counters {
+		if err := check(slots, "slot", c.SlotID); err != nil {
+			return err
+		}
+		if err := check(socialGroups, "socialGroup", c.SocialGroupID); err != nil {
+			return err
+		}
+		if err := check(banners, "banner", c.BannerID); err != nil {
+			return err
+		}
+		if err := c.validate(); err != nil {
+			return err
+		}
+	}
+	for _, l := range s.LinearModels {
+		if err := check(slots, "slot", l.SlotID); err != nil {
+			return err
+		}
+		if err := check(banners, "banner", l.BannerID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validate checks the counter values. The clicks of an interval may exceed its selects, as a banner
+// may be clicked in the interval after the one it is selected in.
+func (c Counter) validate() error {
+	if c.Selects < 0 || c.Clicks < 0 {
+		return fmt.Errorf("%w: counter of banner '%s' in slot '%s' for social group '%s' is negative",
+			ErrInvalidCounter, c.BannerID, c.SlotID, c.SocialGroupID)
+	}
+	if c.Start.IsZero() && c.Clicks > c.Selects {
+		return fmt.Errorf("%w: clicks of banner '%s' in slot '%s' for social group '%s' exceed the selects",
+			ErrInvalidCounter, c.BannerID, c.SlotID, c.SocialGroupID)
+	}
+	return nil
+}
+
+// Sort sorts the snapshot by ids, so the snapshots of the same state are equal whatever storage they are exported from.
+func (s Snapshot) Sort() {
+	sort.Slice(s.Banners, func(i, j int) bool { return s.Banners[i].ID < s.Banners[j].ID })
+	sort.Slice(s.Slots, func(i, j int) bool { return s.Slots[i].ID < s.Slots[j].ID })
+	sort.Slice(s.SocialGroups, func(i, j int) bool { return s.SocialGroups[i].ID < s.SocialGroups[j].ID })
+	sort.Slice(s.Attachments, func(i, j int) bool {
+		a, b := s.Attachments[i], s.Attachments[j]
+		if a.SlotID != b.SlotID {
+			return a.SlotID < b.SlotID
+		}
+		return a.BannerID < b.BannerID
+	})
+	sort.Slice(s.Counters, func(i, j int) bool {
+		a, b := s.Counters[i], s.Counters[j]
+		switch {
+		case a.SlotID != b.SlotID:
+			return a.SlotID < b.SlotID
+		case a.SocialGroupID != b.SocialGroupID:
+			return a.SocialGroupID < b.SocialGroupID
+		case a.BannerID != b.BannerID:
+			return a.BannerID < b.BannerID
+		}
+		return a.Start.Before(b.Start)
+	})
+	sort.Slice(s.LinearModels, func(i, j int) bool {
+		a, b := s.LinearModels[i], s.LinearModels[j]
+		if a.SlotID != b.SlotID {
+			return a.SlotID < b.SlotID
+		}
+		return a.BannerID < b.BannerID
+	})
+	for _, model := range s.LinearModels {
+		sortLinearElements(model.A)
+		sortLinearElements(model.B)
+	}
+}
+
+func sortLinearElements(elements []LinearElement) {
+	sort.Slice(elements, func(i, j int) bool {
+		if elements[i].I != elements[j].I {
+			return elements[i].I < elements[j].I
+		}
+		return elements[i].J < elements[j].J
+	})
+}
@@ -0,0 +1,116 @@
+package app_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ekhvalov/otus-banners-rotation/internal/app"
+	"github.com/ekhvalov/otus-banners-rotation/internal/app/strategy"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+var snapshotStrategies = strategy.NewFactory(strategy.NewConfig(viper.New()))
+
+func TestSnapshot_Validate(t *testing.T) {
+	valid := func() app.Snapshot {
+		return app.Snapshot{
+			Banners:      []app.Banner{{ID: "b"}},
+			Slots:        []app.Slot{{ID: "s"}},
+			SocialGroups: []app.SocialGroup{{ID: "g"}},
+			Attachments:  []app.Attachment{{SlotID: "s", BannerID: "b"}},
+			Counters:     []app.Counter{{SlotID: "s", SocialGroupID: "g", BannerID: "b", Selects: 1}},
+			LinearModels: []app.LinearModel{{SlotID: "s", BannerID: "b"}},
+		}
+	}
+	tests := map[string]func(s *app.Snapshot){
+		"attachment slot":   func(s *app.Snapshot) { s.Attachments[0].SlotID = "unknown" },
+		"attachment banner": func(s *app.Snapshot) { s.Attachments[0].BannerID = "unknown" },
+		"counter slot":      func(s *app.Snapshot) { s.Counters[0].SlotID = "unknown" },
+		"counter group":     func(s *app.Snapshot) { s.Counters[0].SocialGroupID = "unknown" },
+		"counter banner":    func(s *app.Snapshot) { s.Counters[0].BannerID = "unknown" },
+		"linear slot":       func(s *app.Snapshot) { s.LinearModels[0].SlotID = "unknown" },
+		"linear banner":     func(s *app.Snapshot) { s.LinearModels[0].BannerID = "unknown" },
+	}
+	require.NoError(t, valid().Validate(snapshotStrategies))
+	for name, corrupt := range tests {
+		t.Run(name, func(t *testing.T) {
+			snapshot := valid()
+			corrupt(&snapshot)
+
+			var errNotFound *app.ErrNotFound
+			require.ErrorAs(t, snapshot.Validate(snapshotStrategies), &errNotFound)
+		})
+	}
+}
+
+func TestSnapshot_Validate_InvalidSlotFormat(t *testing.T) {
+	snapshot := app.Snapshot{
+		Slots: []app.Slot{{ID: "s", Format: app.SlotFormat{MIMETypes: []string{"image/png; q=1"}}}},
+	}
+
+	require.ErrorIs(t, snapshot.Validate(snapshotStrategies), app.ErrInvalidSlotFormat)
+}
+
+func TestSnapshot_Validate_InvalidEntities(t *testing.T) {
+	now := time.Now()
+	valid := func() app.Snapshot {
+		return app.Snapshot{
+			Banners:      []app.Banner{{ID: "b"}},
+			Slots:        []app.Slot{{ID: "s", Strategy: app.StrategyParams{Name: "ucb1"}}},
+			SocialGroups: []app.SocialGroup{{ID: "g"}},
+			Counters:     []app.Counter{{SlotID: "s", SocialGroupID: "g", BannerID: "b", Selects: 2, Clicks: 1}},
+		}
+	}
+	tests := map[string]struct {
+		corrupt func(s *app.Snapshot)
+		err     error
+	}{
+		"creative": {
+			corrupt: func(s *app.Snapshot) { s.Banners[0].Creative.ImageURL = "banner.png" },
+			err:     app.ErrInvalidCreative,
+		},
+		"flight": {
+			corrupt: func(s *app.Snapshot) { s.Banners[0].Flight = app.Flight{Start: now, End: now} },
+			err:     app.ErrInvalidFlight,
+		},
+		"strategy": {
+			corrupt: func(s *app.Snapshot) { s.Slots[0].Strategy.Name = "unknown" },
+			err:     app.ErrInvalidStrategy,
+		},
+		"negative selects": {
+			corrupt: func(s *app.Snapshot) { s.Counters[0].Selects = -1 },
+			err:     app.ErrInvalidCounter,
+		},
+		"negative clicks": {
+			corrupt: func(s *app.Snapshot) { s.Counters[0].Clicks = -1 },
+			err:     app.ErrInvalidCounter,
+		},
+		"clicks exceed selects": {
+			corrupt: func(s *app.Snapshot) { s.Counters[0].Clicks = 3 },
+			err:     app.ErrInvalidCounter,
+		},
+	}
+	require.NoError(t, valid().Validate(snapshotStrategies))
+	for testName, tt := range tests {
+		t.Run(testName, func(t *testing.T) {
+			snapshot := valid()
+			tt.corrupt(&snapshot)
+
+			require.ErrorIs(t, snapshot.Validate(snapshotStrategies), tt.err)
+		})
+	}
+}
+
+func TestSnapshot_Validate_IntervalClicksExceedSelects(t *testing.T) {
+	snapshot := app.Snapshot{
+		Banners:      []app.Banner{{ID: "b"}},
+		Slots:        []app.Slot{{ID: "s"}},
+		SocialGroups: []app.SocialGroup{{ID: "g"}},
+		Counters: []app.Counter{
+			{SlotID: "s", SocialGroupID: "g", BannerID: "b", Start: time.Now().Truncate(app.StatsInterval), Clicks: 1},
+		},
+	}
+
+	require.NoError(t, snapshot.Validate(snapshotStrategies), "a banner may be clicked in the next interval")
+}
@@ -0,0 +1,109 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+)
+
+var ErrInvalidStatsPeriod = errors.New("invalid stats period")
+
+const (
+	// StatsInterval is a granularity of the selects and clicks counters history.
+	StatsInterval = time.Hour
+	// MaxStatsHistory is a period for which the counters history is kept.
+	MaxStatsHistory = 30 * 24 * time.Hour
+
+	// minDiscountWeight is a weight below which discounted counters are neglected.
+	minDiscountWeight = 1e-3
+)
+
+// BannerStats contains selects and clicks counters of a banner.
+// Counters are fractional in case of discounted stats.
+type BannerStats struct {
+	BannerID string
+	Selects  float64
+	Clicks   float64
+	// OtherSelects and OtherClicks are all-time counters of the banner in the other slots for the social group.
+	// They are not filled in the stats history.
+	OtherSelects float64
+	OtherClicks  float64
+	// PooledSelects and PooledClicks are counters of the banner in the slot for all social groups.
+	PooledSelects float64
+	PooledClicks  float64
+}
+
+type SlotStats struct {
+	Banners      []BannerStats
+	TotalSelects float64
+	// PooledTotalSelects is a counter of the slot selects for all social groups.
+	PooledTotalSelects float64
+}
+
+// IntervalStats contains counters registered during StatsInterval starting at Start.
+type IntervalStats struct {
+	Start time.Time
+	Stats SlotStats
+}
+
+// StatsHistorySince returns the start of the counters history since the specified time up to now.
+// The start is limited by MaxStatsHistory because the older counters are not kept.
+// Returns ErrInvalidStatsPeriod in case of the specified time is after now.
+func StatsHistorySince(since, now time.Time) (time.Time, error) {
+	if since.After(now) {
+		return time.Time{}, fmt.Errorf("%w: since %s is after now %s", ErrInvalidStatsPeriod,
+			since.Format(time.RFC3339), now.Format(time.RFC3339))
+	}
+	if oldest := now.Add(-MaxStatsHistory); since.Before(oldest) {
+		return oldest, nil
+	}
+	return since, nil
+}
+
+// AggregateStats sums counters of the history. Counters of an interval which is k intervals older
+// than the interval containing now are multiplied by discount^k. Discount 0 means no discounting.
+func AggregateStats(history []IntervalStats, now time.Time, discount float64) SlotStats {
+	current := now.Truncate(StatsInterval)
+	stats := SlotStats{}
+	indexes := make(map[string]int)
+	for _, interval := range history {
+		weight := 1.0
+		if discount > 0 {
+			age := float64(current.Sub(interval.Start.Truncate(StatsInterval)) / StatsInterval)
+			weight = math.Pow(discount, math.Max(age, 0))
+		}
+		for _, banner := range interval.Stats.Banners {
+			i, ok := indexes[banner.BannerID]
+			if !ok {
+				i = len(stats.Banners)
+				indexes[banner.BannerID] = i
+				stats.Banners = append(stats.Banners, BannerStats{BannerID: banner.BannerID})
+			}
+			stats.Banners[i].Selects += weight * banner.Selects
+			stats.Banners[i].Clicks += weight * banner.Clicks
+			stats.Banners[i].PooledSelects += weight * banner.PooledSelects
+			stats.Banners[i].PooledClicks += weight * banner.PooledClicks
+		}
+		stats.TotalSelects += weight * interval.Stats.TotalSelects
+		stats.PooledTotalSelects += weight * interval.Stats.PooledTotalSelects
+	}
+	return stats
+}
+
+// statsHistoryPeriod returns a period of the counters history which is used by the strategy params.
+// Returns zero in case of all-time counters are used.
+func statsHistoryPeriod(params StrategyParams) time.Duration {
+	period := params.Window
+	if params.Discount > 0 && params.Discount < 1 {
+		intervals := math.Ceil(math.Log(minDiscountWeight) / math.Log(params.Discount))
+		discountPeriod := time.Duration(intervals) * StatsInterval
+		if period == 0 || discountPeriod < period {
+			period = discountPeriod
+		}
+	}
+	if period > MaxStatsHistory {
+		period = MaxStatsHistory
+	}
+	return period
+}
@@ -0,0 +1,99 @@
+package app_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ekhvalov/otus-banners-rotation/internal/app"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAggregateStats(t *testing.T) {
+	now := time.Date(2022, 11, 20, 12, 30, 0, 0, time.UTC)
+	history := []app.IntervalStats{
+		{
+			Start: now.Add(-2 * time.Hour),
+			Stats: app.SlotStats{
+				Banners:      []app.BannerStats{{BannerID: "1", Selects: 4, Clicks: 2}, {BannerID: "2", Selects: 4}},
+				TotalSelects: 8,
+			},
+		},
+		{
+			Start: now,
+			Stats: app.SlotStats{
+				Banners: []app.BannerStats{
+					{BannerID: "1", Selects: 1, PooledSelects: 3},
+					{BannerID: "2", Selects: 1, Clicks: 1, PooledSelects: 1, PooledClicks: 1},
+				},
+				TotalSelects:       2,
+				PooledTotalSelects: 4,
+			},
+		},
+	}
+	tests := map[string]struct {
+		discount float64
+		want     app.SlotStats
+	}{
+		"without discount": {
+			discount: 0,
+			want: app.SlotStats{
+				Banners: []app.BannerStats{
+					{BannerID: "1", Selects: 5, Clicks: 2, PooledSelects: 3},
+					{BannerID: "2", Selects: 5, Clicks: 1, PooledSelects: 1, PooledClicks: 1},
+				},
+				TotalSelects:       10,
+				PooledTotalSelects: 4,
+			},
+		},
+		"with discount": {
+			discount: 0.5,
+			want: app.SlotStats{
+				Banners: []app.BannerStats{
+					{BannerID: "1", Selects: 2, Clicks: 0.5, PooledSelects: 3},
+					{BannerID: "2", Selects: 2, Clicks: 1, PooledSelects: 1, PooledClicks: 1},
+				},
+				TotalSelects:       4,
+				PooledTotalSelects: 4,
+			},
+		},
+	}
+	for testName, tt := range tests {
+		t.Run(testName, func(t *testing.T) {
+			require.Equal(t, tt.want, app.AggregateStats(history, now, tt.discount))
+		})
+	}
+}
+
+func TestAggregateStats_Empty(t *testing.T) {
+	require.Equal(t, app.SlotStats{}, app.AggregateStats(nil, time.Now(), 0.5))
+}
+
+func TestStatsHistorySince(t *testing.T) {
+	now := time.Date(2022, 11, 20, 12, 30, 0, 0, time.UTC)
+	tests := map[string]struct {
+		since time.Time
+		want  time.Time
+	}{
+		"recent":         {since: now.Add(-time.Hour), want: now.Add(-time.Hour)},
+		"now":            {since: now, want: now},
+		"oldest":         {since: now.Add(-app.MaxStatsHistory), want: now.Add(-app.MaxStatsHistory)},
+		"before oldest":  {since: now.Add(-2 * app.MaxStatsHistory), want: now.Add(-app.MaxStatsHistory)},
+		"zero is oldest": {since: time.Time{}, want: now.Add(-app.MaxStatsHistory)},
+	}
+	for testName, tt := range tests {
+		t.Run(testName, func(t *testing.T) {
+			since, err := app.StatsHistorySince(tt.since, now)
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, since)
+		})
+	}
+}
+
+func TestStatsHistorySince_Error_AfterNow(t *testing.T) {
+	now := time.Date(2022, 11, 20, 12, 30, 0, 0, time.UTC)
+
+	_, err := app.StatsHistorySince(now.Add(time.Second), now)
+
+	require.ErrorIs(t, err, app.ErrInvalidStatsPeriod)
+}
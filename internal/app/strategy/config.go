@@ -0,0 +1,128 @@
+package strategy
+
+import (
+	"github.com/ekhvalov/otus-banners-rotation/internal/app"
+	"github.com/spf13/viper"
+)
+
+const (
+	defaultName                  = NameUCB1
+	defaultUCBExploration        = 2.0
+	defaultThompsonSamplingAlpha = 1.0
+	defaultThompsonSamplingBeta  = 1.0
+	defaultEpsilon               = 0.1
+	defaultEpsilonDecay          = 0.001
+	defaultLinUCBDimension       = 32
+	defaultPrior                 = PriorNone
+	defaultPriorSelects          = 10.0
+	defaultPriorClicks           = 0.0
+	defaultPooling               = PoolingNone
+	defaultPoolingStrength       = 10.0
+	defaultPoolingMinSelects     = 100.0
+
+	// minLinUCBDimension is a bias and at least one hashed feature.
+	minLinUCBDimension = 2
+)
+
+func NewConfig(v *viper.Viper) Config {
+	return Config{v: v}
+}
+
+type Config struct {
+	v *viper.Viper
+}
+
+// GetDefaultParams returns the strategy params which are used for slots without own params.
+func (c *Config) GetDefaultParams() app.StrategyParams {
+	return app.StrategyParams{
+		Name:              c.GetName(),
+		UCBExploration:    float64Ptr(c.GetUCBExploration()),
+		Epsilon:           float64Ptr(c.GetEpsilonGreedyEpsilon()),
+		EpsilonDecay:      c.GetEpsilonGreedyDecay(),
+		Alpha:             c.GetThompsonSamplingAlpha(),
+		Beta:              c.GetThompsonSamplingBeta(),
+		Prior:             c.GetPrior(),
+		PriorSelects:      float64Ptr(c.GetPriorSelects()),
+		PriorClicks:       float64Ptr(c.GetPriorClicks()),
+		Pooling:           c.GetPooling(),
+		PoolingStrength:   float64Ptr(c.GetPoolingStrength()),
+		PoolingMinSelects: float64Ptr(c.GetPoolingMinSelects()),
+	}
+}
+
+func (c *Config) GetName() string {
+	if name := c.v.GetString("rotator.strategy"); name != "" {
+		return name
+	}
+	return defaultName
+}
+
+func (c *Config) GetUCBExploration() float64 {
+	return c.getFloat64OrDefault("rotator.ucb.exploration", defaultUCBExploration)
+}
+
+func (c *Config) GetThompsonSamplingAlpha() float64 {
+	return c.getFloat64OrDefault("rotator.thompson_sampling.alpha", defaultThompsonSamplingAlpha)
+}
+
+func (c *Config) GetThompsonSamplingBeta() float64 {
+	return c.getFloat64OrDefault("rotator.thompson_sampling.beta", defaultThompsonSamplingBeta)
+}
+
+func (c *Config) GetEpsilonGreedyEpsilon() float64 {
+	return c.getFloat64OrDefault("rotator.epsilon_greedy.epsilon", defaultEpsilon)
+}
+
+func (c *Config) GetEpsilonGreedyDecay() float64 {
+	return c.getFloat64OrDefault("rotator.epsilon_greedy.decay", defaultEpsilonDecay)
+}
+
+func (c *Config) GetPrior() string {
+	if prior := c.v.GetString("rotator.prior.mode"); prior != "" {
+		return prior
+	}
+	return defaultPrior
+}
+
+func (c *Config) GetPriorSelects() float64 {
+	return c.getFloat64OrDefault("rotator.prior.selects", defaultPriorSelects)
+}
+
+func (c *Config) GetPriorClicks() float64 {
+	return c.getFloat64OrDefault("rotator.prior.clicks", defaultPriorClicks)
+}
+
+func (c *Config) GetPooling() string {
+	if pooling := c.v.GetString("rotator.pooling.mode"); pooling != "" {
+		return pooling
+	}
+	return defaultPooling
+}
+
+func (c *Config) GetPoolingStrength() float64 {
+	return c.getFloat64OrDefault("rotator.pooling.strength", defaultPoolingStrength)
+}
+
+func (c *Config) GetPoolingMinSelects() float64 {
+	return c.getFloat64OrDefault("rotator.pooling.min_selects", defaultPoolingMinSelects)
+}
+
+// GetLinUCBDimension returns a length of the feature vectors of the "lin_ucb" strategy.
+// Changing it invalidates the learned models.
+func (c *Config) GetLinUCBDimension() int {
+	if !c.v.IsSet("rotator.lin_ucb.dimension") {
+		return defaultLinUCBDimension
+	}
+	return c.v.GetInt("rotator.lin_ucb.dimension")
+}
+
+func (c *Config) getFloat64OrDefault(key string, defaultValue float64) float64 {
+	if !c.v.IsSet(key) {
+		return defaultValue
+	}
+	return c.v.GetFloat64(key)
+}
+
+func float64Ptr(v float64) *float64 {
+	return &v
+}
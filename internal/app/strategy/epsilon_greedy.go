@@ -0,0 +1,50 @@
+package strategy
+
+import (
+	"github.com/ekhvalov/otus-banners-rotation/internal/app"
+)
+
+// NewEpsilonGreedy creates a strategy which selects a random banner with the epsilon probability
+// and a banner with the highest click-through rate otherwise.
+func NewEpsilonGreedy(epsilon float64) app.Strategy {
+	return NewAnnealingEpsilonGreedy(epsilon, 0)
+}
+
+// NewAnnealingEpsilonGreedy creates an epsilon-greedy strategy which decreases the exploration probability
+// as epsilon / (1 + decay * totalSelects), so the strategy tends to exploit more as evidence accumulates.
+func NewAnnealingEpsilonGreedy(epsilon, decay float64) app.Strategy {
+	return epsilonGreedy{epsilon: epsilon, decay: decay}
+}
+
+type epsilonGreedy struct {
+	epsilon float64
+	decay   float64
+}
+
+func (s epsilonGreedy) SelectBanner(stats app.SlotStats, random app.Random) string {
+	if len(stats.Banners) == 0 {
+		return ""
+	}
+	for _, banner := range stats.Banners {
+		if banner.Selects == 0 {
+			return banner.BannerID
+		}
+	}
+	if random.Float64() < s.currentEpsilon(stats.TotalSelects) {
+		return stats.Banners[random.Intn(len(stats.Banners))].BannerID
+	}
+	bannerID := ""
+	maxRatio := -1.0
+	for _, banner := range stats.Banners {
+		ratio := banner.Clicks / banner.Selects
+		if ratio > maxRatio {
+			bannerID = banner.BannerID
+			maxRatio = ratio
+		}
+	}
+	return bannerID
+}
+
+func (s epsilonGreedy) currentEpsilon(totalSelects float64) float64 {
+	return s.epsilon / (1 + s.decay*totalSelects)
+}
@@ -0,0 +1,94 @@
+package strategy_test
+
+import (
+	"testing"
+
+	"github.com/ekhvalov/otus-banners-rotation/internal/app"
+	"github.com/ekhvalov/otus-banners-rotation/internal/app/strategy"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEpsilonGreedy_SelectBanner(t *testing.T) {
+	random := app.NewRandom(1)
+	tests := map[string]struct {
+		stats app.SlotStats
+		want  string
+	}{
+		"no banners": {
+			stats: app.SlotStats{},
+			want:  "",
+		},
+		"never selected banner first": {
+			stats: app.SlotStats{
+				Banners: []app.BannerStats{
+					{BannerID: "1", Selects: 10, Clicks: 9},
+					{BannerID: "2", Selects: 0, Clicks: 0},
+				},
+				TotalSelects: 10,
+			},
+			want: "2",
+		},
+		"highest ratio": {
+			stats: app.SlotStats{
+				Banners: []app.BannerStats{
+					{BannerID: "1", Selects: 50, Clicks: 5},
+					{BannerID: "2", Selects: 50, Clicks: 25},
+					{BannerID: "3", Selects: 50, Clicks: 10},
+				},
+				TotalSelects: 150,
+			},
+			want: "2",
+		},
+	}
+	for testName, tt := range tests {
+		t.Run(testName, func(t *testing.T) {
+			got := strategy.NewEpsilonGreedy(0).SelectBanner(tt.stats, random)
+
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestEpsilonGreedy_SelectBanner_Exploration(t *testing.T) {
+	random := app.NewRandom(1)
+	stats := app.SlotStats{
+		Banners: []app.BannerStats{
+			{BannerID: "1", Selects: 50, Clicks: 25},
+			{BannerID: "2", Selects: 50, Clicks: 5},
+		},
+		TotalSelects: 100,
+	}
+	tests := map[string]struct {
+		strategy   app.Strategy
+		minSelects int
+		maxSelects int
+	}{
+		"always explore": {
+			strategy:   strategy.NewEpsilonGreedy(1),
+			minSelects: 300,
+			maxSelects: 700,
+		},
+		"never explore": {
+			strategy:   strategy.NewEpsilonGreedy(0),
+			minSelects: 0,
+			maxSelects: 0,
+		},
+		"annealed exploration": {
+			strategy:   strategy.NewAnnealingEpsilonGreedy(1, 1),
+			minSelects: 0,
+			maxSelects: 50,
+		},
+	}
+	for testName, tt := range tests {
+		t.Run(testName, func(t *testing.T) {
+			selects := make(map[string]int)
+
+			for i := 0; i < 1000; i++ {
+				selects[tt.strategy.SelectBanner(stats, random)]++
+			}
+
+			require.GreaterOrEqual(t, selects["2"], tt.minSelects)
+			require.LessOrEqual(t, selects["2"], tt.maxSelects)
+		})
+	}
+}
@@ -0,0 +1,172 @@
+package strategy
+
+import (
+	"fmt"
+
+	"github.com/ekhvalov/otus-banners-rotation/internal/app"
+)
+
+const (
+	NameUCB1                   = "ucb1"
+	NameUCB1Tuned              = "ucb1_tuned"
+	NameUCBV                   = "ucb_v"
+	NameKLUCB                  = "kl_ucb"
+	NameThompsonSampling       = "thompson_sampling"
+	NameEpsilonGreedy          = "epsilon_greedy"
+	NameAnnealingEpsilonGreedy = "annealing_epsilon_greedy"
+	NameLinUCB                 = "lin_ucb"
+)
+
+// NewFactory creates a strategy factory. Zero or nil slot params are replaced by the config defaults.
+func NewFactory(config Config) app.StrategyFactory {
+	return factory{
+		defaults:        config.GetDefaultParams(),
+		linUCBDimension: config.GetLinUCBDimension(),
+	}
+}
+
+type factory struct {
+	defaults        app.StrategyParams
+	linUCBDimension int
+}
+
+func (f factory) CreateStrategy(params app.StrategyParams) (app.Strategy, app.ContextualStrategy, error) {
+	params = f.withDefaults(params)
+	if err := validateParams(params); err != nil {
+		return nil, nil, err
+	}
+	// The priors are not applied to the contextual strategy which does not use the counters.
+	if params.Name == NameLinUCB {
+		if f.linUCBDimension < minLinUCBDimension {
+			return nil, nil, fmt.Errorf("%w: lin ucb dimension must be at least %d", app.ErrInvalidStrategy,
+				minLinUCBDimension)
+		}
+		return nil, NewLinUCB(*params.UCBExploration, f.linUCBDimension), nil
+	}
+	strategy, err := f.createStrategy(params)
+	if err != nil {
+		return nil, nil, err
+	}
+	if params.Prior != PriorNone {
+		strategy = withPrior{
+			Strategy: strategy,
+			mode:     params.Prior,
+			selects:  *params.PriorSelects,
+			clicks:   *params.PriorClicks,
+		}
+	}
+	if params.Pooling == PoolingHierarchical {
+		strategy = withPooling{
+			Strategy:   strategy,
+			strength:   *params.PoolingStrength,
+			minSelects: *params.PoolingMinSelects,
+		}
+	}
+	return strategy, nil, nil
+}
+
+func (f factory) createStrategy(params app.StrategyParams) (app.Strategy, error) {
+	switch params.Name {
+	case NameUCB1:
+		return NewUCB1(*params.UCBExploration), nil
+	case NameUCB1Tuned:
+		return NewUCB1Tuned(*params.UCBExploration), nil
+	case NameUCBV:
+		return NewUCBV(*params.UCBExploration), nil
+	case NameKLUCB:
+		return NewKLUCB(*params.UCBExploration), nil
+	case NameThompsonSampling:
+		return NewThompsonSampling(params.Alpha, params.Beta), nil
+	case NameEpsilonGreedy:
+		return NewEpsilonGreedy(*params.Epsilon), nil
+	case NameAnnealingEpsilonGreedy:
+		return NewAnnealingEpsilonGreedy(*params.Epsilon, params.EpsilonDecay), nil
+	default:
+		return nil, fmt.Errorf("%w: unknown name '%s'", app.ErrInvalidStrategy, params.Name)
+	}
+}
+
+func (f factory) withDefaults(params app.StrategyParams) app.StrategyParams {
+	if params.Name == "" {
+		params.Name = f.defaults.Name
+	}
+	if params.UCBExploration == nil {
+		params.UCBExploration = f.defaults.UCBExploration
+	}
+	if params.Epsilon == nil {
+		params.Epsilon = f.defaults.Epsilon
+	}
+	if params.EpsilonDecay == 0 {
+		params.EpsilonDecay = f.defaults.EpsilonDecay
+	}
+	if params.Alpha == 0 {
+		params.Alpha = f.defaults.Alpha
+	}
+	if params.Beta == 0 {
+		params.Beta = f.defaults.Beta
+	}
+	if params.Prior == "" {
+		params.Prior = f.defaults.Prior
+	}
+	if params.PriorSelects == nil {
+		params.PriorSelects = f.defaults.PriorSelects
+	}
+	if params.PriorClicks == nil {
+		params.PriorClicks = f.defaults.PriorClicks
+	}
+	if params.Pooling == "" {
+		params.Pooling = f.defaults.Pooling
+	}
+	if params.PoolingStrength == nil {
+		params.PoolingStrength = f.defaults.PoolingStrength
+	}
+	if params.PoolingMinSelects == nil {
+		params.PoolingMinSelects = f.defaults.PoolingMinSelects
+	}
+	return params
+}
+
+func validateParams(params app.StrategyParams) error {
+	if *params.UCBExploration < 0 {
+		return fmt.Errorf("%w: ucb exploration must not be negative", app.ErrInvalidStrategy)
+	}
+	if *params.Epsilon < 0 || *params.Epsilon > 1 {
+		return fmt.Errorf("%w: epsilon must be in range [0, 1]", app.ErrInvalidStrategy)
+	}
+	if params.EpsilonDecay < 0 {
+		return fmt.Errorf("%w: epsilon decay must not be negative", app.ErrInvalidStrategy)
+	}
+	if params.Alpha <= 0 || params.Beta <= 0 {
+		return fmt.Errorf("%w: alpha and beta must be positive", app.ErrInvalidStrategy)
+	}
+	if params.Window < 0 || params.Window > app.MaxStatsHistory {
+		return fmt.Errorf("%w: window must be in range [0, %s]", app.ErrInvalidStrategy, app.MaxStatsHistory)
+	}
+	// The counters history is kept per StatsInterval, so a shorter window would use more counters than it states.
+	if params.Window%app.StatsInterval != 0 {
+		return fmt.Errorf("%w: window must be a multiple of %s", app.ErrInvalidStrategy, app.StatsInterval)
+	}
+	if params.Discount < 0 || params.Discount > 1 {
+		return fmt.Errorf("%w: discount must be in range [0, 1]", app.ErrInvalidStrategy)
+	}
+	switch params.Prior {
+	case PriorNone, PriorPseudoCounts, PriorSlotAverage, PriorBannerHistory:
+	default:
+		return fmt.Errorf("%w: unknown prior '%s'", app.ErrInvalidStrategy, params.Prior)
+	}
+	// The counters of the other slots are not kept per StatsInterval, so the recent stats have none of them.
+	if params.Prior == PriorBannerHistory && (params.Window > 0 || (params.Discount > 0 && params.Discount < 1)) {
+		return fmt.Errorf("%w: prior '%s' can not be used with window or discount", app.ErrInvalidStrategy,
+			PriorBannerHistory)
+	}
+	if *params.PriorSelects < 0 || *params.PriorClicks < 0 || *params.PriorClicks > *params.PriorSelects {
+		return fmt.Errorf("%w: prior clicks must be in range [0, prior selects]", app.ErrInvalidStrategy)
+	}
+	if params.Pooling != PoolingNone && params.Pooling != PoolingHierarchical {
+		return fmt.Errorf("%w: unknown pooling '%s'", app.ErrInvalidStrategy, params.Pooling)
+	}
+	if *params.PoolingStrength < 0 || *params.PoolingMinSelects < 0 {
+		return fmt.Errorf("%w: pooling strength and min selects must not be negative", app.ErrInvalidStrategy)
+	}
+	return nil
+}
@@ -0,0 +1,209 @@
+package strategy_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ekhvalov/otus-banners-rotation/internal/app"
+	"github.com/ekhvalov/otus-banners-rotation/internal/app/strategy"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFactory_CreateStrategy(t *testing.T) {
+	tests := map[string]struct {
+		defaultName string
+		params      app.StrategyParams
+		err         error
+	}{
+		"default": {
+			params: app.StrategyParams{},
+		},
+		"configured default": {
+			defaultName: strategy.NameThompsonSampling,
+			params:      app.StrategyParams{},
+		},
+		"ucb1": {
+			params: app.StrategyParams{Name: strategy.NameUCB1, UCBExploration: float64Ptr(0.5)},
+		},
+		"ucb1 tuned": {
+			params: app.StrategyParams{Name: strategy.NameUCB1Tuned},
+		},
+		"ucb v": {
+			params: app.StrategyParams{Name: strategy.NameUCBV, UCBExploration: float64Ptr(1)},
+		},
+		"kl ucb": {
+			params: app.StrategyParams{Name: strategy.NameKLUCB, UCBExploration: float64Ptr(0.5)},
+		},
+		"ucb1 without exploration": {
+			params: app.StrategyParams{Name: strategy.NameUCB1, UCBExploration: float64Ptr(0)},
+		},
+		"invalid ucb exploration": {
+			params: app.StrategyParams{Name: strategy.NameUCB1, UCBExploration: float64Ptr(-1)},
+			err:    app.ErrInvalidStrategy,
+		},
+		"thompson sampling": {
+			params: app.StrategyParams{Name: strategy.NameThompsonSampling, Alpha: 2, Beta: 10},
+		},
+		"epsilon greedy": {
+			params: app.StrategyParams{Name: strategy.NameEpsilonGreedy, Epsilon: float64Ptr(0.2)},
+		},
+		"greedy": {
+			params: app.StrategyParams{Name: strategy.NameEpsilonGreedy, Epsilon: float64Ptr(0)},
+		},
+		"annealing epsilon greedy": {
+			params: app.StrategyParams{Name: strategy.NameAnnealingEpsilonGreedy, Epsilon: float64Ptr(1), EpsilonDecay: 0.1},
+		},
+		"sliding window ucb": {
+			params: app.StrategyParams{Name: strategy.NameUCB1, Window: 24 * time.Hour},
+		},
+		"discounted thompson sampling": {
+			params: app.StrategyParams{Name: strategy.NameThompsonSampling, Discount: 0.95},
+		},
+		"invalid window": {
+			params: app.StrategyParams{Name: strategy.NameUCB1, Window: -time.Hour},
+			err:    app.ErrInvalidStrategy,
+		},
+		"window shorter than stats interval": {
+			params: app.StrategyParams{Name: strategy.NameUCB1, Window: 10 * time.Minute},
+			err:    app.ErrInvalidStrategy,
+		},
+		"window not a multiple of stats interval": {
+			params: app.StrategyParams{Name: strategy.NameUCB1, Window: 90 * time.Minute},
+			err:    app.ErrInvalidStrategy,
+		},
+		"too long window": {
+			params: app.StrategyParams{Name: strategy.NameUCB1, Window: app.MaxStatsHistory + time.Hour},
+			err:    app.ErrInvalidStrategy,
+		},
+		"invalid discount": {
+			params: app.StrategyParams{Name: strategy.NameUCB1, Discount: 1.5},
+			err:    app.ErrInvalidStrategy,
+		},
+		"lin ucb": {
+			params: app.StrategyParams{Name: strategy.NameLinUCB, UCBExploration: float64Ptr(0.5)},
+		},
+		"prior": {
+			params: app.StrategyParams{
+				Name: strategy.NameUCB1, Prior: strategy.PriorBannerHistory, PriorSelects: float64Ptr(5),
+			},
+		},
+		"banner history prior with window": {
+			params: app.StrategyParams{Name: strategy.NameUCB1, Prior: strategy.PriorBannerHistory, Window: time.Hour},
+			err:    app.ErrInvalidStrategy,
+		},
+		"banner history prior with discount": {
+			params: app.StrategyParams{Name: strategy.NameUCB1, Prior: strategy.PriorBannerHistory, Discount: 0.9},
+			err:    app.ErrInvalidStrategy,
+		},
+		"slot average prior with window": {
+			params: app.StrategyParams{Name: strategy.NameUCB1, Prior: strategy.PriorSlotAverage, Window: time.Hour},
+		},
+		"zero prior clicks": {
+			params: app.StrategyParams{
+				Prior: strategy.PriorPseudoCounts, PriorSelects: float64Ptr(5), PriorClicks: float64Ptr(0),
+			},
+		},
+		"unknown prior": {
+			params: app.StrategyParams{Name: strategy.NameUCB1, Prior: "unknown"},
+			err:    app.ErrInvalidStrategy,
+		},
+		"invalid prior clicks": {
+			params: app.StrategyParams{
+				Prior: strategy.PriorPseudoCounts, PriorSelects: float64Ptr(5), PriorClicks: float64Ptr(10),
+			},
+			err: app.ErrInvalidStrategy,
+		},
+		"hierarchical pooling": {
+			params: app.StrategyParams{Name: strategy.NameUCB1, Pooling: strategy.PoolingHierarchical},
+		},
+		"unknown pooling": {
+			params: app.StrategyParams{Name: strategy.NameUCB1, Pooling: "unknown"},
+			err:    app.ErrInvalidStrategy,
+		},
+		"invalid pooling strength": {
+			params: app.StrategyParams{Pooling: strategy.PoolingHierarchical, PoolingStrength: float64Ptr(-1)},
+			err:    app.ErrInvalidStrategy,
+		},
+		"unknown name": {
+			params: app.StrategyParams{Name: "unknown"},
+			err:    app.ErrInvalidStrategy,
+		},
+		"unknown default name": {
+			defaultName: "unknown",
+			params:      app.StrategyParams{},
+			err:         app.ErrInvalidStrategy,
+		},
+		"invalid epsilon": {
+			params: app.StrategyParams{Name: strategy.NameEpsilonGreedy, Epsilon: float64Ptr(1.5)},
+			err:    app.ErrInvalidStrategy,
+		},
+		"invalid alpha": {
+			params: app.StrategyParams{Name: strategy.NameThompsonSampling, Alpha: -1},
+			err:    app.ErrInvalidStrategy,
+		},
+	}
+	for testName, tt := range tests {
+		t.Run(testName, func(t *testing.T) {
+			v := viper.New()
+			v.Set("rotator.strategy", tt.defaultName)
+			factory := strategy.NewFactory(strategy.NewConfig(v))
+
+			got, gotContextual, err := factory.CreateStrategy(tt.params)
+
+			if tt.err == nil {
+				require.NoError(t, err)
+				require.True(t, (got == nil) != (gotContextual == nil), "exactly one of the strategies must be created")
+			} else {
+				require.ErrorIs(t, err, tt.err)
+				require.Nil(t, got)
+				require.Nil(t, gotContextual)
+			}
+		})
+	}
+}
+
+func TestFactory_CreateStrategy_InvalidLinUCBDimension(t *testing.T) {
+	v := viper.New()
+	v.Set("rotator.lin_ucb.dimension", 1)
+	factory := strategy.NewFactory(strategy.NewConfig(v))
+
+	got, gotContextual, err := factory.CreateStrategy(app.StrategyParams{Name: strategy.NameLinUCB})
+
+	require.ErrorIs(t, err, app.ErrInvalidStrategy)
+	require.Nil(t, got)
+	require.Nil(t, gotContextual)
+}
+
+func TestFactory_CreateStrategy_Contextual(t *testing.T) {
+	factory := strategy.NewFactory(strategy.NewConfig(viper.New()))
+
+	got, gotContextual, err := factory.CreateStrategy(app.StrategyParams{Name: strategy.NameLinUCB})
+
+	require.NoError(t, err)
+	require.Nil(t, got, "the contextual strategy must not select by the counters")
+	require.NotNil(t, gotContextual)
+}
+
+func TestFactory_CreateStrategy_ZeroEpsilon(t *testing.T) {
+	factory := strategy.NewFactory(strategy.NewConfig(viper.New()))
+	stats := app.SlotStats{
+		Banners: []app.BannerStats{
+			{BannerID: "1", Selects: 50, Clicks: 5},
+			{BannerID: "2", Selects: 50, Clicks: 25},
+		},
+		TotalSelects: 100,
+	}
+
+	got, _, err := factory.CreateStrategy(app.StrategyParams{Name: strategy.NameEpsilonGreedy, Epsilon: float64Ptr(0)})
+
+	require.NoError(t, err)
+	random := app.NewRandom(1)
+	for i := 0; i < 1000; i++ {
+		require.Equal(t, "2", got.SelectBanner(stats, random), "zero epsilon must not be replaced by the default")
+	}
+}
+
+func float64Ptr(v float64) *float64 {
+	return &v
+}
@@ -0,0 +1,114 @@
+package strategy
+
+import (
+	"hash/fnv"
+	"math"
+
+	"github.com/ekhvalov/otus-banners-rotation/internal/app"
+)
+
+// NewLinUCB creates the LinUCB strategy which learns a ridge regression of the banner click-through ratio
+// over the request feature vector x and selects a banner with the highest score: theta * x + c * sqrt(x * A^-1 * x),
+// where A = I + sum of x * x^T of the banner selects, theta = A^-1 * b and b is a sum of x of the banner clicks.
+// The features are hashed into a vector of the dimension, the first component of the vector is a bias.
+func NewLinUCB(exploration float64, dimension int) app.ContextualStrategy {
+	return linUCB{exploration: exploration, dimension: dimension}
+}
+
+type linUCB struct {
+	exploration float64
+	dimension   int
+}
+
+func (s linUCB) Vectorize(features app.Features) []float64 {
+	vector := make([]float64, s.dimension)
+	vector[0] = 1
+	for name, value := range features {
+		h := fnv.New32a()
+		_, _ = h.Write([]byte(name))
+		_, _ = h.Write([]byte{0})
+		_, _ = h.Write([]byte(value))
+		vector[1+int(h.Sum32()%uint32(s.dimension-1))]++
+	}
+	return vector
+}
+
+func (s linUCB) SelectContextualBanner(stats []app.LinearStats, vector []float64) string {
+	bannerID := ""
+	maxScore := math.Inf(-1)
+	n := len(vector)
+	for _, banner := range stats {
+		a := make([]float64, n*n)
+		copy(a, banner.A)
+		for i := 0; i < n; i++ {
+			a[i*n+i]++
+		}
+		l, ok := cholesky(a, n)
+		if !ok {
+			continue
+		}
+		theta := solveCholesky(l, n, banner.B)
+		z := solveCholesky(l, n, vector)
+		score := dot(theta, vector) + s.exploration*math.Sqrt(math.Max(dot(vector, z), 0))
+		if score > maxScore {
+			bannerID = banner.BannerID
+			maxScore = score
+		}
+	}
+	return bannerID
+}
+
+// cholesky returns the lower triangular matrix L (row-major) which satisfies a = L * L^T.
+// Returns false in case of the matrix is not positive definite.
+func cholesky(a []float64, n int) ([]float64, bool) {
+	l := make([]float64, n*n)
+	for i := 0; i < n; i++ {
+		for j := 0; j <= i; j++ {
+			sum := a[i*n+j]
+			for k := 0; k < j; k++ {
+				sum -= l[i*n+k] * l[j*n+k]
+			}
+			if i == j {
+				if sum <= 0 {
+					return nil, false
+				}
+				l[i*n+i] = math.Sqrt(sum)
+			} else {
+				l[i*n+j] = sum / l[j*n+j]
+			}
+		}
+	}
+	return l, true
+}
+
+// solveCholesky solves L * L^T * x = b.
+func solveCholesky(l []float64, n int, b []float64) []float64 {
+	y := make([]float64, n)
+	for i := 0; i < n; i++ {
+		sum := 0.0
+		if i < len(b) {
+			sum = b[i]
+		}
+		for k := 0; k < i; k++ {
+			sum -= l[i*n+k] * y[k]
+		}
+		y[i] = sum / l[i*n+i]
+	}
+	x := make([]float64, n)
+	for i := n - 1; i >= 0; i-- {
+		sum := y[i]
+		for k := i + 1; k < n; k++ {
+			sum -= l[k*n+i] * x[k]
+		}
+		x[i] = sum / l[i*n+i]
+	}
+	return x
+}
+
+func dot(a, b []float64) float64 {
+	sum := 0.0
+	for i := range a {
+		sum += a[i] * b[i]
+	}
+	return sum
+}
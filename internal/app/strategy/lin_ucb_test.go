@@ -0,0 +1,81 @@
+package strategy_test
+
+import (
+	"testing"
+
+	"github.com/ekhvalov/otus-banners-rotation/internal/app"
+	"github.com/ekhvalov/otus-banners-rotation/internal/app/strategy"
+	"github.com/stretchr/testify/require"
+)
+
+const linUCBDimension = 8
+
+func TestLinUCB_Vectorize(t *testing.T) {
+	s := strategy.NewLinUCB(1, linUCBDimension)
+
+	empty := s.Vectorize(nil)
+	vector := s.Vectorize(app.Features{"device": "mobile", "locale": "en"})
+
+	require.Equal(t, []float64{1, 0, 0, 0, 0, 0, 0, 0}, empty)
+	require.Len(t, vector, linUCBDimension)
+	require.Equal(t, 1.0, vector[0])
+	require.Equal(t, 3.0, sum(vector))
+	require.Equal(t, vector, s.Vectorize(app.Features{"locale": "en", "device": "mobile"}))
+}
+
+func TestLinUCB_SelectContextualBanner(t *testing.T) {
+	s := strategy.NewLinUCB(0.1, linUCBDimension)
+	mobile := s.Vectorize(app.Features{"device": "mobile"})
+	desktop := s.Vectorize(app.Features{"device": "desktop"})
+	stats := []app.LinearStats{
+		trainLinearStats("1", []linearCounter{{mobile, 100, 50}, {desktop, 100, 1}}),
+		trainLinearStats("2", []linearCounter{{mobile, 100, 1}, {desktop, 100, 50}}),
+	}
+
+	require.Equal(t, "1", s.SelectContextualBanner(stats, mobile))
+	require.Equal(t, "2", s.SelectContextualBanner(stats, desktop))
+	require.Equal(t, "", s.SelectContextualBanner(nil, mobile))
+}
+
+func TestLinUCB_SelectContextualBanner_Exploration(t *testing.T) {
+	s := strategy.NewLinUCB(2, linUCBDimension)
+	vector := s.Vectorize(app.Features{"device": "mobile"})
+	stats := []app.LinearStats{
+		trainLinearStats("1", []linearCounter{{vector, 1000, 100}}),
+		{BannerID: "2"},
+	}
+
+	require.Equal(t, "2", s.SelectContextualBanner(stats, vector))
+}
+
+type linearCounter struct {
+	vector  []float64
+	selects float64
+	clicks  float64
+}
+
+// trainLinearStats makes the stats of a banner from the selects and clicks counters of the feature vectors.
+func trainLinearStats(bannerID string, counters []linearCounter) app.LinearStats {
+	stats := app.LinearStats{
+		BannerID: bannerID,
+		A:        make([]float64, linUCBDimension*linUCBDimension),
+		B:        make([]float64, linUCBDimension),
+	}
+	for _, counter := range counters {
+		for i, xi := range counter.vector {
+			for j, xj := range counter.vector {
+				stats.A[i*linUCBDimension+j] += counter.selects * xi * xj
+			}
+			stats.B[i] += counter.clicks * xi
+		}
+	}
+	return stats
+}
+
+func sum(vector []float64) float64 {
+	result := 0.0
+	for _, value := range vector {
+		result += value
+	}
+	return result
+}
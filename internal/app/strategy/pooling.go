@@ -0,0 +1,50 @@
+package strategy
+
+import (
+	"github.com/ekhvalov/otus-banners-rotation/internal/app"
+)
+
+const (
+	// PoolingNone learns every social group of a slot independently.
+	PoolingNone = "none"
+	// PoolingHierarchical shrinks the social group counters toward the counters of the slot for all social groups.
+	PoolingHierarchical = "hierarchical"
+)
+
+// withPooling shrinks the social group stats toward the pooled slot stats before the banner selection.
+// Every banner gets strength pseudo-selects with the pooled click-through ratio of the banner.
+// The pooled stats are used instead of the social group ones when the group has less than minSelects selects.
+type withPooling struct {
+	app.Strategy
+	strength   float64
+	minSelects float64
+}
+
+func (s withPooling) SelectBanner(stats app.SlotStats, random app.Random) string {
+	return s.Strategy.SelectBanner(s.pool(stats), random)
+}
+
+func (s withPooling) pool(stats app.SlotStats) app.SlotStats {
+	result := app.SlotStats{
+		Banners:            make([]app.BannerStats, len(stats.Banners)),
+		TotalSelects:       stats.TotalSelects,
+		PooledTotalSelects: stats.PooledTotalSelects,
+	}
+	fallback := stats.TotalSelects < s.minSelects && stats.PooledTotalSelects > stats.TotalSelects
+	if fallback {
+		result.TotalSelects = stats.PooledTotalSelects
+	}
+	for i, banner := range stats.Banners {
+		switch {
+		case fallback:
+			banner.Selects = banner.PooledSelects
+			banner.Clicks = banner.PooledClicks
+		case banner.PooledSelects > 0:
+			banner.Selects += s.strength
+			banner.Clicks += s.strength * banner.PooledClicks / banner.PooledSelects
+			result.TotalSelects += s.strength
+		}
+		result.Banners[i] = banner
+	}
+	return result
+}
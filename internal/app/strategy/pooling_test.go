@@ -0,0 +1,97 @@
+package strategy_test
+
+import (
+	"testing"
+
+	"github.com/ekhvalov/otus-banners-rotation/internal/app"
+	"github.com/ekhvalov/otus-banners-rotation/internal/app/strategy"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPooling_SelectBanner(t *testing.T) {
+	random := app.NewRandom(1)
+	tests := map[string]struct {
+		params app.StrategyParams
+		stats  app.SlotStats
+		want   string
+	}{
+		"none": {
+			params: app.StrategyParams{Pooling: strategy.PoolingNone},
+			stats: app.SlotStats{
+				Banners: []app.BannerStats{
+					{BannerID: "1", Selects: 10, Clicks: 1, PooledSelects: 10000, PooledClicks: 5000},
+					{BannerID: "2", Selects: 10, Clicks: 5, PooledSelects: 10000, PooledClicks: 100},
+				},
+				TotalSelects:       20,
+				PooledTotalSelects: 20000,
+			},
+			want: "2",
+		},
+		"zero strength": {
+			params: app.StrategyParams{
+				Pooling:           strategy.PoolingHierarchical,
+				PoolingStrength:   float64Ptr(0),
+				PoolingMinSelects: float64Ptr(0),
+			},
+			stats: app.SlotStats{
+				Banners: []app.BannerStats{
+					{BannerID: "1", Selects: 10, Clicks: 1, PooledSelects: 10000, PooledClicks: 5000},
+					{BannerID: "2", Selects: 10, Clicks: 5, PooledSelects: 10000, PooledClicks: 100},
+				},
+				TotalSelects:       20,
+				PooledTotalSelects: 20000,
+			},
+			want: "2",
+		},
+		"shrinkage": {
+			params: app.StrategyParams{
+				Pooling:           strategy.PoolingHierarchical,
+				PoolingStrength:   float64Ptr(1000),
+				PoolingMinSelects: float64Ptr(1),
+			},
+			stats: app.SlotStats{
+				Banners: []app.BannerStats{
+					{BannerID: "1", Selects: 10, Clicks: 1, PooledSelects: 10000, PooledClicks: 5000},
+					{BannerID: "2", Selects: 10, Clicks: 5, PooledSelects: 10000, PooledClicks: 100},
+				},
+				TotalSelects:       20,
+				PooledTotalSelects: 20000,
+			},
+			want: "1",
+		},
+		"fallback to the slot stats": {
+			params: app.StrategyParams{Pooling: strategy.PoolingHierarchical, PoolingMinSelects: float64Ptr(100)},
+			stats: app.SlotStats{
+				Banners: []app.BannerStats{
+					{BannerID: "1", Selects: 0, Clicks: 0, PooledSelects: 10000, PooledClicks: 5000},
+					{BannerID: "2", Selects: 0, Clicks: 0, PooledSelects: 10000, PooledClicks: 100},
+				},
+				TotalSelects:       0,
+				PooledTotalSelects: 20000,
+			},
+			want: "1",
+		},
+		"no slot stats": {
+			params: app.StrategyParams{Pooling: strategy.PoolingHierarchical, PoolingMinSelects: float64Ptr(100)},
+			stats: app.SlotStats{
+				Banners: []app.BannerStats{
+					{BannerID: "1", Selects: 10, Clicks: 1},
+					{BannerID: "2", Selects: 0, Clicks: 0},
+				},
+				TotalSelects: 10,
+			},
+			want: "2",
+		},
+	}
+	for testName, tt := range tests {
+		t.Run(testName, func(t *testing.T) {
+			factory := strategy.NewFactory(strategy.NewConfig(viper.New()))
+			tt.params.Name = strategy.NameUCB1
+			s, _, err := factory.CreateStrategy(tt.params)
+			require.NoError(t, err)
+
+			require.Equal(t, tt.want, s.SelectBanner(tt.stats, random))
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package strategy
+
+import (
+	"github.com/ekhvalov/otus-banners-rotation/internal/app"
+)
+
+const (
+	// PriorNone disables the priors, so a banner without selects is explored first.
+	PriorNone = "none"
+	// PriorPseudoCounts adds the same pseudo-selects and pseudo-clicks to every banner.
+	PriorPseudoCounts = "pseudo_counts"
+	// PriorSlotAverage adds pseudo-selects with the average click-through ratio of the slot.
+	PriorSlotAverage = "slot_average"
+	// PriorBannerHistory adds pseudo-selects with the click-through ratio of the banner in the other slots.
+	// The slot average is used for a banner without history.
+	PriorBannerHistory = "banner_history"
+)
+
+// withPrior adds the prior pseudo-counters to the stats before the banner selection.
+type withPrior struct {
+	app.Strategy
+	mode    string
+	selects float64
+	clicks  float64
+}
+
+func (s withPrior) SelectBanner(stats app.SlotStats, random app.Random) string {
+	return s.Strategy.SelectBanner(s.applyPrior(stats), random)
+}
+
+func (s withPrior) applyPrior(stats app.SlotStats) app.SlotStats {
+	slotRatio := calculateSlotRatio(stats)
+	result := app.SlotStats{Banners: make([]app.BannerStats, len(stats.Banners)), TotalSelects: stats.TotalSelects}
+	for i, banner := range stats.Banners {
+		clicks := s.clicks
+		switch s.mode {
+		case PriorSlotAverage:
+			clicks = s.selects * slotRatio
+		case PriorBannerHistory:
+			clicks = s.selects * slotRatio
+			if banner.OtherSelects > 0 {
+				clicks = s.selects * banner.OtherClicks / banner.OtherSelects
+			}
+		}
+		banner.Selects += s.selects
+		banner.Clicks += clicks
+		result.Banners[i] = banner
+		result.TotalSelects += s.selects
+	}
+	return result
+}
+
+func calculateSlotRatio(stats app.SlotStats) float64 {
+	var selects, clicks float64
+	for _, banner := range stats.Banners {
+		selects += banner.Selects
+		clicks += banner.Clicks
+	}
+	if selects == 0 {
+		return 0
+	}
+	return clicks / selects
+}
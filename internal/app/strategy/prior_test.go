@@ -0,0 +1,81 @@
+package strategy_test
+
+import (
+	"testing"
+
+	"github.com/ekhvalov/otus-banners-rotation/internal/app"
+	"github.com/ekhvalov/otus-banners-rotation/internal/app/strategy"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrior_SelectBanner(t *testing.T) {
+	random := app.NewRandom(1)
+	stats := app.SlotStats{
+		Banners: []app.BannerStats{
+			{BannerID: "1", Selects: 10000, Clicks: 5000},
+			{BannerID: "2", Selects: 0, Clicks: 0, OtherSelects: 1000, OtherClicks: 900},
+			{BannerID: "3", Selects: 0, Clicks: 0, OtherSelects: 1000, OtherClicks: 10},
+		},
+		TotalSelects: 10000,
+	}
+	tests := map[string]struct {
+		params app.StrategyParams
+		stats  app.SlotStats
+		want   string
+	}{
+		"none": {
+			params: app.StrategyParams{Prior: strategy.PriorNone},
+			stats:  stats,
+			want:   "2",
+		},
+		"pseudo counts": {
+			params: app.StrategyParams{Prior: strategy.PriorPseudoCounts, PriorSelects: float64Ptr(100)},
+			stats:  stats,
+			want:   "1",
+		},
+		"banner history": {
+			params: app.StrategyParams{Prior: strategy.PriorBannerHistory, PriorSelects: float64Ptr(100)},
+			stats: app.SlotStats{
+				Banners:      []app.BannerStats{stats.Banners[0], stats.Banners[2], stats.Banners[1]},
+				TotalSelects: stats.TotalSelects,
+			},
+			want: "2",
+		},
+		"banner history low ratio": {
+			params: app.StrategyParams{Prior: strategy.PriorBannerHistory, PriorSelects: float64Ptr(100)},
+			stats: app.SlotStats{
+				Banners:      []app.BannerStats{stats.Banners[0], stats.Banners[2]},
+				TotalSelects: stats.TotalSelects,
+			},
+			want: "1",
+		},
+		"slot average": {
+			params: app.StrategyParams{Prior: strategy.PriorSlotAverage, PriorSelects: float64Ptr(10000)},
+			stats: app.SlotStats{
+				Banners:      []app.BannerStats{stats.Banners[0], {BannerID: "4"}},
+				TotalSelects: stats.TotalSelects,
+			},
+			want: "4",
+		},
+	}
+	for testName, tt := range tests {
+		t.Run(testName, func(t *testing.T) {
+			factory := strategy.NewFactory(strategy.NewConfig(viper.New()))
+			tt.params.Name = strategy.NameUCB1
+			s, _, err := factory.CreateStrategy(tt.params)
+			require.NoError(t, err)
+
+			require.Equal(t, tt.want, s.SelectBanner(tt.stats, random))
+		})
+	}
+}
+
+func TestPrior_SelectBanner_NoBanners(t *testing.T) {
+	random := app.NewRandom(1)
+	factory := strategy.NewFactory(strategy.NewConfig(viper.New()))
+	s, _, err := factory.CreateStrategy(app.StrategyParams{Prior: strategy.PriorSlotAverage})
+	require.NoError(t, err)
+
+	require.Equal(t, "", s.SelectBanner(app.SlotStats{}, random))
+}
@@ -0,0 +1,39 @@
+package strategy
+
+import (
+	"math"
+
+	"github.com/ekhvalov/otus-banners-rotation/internal/app"
+)
+
+// sampleBeta returns a random value from the Beta(alpha, beta) distribution.
+func sampleBeta(random app.Random, alpha, beta float64) float64 {
+	x := sampleGamma(random, alpha)
+	y := sampleGamma(random, beta)
+	if x+y == 0 {
+		return 0
+	}
+	return x / (x + y)
+}
+
+// sampleGamma returns a random value from the Gamma(shape, 1) distribution.
+// It uses the Marsaglia and Tsang method.
+func sampleGamma(random app.Random, shape float64) float64 {
+	if shape < 1 {
+		return sampleGamma(random, shape+1) * math.Pow(random.Float64(), 1/shape)
+	}
+	d := shape - 1.0/3.0
+	c := 1 / math.Sqrt(9*d)
+	for {
+		x := random.NormFloat64()
+		v := 1 + c*x
+		if v <= 0 {
+			continue
+		}
+		v = v * v * v
+		u := random.Float64()
+		if u < 1-0.0331*x*x*x*x || math.Log(u) < 0.5*x*x+d*(1-v+math.Log(v)) {
+			return d * v
+		}
+	}
+}
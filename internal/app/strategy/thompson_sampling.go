@@ -0,0 +1,35 @@
+package strategy
+
+import (
+	"math"
+
+	"github.com/ekhvalov/otus-banners-rotation/internal/app"
+)
+
+// NewThompsonSampling creates a strategy which samples a click-through rate of every banner
+// from the Beta(clicks+alpha, selects-clicks+beta) distribution and selects a banner with the highest sample.
+func NewThompsonSampling(alpha, beta float64) app.Strategy {
+	return thompsonSampling{alpha: alpha, beta: beta}
+}
+
+type thompsonSampling struct {
+	alpha float64
+	beta  float64
+}
+
+func (s thompsonSampling) SelectBanner(stats app.SlotStats, random app.Random) string {
+	bannerID := ""
+	maxSample := math.Inf(-1)
+	for _, banner := range stats.Banners {
+		failures := banner.Selects - banner.Clicks
+		if failures < 0 {
+			failures = 0
+		}
+		sample := sampleBeta(random, banner.Clicks+s.alpha, failures+s.beta)
+		if sample > maxSample {
+			bannerID = banner.BannerID
+			maxSample = sample
+		}
+	}
+	return bannerID
+}
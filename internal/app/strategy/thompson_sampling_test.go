@@ -0,0 +1,68 @@
+package strategy_test
+
+import (
+	"testing"
+
+	"github.com/ekhvalov/otus-banners-rotation/internal/app"
+	"github.com/ekhvalov/otus-banners-rotation/internal/app/strategy"
+	"github.com/stretchr/testify/require"
+)
+
+func TestThompsonSampling_SelectBanner(t *testing.T) {
+	random := app.NewRandom(1)
+	tests := map[string]struct {
+		stats app.SlotStats
+		want  string
+	}{
+		"no banners": {
+			stats: app.SlotStats{},
+			want:  "",
+		},
+		"single banner": {
+			stats: app.SlotStats{
+				Banners:      []app.BannerStats{{BannerID: "1", Selects: 10, Clicks: 1}},
+				TotalSelects: 10,
+			},
+			want: "1",
+		},
+		"highest ratio": {
+			stats: app.SlotStats{
+				Banners: []app.BannerStats{
+					{BannerID: "1", Selects: 1000, Clicks: 10},
+					{BannerID: "2", Selects: 1000, Clicks: 900},
+					{BannerID: "3", Selects: 1000, Clicks: 20},
+				},
+				TotalSelects: 3000,
+			},
+			want: "2",
+		},
+	}
+	for testName, tt := range tests {
+		t.Run(testName, func(t *testing.T) {
+			s := strategy.NewThompsonSampling(1, 1)
+			for i := 0; i < 100; i++ {
+				require.Equal(t, tt.want, s.SelectBanner(tt.stats, random))
+			}
+		})
+	}
+}
+
+func TestThompsonSampling_SelectBanner_Exploration(t *testing.T) {
+	random := app.NewRandom(1)
+	stats := app.SlotStats{
+		Banners: []app.BannerStats{
+			{BannerID: "1", Selects: 2, Clicks: 1},
+			{BannerID: "2", Selects: 2, Clicks: 1},
+		},
+		TotalSelects: 4,
+	}
+	s := strategy.NewThompsonSampling(1, 1)
+	selects := make(map[string]int)
+
+	for i := 0; i < 1000; i++ {
+		selects[s.SelectBanner(stats, random)]++
+	}
+
+	require.Greater(t, selects["1"], 300)
+	require.Greater(t, selects["2"], 300)
+}
@@ -0,0 +1,100 @@
+package strategy
+
+import (
+	"math"
+
+	"github.com/ekhvalov/otus-banners-rotation/internal/app"
+)
+
+// klUCBPrecision is a precision of the KL-UCB upper bound search.
+const klUCBPrecision = 1e-6
+
+// The exploration coefficient c of all UCB strategies scales the log term of the upper bound,
+// c = 2 gives the classic form of every algorithm. Lower values reduce exploration.
+
+// NewUCB1 creates a strategy which selects a banner with the highest UCB1 score:
+// ratio + sqrt(c * ln(totalSelects) / selects).
+// A banner which has never been selected gets the infinite score, so every banner is tried at least once.
+func NewUCB1(exploration float64) app.Strategy {
+	return ucb{exploration: exploration, score: calculateUCB1Score}
+}
+
+// NewUCB1Tuned creates a strategy which selects a banner with the highest UCB1-Tuned score. It bounds
+// the exploration term by the variance estimate of a banner, so stable banners are explored less.
+func NewUCB1Tuned(exploration float64) app.Strategy {
+	return ucb{exploration: exploration, score: calculateUCB1TunedScore}
+}
+
+// NewUCBV creates a strategy which selects a banner with the highest UCB-V score (empirical Bernstein bound).
+func NewUCBV(exploration float64) app.Strategy {
+	return ucb{exploration: exploration, score: calculateUCBVScore}
+}
+
+// NewKLUCB creates a strategy which selects a banner with the highest KL-UCB score: the largest rate q which
+// satisfies selects * KL(ratio, q) <= c/2 * ln(totalSelects), where KL is the Bernoulli Kullback-Leibler divergence.
+func NewKLUCB(exploration float64) app.Strategy {
+	return ucb{exploration: exploration, score: calculateKLUCBScore}
+}
+
+type ucb struct {
+	exploration float64
+	score       func(selects, clicks, totalSelects, exploration float64) float64
+}
+
+func (s ucb) SelectBanner(stats app.SlotStats, _ app.Random) string {
+	bannerID := ""
+	maxScore := math.Inf(-1)
+	totalSelects := math.Max(stats.TotalSelects, 1)
+	for _, banner := range stats.Banners {
+		if banner.Selects == 0 {
+			return banner.BannerID
+		}
+		score := s.score(banner.Selects, banner.Clicks, totalSelects, s.exploration)
+		if score > maxScore {
+			bannerID = banner.BannerID
+			maxScore = score
+		}
+	}
+	return bannerID
+}
+
+func calculateUCB1Score(selects, clicks, totalSelects, exploration float64) float64 {
+	bannerRatio := clicks / selects
+	return bannerRatio + math.Sqrt((exploration*math.Log(totalSelects))/selects)
+}
+
+func calculateUCB1TunedScore(selects, clicks, totalSelects, exploration float64) float64 {
+	bannerRatio := clicks / selects
+	logTerm := math.Log(totalSelects) / selects
+	variance := bannerRatio - bannerRatio*bannerRatio + math.Sqrt(exploration*logTerm)
+	return bannerRatio + math.Sqrt(exploration/2*logTerm*math.Min(0.25, variance))
+}
+
+func calculateUCBVScore(selects, clicks, totalSelects, exploration float64) float64 {
+	bannerRatio := clicks / selects
+	variance := bannerRatio - bannerRatio*bannerRatio
+	logTerm := exploration / 2 * math.Log(totalSelects) / selects
+	return bannerRatio + math.Sqrt(2*variance*logTerm) + 3*logTerm
+}
+
+func calculateKLUCBScore(selects, clicks, totalSelects, exploration float64) float64 {
+	bannerRatio := math.Min(clicks/selects, 1)
+	bound := exploration / 2 * math.Log(totalSelects) / selects
+	low, high := bannerRatio, 1.0
+	for high-low > klUCBPrecision {
+		middle := (low + high) / 2
+		if bernoulliKL(bannerRatio, middle) > bound {
+			high = middle
+		} else {
+			low = middle
+		}
+	}
+	return low
+}
+
+func bernoulliKL(p, q float64) float64 {
+	const eps = 1e-15
+	p = math.Min(math.Max(p, eps), 1-eps)
+	q = math.Min(math.Max(q, eps), 1-eps)
+	return p*math.Log(p/q) + (1-p)*math.Log((1-p)/(1-q))
+}
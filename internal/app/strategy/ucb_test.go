@@ -0,0 +1,87 @@
+package strategy_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ekhvalov/otus-banners-rotation/internal/app"
+	"github.com/ekhvalov/otus-banners-rotation/internal/app/strategy"
+	"github.com/stretchr/testify/require"
+)
+
+var ucbStrategies = map[string]func(exploration float64) app.Strategy{
+	"UCB1":       strategy.NewUCB1,
+	"UCB1-Tuned": strategy.NewUCB1Tuned,
+	"UCB-V":      strategy.NewUCBV,
+	"KL-UCB":     strategy.NewKLUCB,
+}
+
+func TestUCB_SelectBanner(t *testing.T) {
+	random := app.NewRandom(1)
+	tests := map[string]struct {
+		stats app.SlotStats
+		want  string
+	}{
+		"no banners": {
+			stats: app.SlotStats{},
+			want:  "",
+		},
+		"never selected banner first": {
+			stats: app.SlotStats{
+				Banners: []app.BannerStats{
+					{BannerID: "1", Selects: 10, Clicks: 9},
+					{BannerID: "2", Selects: 0, Clicks: 0},
+				},
+				TotalSelects: 10,
+			},
+			want: "2",
+		},
+		"highest ratio": {
+			stats: app.SlotStats{
+				Banners: []app.BannerStats{
+					{BannerID: "1", Selects: 50, Clicks: 5},
+					{BannerID: "2", Selects: 50, Clicks: 25},
+					{BannerID: "3", Selects: 50, Clicks: 10},
+				},
+				TotalSelects: 150,
+			},
+			want: "2",
+		},
+		"rarely selected banner": {
+			stats: app.SlotStats{
+				Banners: []app.BannerStats{
+					{BannerID: "1", Selects: 1000, Clicks: 300},
+					{BannerID: "2", Selects: 2, Clicks: 0},
+				},
+				TotalSelects: 1002,
+			},
+			want: "2",
+		},
+	}
+	for strategyName, newStrategy := range ucbStrategies {
+		for testName, tt := range tests {
+			t.Run(fmt.Sprintf("%s_%s", strategyName, testName), func(t *testing.T) {
+				got := newStrategy(2).SelectBanner(tt.stats, random)
+
+				require.Equal(t, tt.want, got)
+			})
+		}
+	}
+}
+
+func TestUCB_SelectBanner_Exploration(t *testing.T) {
+	random := app.NewRandom(1)
+	stats := app.SlotStats{
+		Banners: []app.BannerStats{
+			{BannerID: "1", Selects: 10000, Clicks: 3000},
+			{BannerID: "2", Selects: 20, Clicks: 4},
+		},
+		TotalSelects: 10020,
+	}
+	for strategyName, newStrategy := range ucbStrategies {
+		t.Run(strategyName, func(t *testing.T) {
+			require.Equal(t, "2", newStrategy(2).SelectBanner(stats, random), "classic exploration")
+			require.Equal(t, "1", newStrategy(0.01).SelectBanner(stats, random), "reduced exploration")
+		})
+	}
+}
@@ -0,0 +1,14 @@
+package idgenerator
+
+import "github.com/google/uuid"
+
+// NewUUID creates a generator of the random UUID ids which is used by all storages.
+func NewUUID() UUID {
+	return UUID{}
+}
+
+type UUID struct{}
+
+func (g UUID) GenerateID() string {
+	return uuid.New().String()
+}
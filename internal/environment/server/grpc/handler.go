@@ -3,13 +3,20 @@ package internalgrpc
 import (
 	"context"
 	"errors"
+	"fmt"
+	"time"
 
 	"github.com/ekhvalov/otus-banners-rotation/internal/app"
 	grpcapi "github.com/ekhvalov/otus-banners-rotation/pkg/api/grpc"
 	"google.golang.org/genproto/googleapis/rpc/code"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-var statusOK = grpcapi.Status{Code: code.Code_OK}
+var (
+	statusOK             = grpcapi.Status{Code: code.Code_OK}
+	errInvalidUpdateMask = errors.New("invalid update mask")
+)
 
 type handler struct {
 	grpcapi.UnimplementedRotatorServer
@@ -20,9 +27,11 @@ func (h *handler) CreateBanner(
 	ctx context.Context,
 	request *grpcapi.CreateBannerRequest,
 ) (*grpcapi.CreateBannerResponse, error) {
-	id, err := h.rotator.CreateBanner(ctx, request.GetDescription())
+	id, err := h.rotator.CreateBanner(ctx, request.GetDescription(), makeAppCreative(request.GetCreative()),
+		app.Flight{Start: makeAppTime(request.GetStartTime()), End: makeAppTime(request.GetEndTime())})
 	if err != nil {
-		if errors.Is(err, app.ErrEmptyDescription) {
+		if errors.Is(err, app.ErrEmptyDescription) || errors.Is(err, app.ErrInvalidCreative) ||
+			errors.Is(err, app.ErrInvalidFlight) {
 			return &grpcapi.CreateBannerResponse{Status: makeStatus(code.Code_INVALID_ARGUMENT, err)}, nil
 		}
 		return nil, err
@@ -39,6 +48,10 @@ func (h *handler) DeleteBanner(
 		if errors.Is(err, app.ErrEmptyID) {
 			return &grpcapi.DeleteBannerResponse{Status: makeStatus(code.Code_INVALID_ARGUMENT, err)}, nil
 		}
+		var errInUse *app.ErrInUse
+		if errors.As(err, &errInUse) {
+			return &grpcapi.DeleteBannerResponse{Status: makeStatus(code.Code_FAILED_PRECONDITION, err)}, nil
+		}
 		return nil, err
 	}
 	return &grpcapi.DeleteBannerResponse{Status: &statusOK}, nil
@@ -48,9 +61,15 @@ func (h *handler) CreateSlot(
 	ctx context.Context,
 	request *grpcapi.CreateSlotRequest,
 ) (*grpcapi.CreateSlotResponse, error) {
-	id, err := h.rotator.CreateSlot(ctx, request.GetDescription())
+	id, err := h.rotator.CreateSlot(
+		ctx,
+		request.GetDescription(),
+		makeStrategyParams(request.GetStrategy()),
+		makeAppSlotFormat(request.GetFormat()),
+	)
 	if err != nil {
-		if errors.Is(err, app.ErrEmptyDescription) {
+		if errors.Is(err, app.ErrEmptyDescription) || errors.Is(err, app.ErrInvalidStrategy) ||
+			errors.Is(err, app.ErrInvalidSlotFormat) {
 			return &grpcapi.CreateSlotResponse{Status: makeStatus(code.Code_INVALID_ARGUMENT, err)}, nil
 		}
 		return nil, err
@@ -67,6 +86,10 @@ func (h *handler) DeleteSlot(
 		if errors.Is(err, app.ErrEmptyID) {
 			return &grpcapi.DeleteSlotResponse{Status: makeStatus(code.Code_INVALID_ARGUMENT, err)}, nil
 		}
+		var errInUse *app.ErrInUse
+		if errors.As(err, &errInUse) {
+			return &grpcapi.DeleteSlotResponse{Status: makeStatus(code.Code_FAILED_PRECONDITION, err)}, nil
+		}
 		return nil, err
 	}
 	return &grpcapi.DeleteSlotResponse{Status: &statusOK}, nil
@@ -95,6 +118,10 @@ func (h *handler) DeleteSocialGroup(
 		if errors.Is(err, app.ErrEmptyID) {
 			return &grpcapi.DeleteSocialGroupResponse{Status: makeStatus(code.Code_INVALID_ARGUMENT, err)}, nil
 		}
+		var errInUse *app.ErrInUse
+		if errors.As(err, &errInUse) {
+			return &grpcapi.DeleteSocialGroupResponse{Status: makeStatus(code.Code_FAILED_PRECONDITION, err)}, nil
+		}
 		return nil, err
 	}
 	return &grpcapi.DeleteSocialGroupResponse{Status: &statusOK}, nil
@@ -110,6 +137,10 @@ func (h *handler) AttachBanner(
 		if errors.As(err, &errNotFound) {
 			return &grpcapi.AttachBannerResponse{Status: makeStatus(code.Code_NOT_FOUND, err)}, nil
 		}
+		var errNotFit *app.ErrBannerNotFit
+		if errors.As(err, &errNotFit) {
+			return &grpcapi.AttachBannerResponse{Status: makeStatus(code.Code_FAILED_PRECONDITION, err)}, nil
+		}
 		return nil, err
 	}
 	return &grpcapi.AttachBannerResponse{Status: &statusOK}, nil
@@ -134,7 +165,13 @@ func (h *handler) ClickBanner(
 	ctx context.Context,
 	request *grpcapi.ClickBannerRequest,
 ) (*grpcapi.ClickBannerResponse, error) {
-	err := h.rotator.ClickBanner(ctx, request.GetSlotId(), request.GetBannerId(), request.GetSocialGroupId())
+	err := h.rotator.ClickBanner(
+		ctx,
+		request.GetSlotId(),
+		request.GetBannerId(),
+		request.GetSocialGroupId(),
+		request.GetFeatures(),
+	)
 	if err != nil {
 		var errNotFound *app.ErrNotFound
 		if errors.As(err, &errNotFound) {
@@ -153,8 +190,15 @@ func (h *handler) SelectBanner(
 	ctx context.Context,
 	request *grpcapi.SelectBannerRequest,
 ) (*grpcapi.SelectBannerResponse, error) {
-	bannerID, err := h.rotator.SelectBanner(ctx, request.GetSlotId(), request.GetSocialGroupId())
+	banner, err := h.rotator.SelectBanner(ctx, request.GetSlotId(), request.GetSocialGroupId(), request.GetFeatures())
 	if err != nil {
+		if errors.Is(err, app.ErrEmptyID) {
+			return &grpcapi.SelectBannerResponse{Status: makeStatus(code.Code_INVALID_ARGUMENT, err)}, nil
+		}
+		// A slot which banners are all out of their flights has no banners to select as well.
+		if errors.Is(err, app.ErrNoBannersFound) || errors.Is(err, app.ErrInvalidStrategy) {
+			return &grpcapi.SelectBannerResponse{Status: makeStatus(code.Code_FAILED_PRECONDITION, err)}, nil
+		}
 		var errNotFound *app.ErrNotFound
 		if errors.As(err, &errNotFound) {
 			return &grpcapi.SelectBannerResponse{Status: makeStatus(code.Code_NOT_FOUND, err)}, nil
@@ -167,10 +211,380 @@ func (h *handler) SelectBanner(
 	}
 	return &grpcapi.SelectBannerResponse{
 		Status:   &statusOK,
-		BannerId: bannerID,
+		BannerId: banner.ID,
+		Banner:   makeBanner(banner),
 	}, nil
 }
 
+func (h *handler) GetBanner(
+	ctx context.Context,
+	request *grpcapi.GetBannerRequest,
+) (*grpcapi.GetBannerResponse, error) {
+	banner, err := h.rotator.GetBanner(ctx, request.GetId())
+	if err != nil {
+		if status := makeInventoryStatus(err); status != nil {
+			return &grpcapi.GetBannerResponse{Status: status}, nil
+		}
+		return nil, err
+	}
+	return &grpcapi.GetBannerResponse{Status: &statusOK, Banner: makeBanner(banner)}, nil
+}
+
+func (h *handler) ListBanners(
+	ctx context.Context,
+	request *grpcapi.ListBannersRequest,
+) (*grpcapi.ListBannersResponse, error) {
+	banners, nextPageToken, err := h.rotator.ListBanners(ctx, makeListQuery(request))
+	if err != nil {
+		if status := makeInventoryStatus(err); status != nil {
+			return &grpcapi.ListBannersResponse{Status: status}, nil
+		}
+		return nil, err
+	}
+	response := &grpcapi.ListBannersResponse{Status: &statusOK, NextPageToken: nextPageToken}
+	for _, banner := range banners {
+		response.Banners = append(response.Banners, makeBanner(banner))
+	}
+	return response, nil
+}
+
+func (h *handler) UpdateBanner(
+	ctx context.Context,
+	request *grpcapi.UpdateBannerRequest,
+) (*grpcapi.UpdateBannerResponse, error) {
+	if err := checkUpdateMask(request.GetUpdateMask()); err != nil {
+		return &grpcapi.UpdateBannerResponse{Status: makeStatus(code.Code_INVALID_ARGUMENT, err)}, nil
+	}
+	banner, err := h.rotator.UpdateBanner(ctx, request.GetBanner().GetId(), request.GetBanner().GetDescription())
+	if err != nil {
+		if status := makeInventoryStatus(err); status != nil {
+			return &grpcapi.UpdateBannerResponse{Status: status}, nil
+		}
+		return nil, err
+	}
+	return &grpcapi.UpdateBannerResponse{Status: &statusOK, Banner: makeBanner(banner)}, nil
+}
+
+func (h *handler) GetSlot(
+	ctx context.Context,
+	request *grpcapi.GetSlotRequest,
+) (*grpcapi.GetSlotResponse, error) {
+	slot, err := h.rotator.GetSlot(ctx, request.GetId())
+	if err != nil {
+		if status := makeInventoryStatus(err); status != nil {
+			return &grpcapi.GetSlotResponse{Status: status}, nil
+		}
+		return nil, err
+	}
+	return &grpcapi.GetSlotResponse{Status: &statusOK, Slot: makeSlot(slot)}, nil
+}
+
+func (h *handler) ListSlots(
+	ctx context.Context,
+	request *grpcapi.ListSlotsRequest,
+) (*grpcapi.ListSlotsResponse, error) {
+	slots, nextPageToken, err := h.rotator.ListSlots(ctx, makeListQuery(request))
+	if err != nil {
+		if status := makeInventoryStatus(err); status != nil {
+			return &grpcapi.ListSlotsResponse{Status: status}, nil
+		}
+		return nil, err
+	}
+	response := &grpcapi.ListSlotsResponse{Status: &statusOK, NextPageToken: nextPageToken}
+	for _, slot := range slots {
+		response.Slots = append(response.Slots, makeSlot(slot))
+	}
+	return response, nil
+}
+
+func (h *handler) UpdateSlot(
+	ctx context.Context,
+	request *grpcapi.UpdateSlotRequest,
+) (*grpcapi.UpdateSlotResponse, error) {
+	if err := checkUpdateMask(request.GetUpdateMask()); err != nil {
+		return &grpcapi.UpdateSlotResponse{Status: makeStatus(code.Code_INVALID_ARGUMENT, err)}, nil
+	}
+	slot, err := h.rotator.UpdateSlot(ctx, request.GetSlot().GetId(), request.GetSlot().GetDescription())
+	if err != nil {
+		if status := makeInventoryStatus(err); status != nil {
+			return &grpcapi.UpdateSlotResponse{Status: status}, nil
+		}
+		return nil, err
+	}
+	return &grpcapi.UpdateSlotResponse{Status: &statusOK, Slot: makeSlot(slot)}, nil
+}
+
+func (h *handler) GetSocialGroup(
+	ctx context.Context,
+	request *grpcapi.GetSocialGroupRequest,
+) (*grpcapi.GetSocialGroupResponse, error) {
+	socialGroup, err := h.rotator.GetSocialGroup(ctx, request.GetId())
+	if err != nil {
+		if status := makeInventoryStatus(err); status != nil {
+			return &grpcapi.GetSocialGroupResponse{Status: status}, nil
+		}
+		return nil, err
+	}
+	return &grpcapi.GetSocialGroupResponse{Status: &statusOK, SocialGroup: makeSocialGroup(socialGroup)}, nil
+}
+
+func (h *handler) ListSocialGroups(
+	ctx context.Context,
+	request *grpcapi.ListSocialGroupsRequest,
+) (*grpcapi.ListSocialGroupsResponse, error) {
+	socialGroups, nextPageToken, err := h.rotator.ListSocialGroups(ctx, makeListQuery(request))
+	if err != nil {
+		if status := makeInventoryStatus(err); status != nil {
+			return &grpcapi.ListSocialGroupsResponse{Status: status}, nil
+		}
+		return nil, err
+	}
+	response := &grpcapi.ListSocialGroupsResponse{Status: &statusOK, NextPageToken: nextPageToken}
+	for _, socialGroup := range socialGroups {
+		response.SocialGroups = append(response.SocialGroups, makeSocialGroup(socialGroup))
+	}
+	return response, nil
+}
+
+func (h *handler) UpdateSocialGroup(
+	ctx context.Context,
+	request *grpcapi.UpdateSocialGroupRequest,
+) (*grpcapi.UpdateSocialGroupResponse, error) {
+	if err := checkUpdateMask(request.GetUpdateMask()); err != nil {
+		return &grpcapi.UpdateSocialGroupResponse{Status: makeStatus(code.Code_INVALID_ARGUMENT, err)}, nil
+	}
+	socialGroup, err := h.rotator.UpdateSocialGroup(
+		ctx,
+		request.GetSocialGroup().GetId(),
+		request.GetSocialGroup().GetDescription(),
+	)
+	if err != nil {
+		if status := makeInventoryStatus(err); status != nil {
+			return &grpcapi.UpdateSocialGroupResponse{Status: status}, nil
+		}
+		return nil, err
+	}
+	return &grpcapi.UpdateSocialGroupResponse{Status: &statusOK, SocialGroup: makeSocialGroup(socialGroup)}, nil
+}
+
+func (h *handler) ListSlotBanners(
+	ctx context.Context,
+	request *grpcapi.ListSlotBannersRequest,
+) (*grpcapi.ListSlotBannersResponse, error) {
+	query := app.ListQuery{PageSize: int(request.GetPageSize()), PageToken: request.GetPageToken()}
+	attachments, nextPageToken, err := h.rotator.ListSlotBanners(ctx, request.GetSlotId(), query)
+	if err != nil {
+		if status := makeInventoryStatus(err); status != nil {
+			return &grpcapi.ListSlotBannersResponse{Status: status}, nil
+		}
+		return nil, err
+	}
+	response := &grpcapi.ListSlotBannersResponse{Status: &statusOK, NextPageToken: nextPageToken}
+	for _, attachment := range attachments {
+		response.Attachments = append(response.Attachments, makeAttachment(attachment))
+	}
+	return response, nil
+}
+
+func (h *handler) ListBannerSlots(
+	ctx context.Context,
+	request *grpcapi.ListBannerSlotsRequest,
+) (*grpcapi.ListBannerSlotsResponse, error) {
+	query := app.ListQuery{PageSize: int(request.GetPageSize()), PageToken: request.GetPageToken()}
+	attachments, nextPageToken, err := h.rotator.ListBannerSlots(ctx, request.GetBannerId(), query)
+	if err != nil {
+		if status := makeInventoryStatus(err); status != nil {
+			return &grpcapi.ListBannerSlotsResponse{Status: status}, nil
+		}
+		return nil, err
+	}
+	response := &grpcapi.ListBannerSlotsResponse{Status: &statusOK, NextPageToken: nextPageToken}
+	for _, attachment := range attachments {
+		response.Attachments = append(response.Attachments, makeAttachment(attachment))
+	}
+	return response, nil
+}
+
+func makeStrategyParams(strategy *grpcapi.SlotStrategy) app.StrategyParams {
+	if strategy == nil {
+		return app.StrategyParams{}
+	}
+	return app.StrategyParams{
+		Name:              strategy.GetName(),
+		UCBExploration:    strategy.UcbExploration,
+		Epsilon:           strategy.Epsilon,
+		EpsilonDecay:      strategy.GetEpsilonDecay(),
+		Alpha:             strategy.GetAlpha(),
+		Beta:              strategy.GetBeta(),
+		Window:            time.Duration(strategy.GetWindowSeconds()) * time.Second,
+		Discount:          strategy.GetDiscount(),
+		Prior:             strategy.GetPrior(),
+		PriorSelects:      strategy.PriorSelects,
+		PriorClicks:       strategy.PriorClicks,
+		Pooling:           strategy.GetPooling(),
+		PoolingStrength:   strategy.PoolingStrength,
+		PoolingMinSelects: strategy.PoolingMinSelects,
+	}
+}
+
+func makeSlotStrategy(params app.StrategyParams) *grpcapi.SlotStrategy {
+	return &grpcapi.SlotStrategy{
+		Name:              params.Name,
+		UcbExploration:    params.UCBExploration,
+		Epsilon:           params.Epsilon,
+		EpsilonDecay:      params.EpsilonDecay,
+		Alpha:             params.Alpha,
+		Beta:              params.Beta,
+		WindowSeconds:     int64(params.Window / time.Second),
+		Discount:          params.Discount,
+		Prior:             params.Prior,
+		PriorSelects:      params.PriorSelects,
+		PriorClicks:       params.PriorClicks,
+		Pooling:           params.Pooling,
+		PoolingStrength:   params.PoolingStrength,
+		PoolingMinSelects: params.PoolingMinSelects,
+	}
+}
+
+func makeBanner(banner app.Banner) *grpcapi.Banner {
+	return &grpcapi.Banner{
+		Id:          banner.ID,
+		Description: banner.Description,
+		Creative:    makeCreative(banner.Creative),
+		StartTime:   makeTimestamp(banner.Flight.Start),
+		EndTime:     makeTimestamp(banner.Flight.End),
+	}
+}
+
+// makeTimestamp returns nil in case of the time is zero.
+func makeTimestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+// makeAppTime returns the zero time in case of the timestamp is not set.
+func makeAppTime(timestamp *timestamppb.Timestamp) time.Time {
+	if timestamp == nil {
+		return time.Time{}
+	}
+	return timestamp.AsTime()
+}
+
+func makeCreative(creative app.Creative) *grpcapi.Creative {
+	return &grpcapi.Creative{
+		TargetUrl: creative.TargetURL,
+		ImageUrl:  creative.ImageURL,
+		Width:     int32(creative.Width),
+		Height:    int32(creative.Height),
+		MimeType:  creative.MIMEType,
+		Metadata:  creative.Metadata,
+	}
+}
+
+func makeAppCreative(creative *grpcapi.Creative) app.Creative {
+	return app.Creative{
+		TargetURL: creative.GetTargetUrl(),
+		ImageURL:  creative.GetImageUrl(),
+		Width:     int(creative.GetWidth()),
+		Height:    int(creative.GetHeight()),
+		MIMEType:  creative.GetMimeType(),
+		Metadata:  creative.GetMetadata(),
+	}
+}
+
+func makeSlot(slot app.Slot) *grpcapi.Slot {
+	return &grpcapi.Slot{
+		Id:          slot.ID,
+		Description: slot.Description,
+		Strategy:    makeSlotStrategy(slot.Strategy),
+		Format:      makeSlotFormat(slot.Format),
+	}
+}
+
+func makeSlotFormat(format app.SlotFormat) *grpcapi.SlotFormat {
+	result := &grpcapi.SlotFormat{MimeTypes: format.MIMETypes}
+	for _, size := range format.Sizes {
+		result.Sizes = append(result.Sizes, &grpcapi.Size{Width: int32(size.Width), Height: int32(size.Height)})
+	}
+	return result
+}
+
+func makeAppSlotFormat(format *grpcapi.SlotFormat) app.SlotFormat {
+	result := app.SlotFormat{MIMETypes: format.GetMimeTypes()}
+	for _, size := range format.GetSizes() {
+		result.Sizes = append(result.Sizes, app.Size{Width: int(size.GetWidth()), Height: int(size.GetHeight())})
+	}
+	return result
+}
+
+func makeSocialGroup(socialGroup app.SocialGroup) *grpcapi.SocialGroup {
+	return &grpcapi.SocialGroup{Id: socialGroup.ID, Description: socialGroup.Description}
+}
+
+func makeAttachment(attachment app.AttachmentInfo) *grpcapi.Attachment {
+	result := &grpcapi.Attachment{
+		SlotId:   attachment.SlotID,
+		BannerId: attachment.BannerID,
+		Status:   makeAttachmentStatus(attachment.Status),
+	}
+	result.AttachedAt = makeTimestamp(attachment.AttachedAt)
+	return result
+}
+
+func makeAttachmentStatus(status app.AttachmentStatus) grpcapi.AttachmentStatus {
+	switch status {
+	case app.AttachmentStatusActive:
+		return grpcapi.AttachmentStatus_ATTACHMENT_STATUS_ACTIVE
+	case app.AttachmentStatusScheduled:
+		return grpcapi.AttachmentStatus_ATTACHMENT_STATUS_SCHEDULED
+	case app.AttachmentStatusEnded:
+		return grpcapi.AttachmentStatus_ATTACHMENT_STATUS_ENDED
+	default:
+		return grpcapi.AttachmentStatus_ATTACHMENT_STATUS_UNSPECIFIED
+	}
+}
+
+type listRequest interface {
+	GetPageSize() int32
+	GetPageToken() string
+	GetDescriptionContains() string
+}
+
+func makeListQuery(request listRequest) app.ListQuery {
+	return app.ListQuery{
+		PageSize:            int(request.GetPageSize()),
+		PageToken:           request.GetPageToken(),
+		DescriptionContains: request.GetDescriptionContains(),
+	}
+}
+
+// checkUpdateMask checks the mask contains the description only, an empty mask means the description as well.
+func checkUpdateMask(mask *fieldmaskpb.FieldMask) error {
+	for _, path := range mask.GetPaths() {
+		if path != "description" {
+			return fmt.Errorf("%w: field '%s' can not be updated", errInvalidUpdateMask, path)
+		}
+	}
+	return nil
+}
+
+// makeInventoryStatus returns the status of an error of the get, list and update methods.
+// Returns nil in case of the error is unexpected.
+func makeInventoryStatus(err error) *grpcapi.Status {
+	var errNotFound *app.ErrNotFound
+	switch {
+	case errors.Is(err, app.ErrEmptyID), errors.Is(err, app.ErrEmptyDescription),
+		errors.Is(err, app.ErrInvalidPageSize), errors.Is(err, app.ErrInvalidPageToken):
+		return makeStatus(code.Code_INVALID_ARGUMENT, err)
+	case errors.As(err, &errNotFound):
+		return makeStatus(code.Code_NOT_FOUND, err)
+	default:
+		return nil
+	}
+}
+
 func makeStatus(c code.Code, err error) *grpcapi.Status {
 	return &grpcapi.Status{
 		Code:    c,
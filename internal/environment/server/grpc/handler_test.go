@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/ekhvalov/otus-banners-rotation/internal/app"
 	"github.com/ekhvalov/otus-banners-rotation/internal/app/mock"
@@ -12,6 +13,8 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/genproto/googleapis/rpc/code"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 var (
@@ -25,6 +28,24 @@ var (
 	errRotator       = errors.New("rotator error")
 	errNotFound      = app.NewErrNotFound("something is not found")
 	errNotAttached   = app.NewErrBannerNotAttached(slotID, bannerID)
+	errNotFit        = app.NewErrBannerNotFit(slotID, bannerID, errors.New("size 728x90 is not accepted"))
+	features         = map[string]string{"device": "mobile"}
+	creative         = app.Creative{
+		TargetURL: "https://example.com/landing",
+		ImageURL:  "https://cdn.example.com/banner.png",
+		Width:     300,
+		Height:    250,
+		MIMEType:  "image/png",
+		Metadata:  map[string]string{"campaign": "autumn"},
+	}
+	grpcCreative = &grpcapi.Creative{
+		TargetUrl: "https://example.com/landing",
+		ImageUrl:  "https://cdn.example.com/banner.png",
+		Width:     300,
+		Height:    250,
+		MimeType:  "image/png",
+		Metadata:  map[string]string{"campaign": "autumn"},
+	}
 )
 
 func Test_handler_CreateX(t *testing.T) {
@@ -41,7 +62,7 @@ func Test_handler_CreateX(t *testing.T) {
 			mockRotator: func(c *gomock.Controller, expectedDesc string, returnID string, returnErr error) app.Rotator {
 				rotator := mock.NewMockRotator(c)
 				rotator.EXPECT().
-					CreateBanner(context.Background(), expectedDesc).
+					CreateBanner(context.Background(), expectedDesc, app.Creative{}, app.Flight{}).
 					Return(returnID, returnErr)
 				return rotator
 			},
@@ -53,7 +74,7 @@ func Test_handler_CreateX(t *testing.T) {
 			mockRotator: func(c *gomock.Controller, expectedDescription string, returnID string, returnErr error) app.Rotator {
 				rotator := mock.NewMockRotator(c)
 				rotator.EXPECT().
-					CreateSlot(context.Background(), expectedDescription).
+					CreateSlot(context.Background(), expectedDescription, app.StrategyParams{}, app.SlotFormat{}).
 					Return(returnID, returnErr)
 				return rotator
 			},
@@ -127,6 +148,232 @@ func Test_handler_CreateX(t *testing.T) {
 	}
 }
 
+func Test_handler_CreateSlot_Strategy(t *testing.T) {
+	errInvalidStrategy := fmt.Errorf("%w: unknown name", app.ErrInvalidStrategy)
+	tests := map[string]struct {
+		strategy             *grpcapi.SlotStrategy
+		wantParams           app.StrategyParams
+		rotatorReturnError   error
+		expectedResponseCode code.Code
+	}{
+		"no strategy": {
+			strategy:             nil,
+			wantParams:           app.StrategyParams{},
+			expectedResponseCode: code.Code_OK,
+		},
+		"strategy": {
+			strategy:             &grpcapi.SlotStrategy{Name: "epsilon_greedy", Epsilon: float64Ptr(0.3), EpsilonDecay: 0.01},
+			wantParams:           app.StrategyParams{Name: "epsilon_greedy", Epsilon: float64Ptr(0.3), EpsilonDecay: 0.01},
+			expectedResponseCode: code.Code_OK,
+		},
+		"sliding window strategy": {
+			strategy:             &grpcapi.SlotStrategy{Name: "ucb1", WindowSeconds: 3600},
+			wantParams:           app.StrategyParams{Name: "ucb1", Window: time.Hour},
+			expectedResponseCode: code.Code_OK,
+		},
+		"discounted strategy": {
+			strategy:             &grpcapi.SlotStrategy{Name: "thompson_sampling", Discount: 0.9},
+			wantParams:           app.StrategyParams{Name: "thompson_sampling", Discount: 0.9},
+			expectedResponseCode: code.Code_OK,
+		},
+		"strategy with prior": {
+			strategy: &grpcapi.SlotStrategy{
+				Prior: "pseudo_counts", PriorSelects: float64Ptr(10), PriorClicks: float64Ptr(0),
+			},
+			wantParams: app.StrategyParams{
+				Prior: "pseudo_counts", PriorSelects: float64Ptr(10), PriorClicks: float64Ptr(0),
+			},
+			expectedResponseCode: code.Code_OK,
+		},
+		"strategy with pooling": {
+			strategy: &grpcapi.SlotStrategy{
+				Pooling: "hierarchical", PoolingStrength: float64Ptr(5), PoolingMinSelects: float64Ptr(0),
+			},
+			wantParams: app.StrategyParams{
+				Pooling: "hierarchical", PoolingStrength: float64Ptr(5), PoolingMinSelects: float64Ptr(0),
+			},
+			expectedResponseCode: code.Code_OK,
+		},
+		"invalid strategy": {
+			strategy:             &grpcapi.SlotStrategy{Name: "unknown"},
+			wantParams:           app.StrategyParams{Name: "unknown"},
+			rotatorReturnError:   errInvalidStrategy,
+			expectedResponseCode: code.Code_INVALID_ARGUMENT,
+		},
+	}
+	for testName, tt := range tests {
+		t.Run(testName, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+			r := mock.NewMockRotator(controller)
+			r.EXPECT().
+				CreateSlot(context.Background(), description, tt.wantParams, app.SlotFormat{}).
+				Return(id, tt.rotatorReturnError)
+			h := handler{rotator: r}
+
+			resp, err := h.CreateSlot(
+				context.Background(),
+				&grpcapi.CreateSlotRequest{Description: description, Strategy: tt.strategy},
+			)
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedResponseCode, resp.GetStatus().GetCode())
+		})
+	}
+}
+
+func Test_handler_CreateSlot_Format(t *testing.T) {
+	errInvalidFormat := fmt.Errorf("%w: size 0x90 is not positive", app.ErrInvalidSlotFormat)
+	tests := map[string]struct {
+		format               *grpcapi.SlotFormat
+		wantFormat           app.SlotFormat
+		rotatorReturnError   error
+		expectedResponseCode code.Code
+	}{
+		"no format": {
+			format:               nil,
+			wantFormat:           app.SlotFormat{},
+			expectedResponseCode: code.Code_OK,
+		},
+		"format": {
+			format: &grpcapi.SlotFormat{
+				Sizes:     []*grpcapi.Size{{Width: 300, Height: 250}},
+				MimeTypes: []string{"image/png"},
+			},
+			wantFormat: app.SlotFormat{
+				Sizes:     []app.Size{{Width: 300, Height: 250}},
+				MIMETypes: []string{"image/png"},
+			},
+			expectedResponseCode: code.Code_OK,
+		},
+		"invalid format": {
+			format:               &grpcapi.SlotFormat{Sizes: []*grpcapi.Size{{Width: 0, Height: 90}}},
+			wantFormat:           app.SlotFormat{Sizes: []app.Size{{Width: 0, Height: 90}}},
+			rotatorReturnError:   errInvalidFormat,
+			expectedResponseCode: code.Code_INVALID_ARGUMENT,
+		},
+	}
+	for testName, tt := range tests {
+		t.Run(testName, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+			r := mock.NewMockRotator(controller)
+			r.EXPECT().
+				CreateSlot(context.Background(), description, app.StrategyParams{}, tt.wantFormat).
+				Return(id, tt.rotatorReturnError)
+			h := handler{rotator: r}
+
+			resp, err := h.CreateSlot(
+				context.Background(),
+				&grpcapi.CreateSlotRequest{Description: description, Format: tt.format},
+			)
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedResponseCode, resp.GetStatus().GetCode())
+		})
+	}
+}
+
+func Test_handler_CreateBanner_Creative(t *testing.T) {
+	errInvalidCreative := fmt.Errorf("%w: dimensions -1x250 are negative", app.ErrInvalidCreative)
+	tests := map[string]struct {
+		creative             *grpcapi.Creative
+		wantCreative         app.Creative
+		rotatorReturnError   error
+		expectedResponseCode code.Code
+	}{
+		"no creative": {
+			creative:             nil,
+			wantCreative:         app.Creative{},
+			expectedResponseCode: code.Code_OK,
+		},
+		"creative": {
+			creative:             grpcCreative,
+			wantCreative:         creative,
+			expectedResponseCode: code.Code_OK,
+		},
+		"invalid creative": {
+			creative:             &grpcapi.Creative{Width: -1, Height: 250},
+			wantCreative:         app.Creative{Width: -1, Height: 250},
+			rotatorReturnError:   errInvalidCreative,
+			expectedResponseCode: code.Code_INVALID_ARGUMENT,
+		},
+	}
+	for testName, tt := range tests {
+		t.Run(testName, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+			r := mock.NewMockRotator(controller)
+			r.EXPECT().
+				CreateBanner(context.Background(), description, tt.wantCreative, app.Flight{}).
+				Return(id, tt.rotatorReturnError)
+			h := handler{rotator: r}
+
+			resp, err := h.CreateBanner(
+				context.Background(),
+				&grpcapi.CreateBannerRequest{Description: description, Creative: tt.creative},
+			)
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedResponseCode, resp.GetStatus().GetCode())
+		})
+	}
+}
+
+func Test_handler_CreateBanner_Flight(t *testing.T) {
+	start, end := time.Unix(1668945600, 0).UTC(), time.Unix(1669550400, 0).UTC()
+	errInvalidFlight := fmt.Errorf("%w: end is not after start", app.ErrInvalidFlight)
+	tests := map[string]struct {
+		startTime            *timestamppb.Timestamp
+		endTime              *timestamppb.Timestamp
+		wantFlight           app.Flight
+		rotatorReturnError   error
+		expectedResponseCode code.Code
+	}{
+		"no flight": {
+			wantFlight:           app.Flight{},
+			expectedResponseCode: code.Code_OK,
+		},
+		"start only": {
+			startTime:            timestamppb.New(start),
+			wantFlight:           app.Flight{Start: start},
+			expectedResponseCode: code.Code_OK,
+		},
+		"flight": {
+			startTime:            timestamppb.New(start),
+			endTime:              timestamppb.New(end),
+			wantFlight:           app.Flight{Start: start, End: end},
+			expectedResponseCode: code.Code_OK,
+		},
+		"invalid flight": {
+			startTime:            timestamppb.New(end),
+			endTime:              timestamppb.New(start),
+			wantFlight:           app.Flight{Start: end, End: start},
+			rotatorReturnError:   errInvalidFlight,
+			expectedResponseCode: code.Code_INVALID_ARGUMENT,
+		},
+	}
+	for testName, tt := range tests {
+		t.Run(testName, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+			r := mock.NewMockRotator(controller)
+			r.EXPECT().
+				CreateBanner(context.Background(), description, app.Creative{}, tt.wantFlight).
+				Return(id, tt.rotatorReturnError)
+			h := handler{rotator: r}
+
+			resp, err := h.CreateBanner(
+				context.Background(),
+				&grpcapi.CreateBannerRequest{Description: description, StartTime: tt.startTime, EndTime: tt.endTime},
+			)
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedResponseCode, resp.GetStatus().GetCode())
+		})
+	}
+}
+
 func Test_handler_DeleteX(t *testing.T) {
 	type response interface {
 		GetStatus() *grpcapi.Status
@@ -185,6 +432,11 @@ func Test_handler_DeleteX(t *testing.T) {
 			rotatorReturnError:   app.ErrEmptyID,
 			expectedResponseCode: code.Code_INVALID_ARGUMENT,
 		},
+		"in use": {
+			argID:                id,
+			rotatorReturnError:   app.NewErrInUse("referenced"),
+			expectedResponseCode: code.Code_FAILED_PRECONDITION,
+		},
 		"rotator error": {
 			argID:              id,
 			rotatorReturnError: errRotator,
@@ -233,6 +485,13 @@ func Test_handler_AttachBanner(t *testing.T) {
 			wantErr:          nil,
 			wantResponseCode: code.Code_NOT_FOUND,
 		},
+		"not fit error": {
+			slotID:           slotID,
+			bannerID:         bannerID,
+			rotatorReturnErr: errNotFit,
+			wantErr:          nil,
+			wantResponseCode: code.Code_FAILED_PRECONDITION,
+		},
 		"rotator error": {
 			slotID:           slotID,
 			bannerID:         bannerID,
@@ -377,7 +636,7 @@ func Test_handler_ClickBanner(t *testing.T) {
 			defer controller.Finish()
 			r := mock.NewMockRotator(controller)
 			r.EXPECT().
-				ClickBanner(context.Background(), tt.slotID, tt.bannerID, tt.socialGroupID).
+				ClickBanner(context.Background(), tt.slotID, tt.bannerID, tt.socialGroupID, app.Features(features)).
 				Return(tt.rotatorReturnErr)
 			h := &handler{rotator: r}
 
@@ -385,6 +644,7 @@ func Test_handler_ClickBanner(t *testing.T) {
 				SlotId:        tt.slotID,
 				BannerId:      tt.bannerID,
 				SocialGroupId: tt.socialGroupID,
+				Features:      features,
 			})
 
 			if tt.wantErr == nil {
@@ -424,6 +684,24 @@ func Test_handler_SelectBanner(t *testing.T) {
 			wantErr:          nil,
 			wantResponseCode: code.Code_FAILED_PRECONDITION,
 		},
+		"empty id error": {
+			slotID:           emptyID,
+			socialGroupID:    socialGroupID,
+			rotatorReturnErr: fmt.Errorf("slot id error: %w", app.ErrEmptyID),
+			wantResponseCode: code.Code_INVALID_ARGUMENT,
+		},
+		"all banners out of flight": {
+			slotID:           slotID,
+			socialGroupID:    socialGroupID,
+			rotatorReturnErr: fmt.Errorf("select banner error: %w", app.ErrNoBannersFound),
+			wantResponseCode: code.Code_FAILED_PRECONDITION,
+		},
+		"invalid strategy error": {
+			slotID:           slotID,
+			socialGroupID:    socialGroupID,
+			rotatorReturnErr: fmt.Errorf("select banner error: %w: unknown name 'x'", app.ErrInvalidStrategy),
+			wantResponseCode: code.Code_FAILED_PRECONDITION,
+		},
 		"rotator error": {
 			slotID:           slotID,
 			bannerID:         bannerID,
@@ -448,19 +726,87 @@ func Test_handler_SelectBanner(t *testing.T) {
 			defer controller.Finish()
 			r := mock.NewMockRotator(controller)
 			r.EXPECT().
-				SelectBanner(context.Background(), tt.slotID, tt.socialGroupID).
-				Return(tt.bannerID, tt.rotatorReturnErr)
+				SelectBanner(context.Background(), tt.slotID, tt.socialGroupID, app.Features(features)).
+				Return(app.Banner{ID: tt.bannerID, Description: description, Creative: creative}, tt.rotatorReturnErr)
 			h := &handler{rotator: r}
 
 			gotResponse, err := h.SelectBanner(context.Background(), &grpcapi.SelectBannerRequest{
 				SlotId:        tt.slotID,
 				SocialGroupId: tt.socialGroupID,
+				Features:      features,
 			})
 
 			if tt.wantErr == nil {
 				require.NoError(t, err)
 				require.Equal(t, tt.wantResponseCode, gotResponse.GetStatus().GetCode())
 				require.Equal(t, tt.wantBannerID, gotResponse.GetBannerId())
+				if tt.wantBannerID != "" {
+					require.Equal(t, tt.wantBannerID, gotResponse.GetBanner().GetId())
+					require.Equal(t, grpcCreative.GetMetadata(), gotResponse.GetBanner().GetCreative().GetMetadata())
+					require.Equal(t, grpcCreative.GetImageUrl(), gotResponse.GetBanner().GetCreative().GetImageUrl())
+				}
+			} else {
+				require.ErrorIs(t, err, tt.wantErr)
+				require.Nil(t, gotResponse)
+			}
+		})
+	}
+}
+
+func Test_handler_GetBanner(t *testing.T) {
+	tests := map[string]struct {
+		id               string
+		rotatorReturnErr error
+		wantResponseCode code.Code
+		wantBanner       *grpcapi.Banner
+		wantErr          error
+	}{
+		"empty id": {
+			id:               emptyID,
+			rotatorReturnErr: app.ErrEmptyID,
+			wantResponseCode: code.Code_INVALID_ARGUMENT,
+		},
+		"not found error": {
+			id:               bannerID,
+			rotatorReturnErr: errNotFound,
+			wantResponseCode: code.Code_NOT_FOUND,
+		},
+		"rotator error": {
+			id:               bannerID,
+			rotatorReturnErr: errRotator,
+			wantErr:          errRotator,
+		},
+		"no error": {
+			id:               bannerID,
+			wantResponseCode: code.Code_OK,
+			wantBanner: &grpcapi.Banner{
+				Id:          bannerID,
+				Description: description,
+				StartTime:   &timestamppb.Timestamp{Seconds: 1668945600},
+			},
+		},
+	}
+	for testName, tt := range tests {
+		t.Run(testName, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+			r := mock.NewMockRotator(controller)
+			var banner app.Banner
+			if tt.rotatorReturnErr == nil {
+				banner = app.Banner{ID: tt.id, Description: description, Flight: app.Flight{Start: time.Unix(1668945600, 0)}}
+			}
+			r.EXPECT().GetBanner(context.Background(), tt.id).Return(banner, tt.rotatorReturnErr)
+			h := &handler{rotator: r}
+
+			gotResponse, err := h.GetBanner(context.Background(), &grpcapi.GetBannerRequest{Id: tt.id})
+
+			if tt.wantErr == nil {
+				require.NoError(t, err)
+				require.Equal(t, tt.wantResponseCode, gotResponse.GetStatus().GetCode())
+				require.Equal(t, tt.wantBanner.GetId(), gotResponse.GetBanner().GetId())
+				require.Equal(t, tt.wantBanner.GetDescription(), gotResponse.GetBanner().GetDescription())
+				require.Equal(t, tt.wantBanner.GetStartTime().GetSeconds(), gotResponse.GetBanner().GetStartTime().GetSeconds())
+				require.Nil(t, gotResponse.GetBanner().GetEndTime())
 			} else {
 				require.ErrorIs(t, err, tt.wantErr)
 				require.Nil(t, gotResponse)
@@ -468,3 +814,255 @@ func Test_handler_SelectBanner(t *testing.T) {
 		})
 	}
 }
+
+func Test_handler_GetSlot(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	r := mock.NewMockRotator(controller)
+	slot := app.Slot{
+		ID:          slotID,
+		Description: description,
+		Strategy:    app.StrategyParams{Name: "ucb1", Window: time.Hour},
+		Format:      app.SlotFormat{Sizes: []app.Size{{Width: 300, Height: 250}}, MIMETypes: []string{"image/*"}},
+	}
+	r.EXPECT().GetSlot(context.Background(), slotID).Return(slot, nil)
+	h := &handler{rotator: r}
+
+	gotResponse, err := h.GetSlot(context.Background(), &grpcapi.GetSlotRequest{Id: slotID})
+
+	require.NoError(t, err)
+	require.Equal(t, code.Code_OK, gotResponse.GetStatus().GetCode())
+	require.Equal(t, slotID, gotResponse.GetSlot().GetId())
+	require.Equal(t, description, gotResponse.GetSlot().GetDescription())
+	require.Equal(t, "ucb1", gotResponse.GetSlot().GetStrategy().GetName())
+	require.Equal(t, int64(3600), gotResponse.GetSlot().GetStrategy().GetWindowSeconds())
+	require.Len(t, gotResponse.GetSlot().GetFormat().GetSizes(), 1)
+	require.Equal(t, int32(250), gotResponse.GetSlot().GetFormat().GetSizes()[0].GetHeight())
+	require.Equal(t, []string{"image/*"}, gotResponse.GetSlot().GetFormat().GetMimeTypes())
+}
+
+func Test_handler_ListSocialGroups(t *testing.T) {
+	tests := map[string]struct {
+		rotatorReturnErr  error
+		wantResponseCode  code.Code
+		wantSocialGroups  int
+		wantNextPageToken string
+		wantErr           error
+	}{
+		"invalid page token": {
+			rotatorReturnErr: app.ErrInvalidPageToken,
+			wantResponseCode: code.Code_INVALID_ARGUMENT,
+		},
+		"invalid page size": {
+			rotatorReturnErr: app.ErrInvalidPageSize,
+			wantResponseCode: code.Code_INVALID_ARGUMENT,
+		},
+		"rotator error": {
+			rotatorReturnErr: errRotator,
+			wantErr:          errRotator,
+		},
+		"no error": {
+			wantResponseCode:  code.Code_OK,
+			wantSocialGroups:  2,
+			wantNextPageToken: "token",
+		},
+	}
+	for testName, tt := range tests {
+		t.Run(testName, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+			r := mock.NewMockRotator(controller)
+			var socialGroups []app.SocialGroup
+			var nextPageToken string
+			if tt.rotatorReturnErr == nil {
+				socialGroups = []app.SocialGroup{{ID: "1", Description: "a"}, {ID: "2", Description: "b"}}
+				nextPageToken = "token"
+			}
+			query := app.ListQuery{PageSize: 2, PageToken: "previous", DescriptionContains: "x"}
+			r.EXPECT().
+				ListSocialGroups(context.Background(), query).
+				Return(socialGroups, nextPageToken, tt.rotatorReturnErr)
+			h := &handler{rotator: r}
+
+			gotResponse, err := h.ListSocialGroups(context.Background(), &grpcapi.ListSocialGroupsRequest{
+				PageSize:            2,
+				PageToken:           "previous",
+				DescriptionContains: "x",
+			})
+
+			if tt.wantErr == nil {
+				require.NoError(t, err)
+				require.Equal(t, tt.wantResponseCode, gotResponse.GetStatus().GetCode())
+				require.Len(t, gotResponse.GetSocialGroups(), tt.wantSocialGroups)
+				require.Equal(t, tt.wantNextPageToken, gotResponse.GetNextPageToken())
+			} else {
+				require.ErrorIs(t, err, tt.wantErr)
+				require.Nil(t, gotResponse)
+			}
+		})
+	}
+}
+
+func Test_handler_UpdateBanner(t *testing.T) {
+	tests := map[string]struct {
+		updateMask       *fieldmaskpb.FieldMask
+		isRotatorCalled  bool
+		rotatorReturnErr error
+		wantResponseCode code.Code
+		wantErr          error
+	}{
+		"no mask": {
+			isRotatorCalled:  true,
+			wantResponseCode: code.Code_OK,
+		},
+		"description mask": {
+			updateMask:       &fieldmaskpb.FieldMask{Paths: []string{"description"}},
+			isRotatorCalled:  true,
+			wantResponseCode: code.Code_OK,
+		},
+		"invalid mask": {
+			updateMask:       &fieldmaskpb.FieldMask{Paths: []string{"description", "id"}},
+			wantResponseCode: code.Code_INVALID_ARGUMENT,
+		},
+		"empty description": {
+			isRotatorCalled:  true,
+			rotatorReturnErr: app.ErrEmptyDescription,
+			wantResponseCode: code.Code_INVALID_ARGUMENT,
+		},
+		"not found error": {
+			isRotatorCalled:  true,
+			rotatorReturnErr: errNotFound,
+			wantResponseCode: code.Code_NOT_FOUND,
+		},
+		"rotator error": {
+			isRotatorCalled:  true,
+			rotatorReturnErr: errRotator,
+			wantErr:          errRotator,
+		},
+	}
+	for testName, tt := range tests {
+		t.Run(testName, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+			r := mock.NewMockRotator(controller)
+			if tt.isRotatorCalled {
+				r.EXPECT().
+					UpdateBanner(context.Background(), bannerID, description).
+					Return(app.Banner{ID: bannerID, Description: description}, tt.rotatorReturnErr)
+			}
+			h := &handler{rotator: r}
+
+			gotResponse, err := h.UpdateBanner(context.Background(), &grpcapi.UpdateBannerRequest{
+				Banner:     &grpcapi.Banner{Id: bannerID, Description: description},
+				UpdateMask: tt.updateMask,
+			})
+
+			if tt.wantErr == nil {
+				require.NoError(t, err)
+				require.Equal(t, tt.wantResponseCode, gotResponse.GetStatus().GetCode())
+			} else {
+				require.ErrorIs(t, err, tt.wantErr)
+				require.Nil(t, gotResponse)
+			}
+		})
+	}
+}
+
+func Test_handler_ListSlotBanners(t *testing.T) {
+	tests := map[string]struct {
+		rotatorReturnErr error
+		wantResponseCode code.Code
+		wantErr          error
+	}{
+		"empty slot id": {
+			rotatorReturnErr: app.ErrEmptyID,
+			wantResponseCode: code.Code_INVALID_ARGUMENT,
+		},
+		"slot not found": {
+			rotatorReturnErr: app.NewErrNotFound("slot is not found"),
+			wantResponseCode: code.Code_NOT_FOUND,
+		},
+		"rotator error": {
+			rotatorReturnErr: errRotator,
+			wantErr:          errRotator,
+		},
+		"no error": {
+			wantResponseCode: code.Code_OK,
+		},
+	}
+	for testName, tt := range tests {
+		t.Run(testName, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+			r := mock.NewMockRotator(controller)
+			var attachments []app.AttachmentInfo
+			if tt.rotatorReturnErr == nil {
+				attachments = []app.AttachmentInfo{
+					{
+						Attachment: app.Attachment{SlotID: slotID, BannerID: "1", AttachedAt: time.Unix(1668945600, 0)},
+						Status:     app.AttachmentStatusActive,
+					},
+					{Attachment: app.Attachment{SlotID: slotID, BannerID: "2"}, Status: app.AttachmentStatusActive},
+				}
+			}
+			query := app.ListQuery{PageSize: 2, PageToken: "previous"}
+			r.EXPECT().
+				ListSlotBanners(context.Background(), slotID, query).
+				Return(attachments, "", tt.rotatorReturnErr)
+			h := &handler{rotator: r}
+
+			gotResponse, err := h.ListSlotBanners(context.Background(), &grpcapi.ListSlotBannersRequest{
+				SlotId:    slotID,
+				PageSize:  2,
+				PageToken: "previous",
+			})
+
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				require.Nil(t, gotResponse)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.wantResponseCode, gotResponse.GetStatus().GetCode())
+			require.Len(t, gotResponse.GetAttachments(), len(attachments))
+			if len(attachments) > 0 {
+				first, second := gotResponse.GetAttachments()[0], gotResponse.GetAttachments()[1]
+				require.Equal(t, "1", first.GetBannerId())
+				require.Equal(t, int64(1668945600), first.GetAttachedAt().GetSeconds())
+				require.Equal(t, grpcapi.AttachmentStatus_ATTACHMENT_STATUS_ACTIVE, first.GetStatus())
+				require.Nil(t, second.GetAttachedAt())
+			}
+		})
+	}
+}
+
+func Test_handler_ListBannerSlots(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	r := mock.NewMockRotator(controller)
+	attachments := []app.AttachmentInfo{{
+		Attachment: app.Attachment{SlotID: slotID, BannerID: bannerID, AttachedAt: time.Unix(1668945600, 0)},
+		Status:     app.AttachmentStatusEnded,
+	}}
+	r.EXPECT().
+		ListBannerSlots(context.Background(), bannerID, app.ListQuery{PageSize: 1}).
+		Return(attachments, "token", nil)
+	h := &handler{rotator: r}
+
+	gotResponse, err := h.ListBannerSlots(context.Background(), &grpcapi.ListBannerSlotsRequest{
+		BannerId: bannerID,
+		PageSize: 1,
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, code.Code_OK, gotResponse.GetStatus().GetCode())
+	require.Equal(t, "token", gotResponse.GetNextPageToken())
+	require.Len(t, gotResponse.GetAttachments(), 1)
+	require.Equal(t, slotID, gotResponse.GetAttachments()[0].GetSlotId())
+	require.Equal(t, bannerID, gotResponse.GetAttachments()[0].GetBannerId())
+	require.Equal(t, grpcapi.AttachmentStatus_ATTACHMENT_STATUS_ENDED, gotResponse.GetAttachments()[0].GetStatus())
+}
+
+func float64Ptr(v float64) *float64 {
+	return &v
+}
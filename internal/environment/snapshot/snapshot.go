@@ -0,0 +1,341 @@
+// Package snapshot encodes the storage snapshots into the versioned JSON files.
+package snapshot
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/ekhvalov/otus-banners-rotation/internal/app"
+)
+
+// Version is a version of the file format. A file of another version is rejected by Decode.
+const Version = 1
+
+type file struct {
+	Version      int           `json:"version"`
+	CreatedAt    time.Time     `json:"created_at"`
+	Banners      []banner      `json:"banners"`
+	Slots        []slot        `json:"slots"`
+	SocialGroups []entity      `json:"social_groups"`
+	Attachments  []attachment  `json:"attachments"`
+	Counters     []counter     `json:"counters"`
+	LinearModels []linearModel `json:"linear_models"`
+}
+
+type entity struct {
+	ID          string `json:"id"`
+	Description string `json:"description"`
+}
+
+// banner is a banner of a snapshot, the empty fields of the creative are omitted. The flight times are unix
+// seconds, a zero time is unbounded.
+type banner struct {
+	ID          string            `json:"id"`
+	Description string            `json:"description"`
+	TargetURL   string            `json:"target_url,omitempty"`
+	ImageURL    string            `json:"image_url,omitempty"`
+	Width       int               `json:"width,omitempty"`
+	Height      int               `json:"height,omitempty"`
+	MIMEType    string            `json:"mime_type,omitempty"`
+	Metadata    map[string]string `json:"metadata,omitempty"`
+	FlightStart int64             `json:"flight_start,omitempty"`
+	FlightEnd   int64             `json:"flight_end,omitempty"`
+}
+
+type slot struct {
+	ID          string   `json:"id"`
+	Description string   `json:"description"`
+	Strategy    strategy `json:"strategy"`
+	// Format is omitted in case of the slot accepts any creative.
+	Format *format `json:"format,omitempty"`
+}
+
+type format struct {
+	Sizes     []size   `json:"sizes,omitempty"`
+	MIMETypes []string `json:"mime_types,omitempty"`
+}
+
+type size struct {
+	Width  int `json:"width"`
+	Height int `json:"height"`
+}
+
+type strategy struct {
+	Name              string   `json:"name,omitempty"`
+	UCBExploration    *float64 `json:"ucb_exploration,omitempty"`
+	Epsilon           *float64 `json:"epsilon,omitempty"`
+	EpsilonDecay      float64  `json:"epsilon_decay,omitempty"`
+	Alpha             float64  `json:"alpha,omitempty"`
+	Beta              float64  `json:"beta,omitempty"`
+	WindowSeconds     int64    `json:"window_seconds,omitempty"`
+	Discount          float64  `json:"discount,omitempty"`
+	Prior             string   `json:"prior,omitempty"`
+	PriorSelects      *float64 `json:"prior_selects,omitempty"`
+	PriorClicks       *float64 `json:"prior_clicks,omitempty"`
+	Pooling           string   `json:"pooling,omitempty"`
+	PoolingStrength   *float64 `json:"pooling_strength,omitempty"`
+	PoolingMinSelects *float64 `json:"pooling_min_selects,omitempty"`
+}
+
+// attachment is an attachment of a snapshot, a zero attached at means the attach time is unknown.
+type attachment struct {
+	SlotID     string `json:"slot_id"`
+	BannerID   string `json:"banner_id"`
+	AttachedAt int64  `json:"attached_at,omitempty"`
+}
+
+// counter is a counter of a snapshot, a zero start means the all-time counter.
+type counter struct {
+	SlotID        string `json:"slot_id"`
+	SocialGroupID string `json:"social_group_id"`
+	BannerID      string `json:"banner_id"`
+	Start         int64  `json:"start,omitempty"`
+	Selects       int64  `json:"selects"`
+	Clicks        int64  `json:"clicks"`
+}
+
+type linearModel struct {
+	SlotID   string          `json:"slot_id"`
+	BannerID string          `json:"banner_id"`
+	A        []linearElement `json:"a"`
+	B        []linearElement `json:"b"`
+}
+
+type linearElement struct {
+	I     int     `json:"i"`
+	J     int     `json:"j,omitempty"`
+	Value float64 `json:"value"`
+}
+
+// Encode writes the snapshot as JSON of the current Version.
+func Encode(w io.Writer, snapshot app.Snapshot) error {
+	// The empty lists are written as empty arrays rather than nulls.
+	f := file{
+		Version:      Version,
+		CreatedAt:    time.Now().UTC(),
+		Banners:      make([]banner, 0, len(snapshot.Banners)),
+		Slots:        make([]slot, 0, len(snapshot.Slots)),
+		SocialGroups: make([]entity, 0, len(snapshot.SocialGroups)),
+		Attachments:  make([]attachment, 0, len(snapshot.Attachments)),
+		Counters:     make([]counter, 0, len(snapshot.Counters)),
+		LinearModels: make([]linearModel, 0, len(snapshot.LinearModels)),
+	}
+	for _, b := range snapshot.Banners {
+		f.Banners = append(f.Banners, banner{
+			ID:          b.ID,
+			Description: b.Description,
+			TargetURL:   b.Creative.TargetURL,
+			ImageURL:    b.Creative.ImageURL,
+			Width:       b.Creative.Width,
+			Height:      b.Creative.Height,
+			MIMEType:    b.Creative.MIMEType,
+			Metadata:    b.Creative.Metadata,
+			FlightStart: encodeFlightTime(b.Flight.Start),
+			FlightEnd:   encodeFlightTime(b.Flight.End),
+		})
+	}
+	for _, s := range snapshot.Slots {
+		f.Slots = append(f.Slots, slot{
+			ID:          s.ID,
+			Description: s.Description,
+			Strategy:    encodeStrategy(s.Strategy),
+			Format:      encodeFormat(s.Format),
+		})
+	}
+	for _, socialGroup := range snapshot.SocialGroups {
+		f.SocialGroups = append(f.SocialGroups, entity{ID: socialGroup.ID, Description: socialGroup.Description})
+	}
+	for _, a := range snapshot.Attachments {
+		encoded := attachment{SlotID: a.SlotID, BannerID: a.BannerID}
+		if !a.AttachedAt.IsZero() {
+			encoded.AttachedAt = a.AttachedAt.Unix()
+		}
+		f.Attachments = append(f.Attachments, encoded)
+	}
+	for _, c := range snapshot.Counters {
+		encoded := counter{
+			SlotID:        c.SlotID,
+			SocialGroupID: c.SocialGroupID,
+			BannerID:      c.BannerID,
+			Selects:       c.Selects,
+			Clicks:        c.Clicks,
+		}
+		if !c.Start.IsZero() {
+			encoded.Start = c.Start.Unix()
+		}
+		f.Counters = append(f.Counters, encoded)
+	}
+	for _, model := range snapshot.LinearModels {
+		f.LinearModels = append(f.LinearModels, linearModel{
+			SlotID:   model.SlotID,
+			BannerID: model.BannerID,
+			A:        encodeLinearElements(model.A),
+			B:        encodeLinearElements(model.B),
+		})
+	}
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(f); err != nil {
+		return fmt.Errorf("encode of snapshot error: %w", err)
+	}
+	return nil
+}
+
+// Decode reads the snapshot written by Encode. Returns an error in case of the file has an unsupported version.
+func Decode(r io.Reader) (app.Snapshot, error) {
+	var f file
+	if err := json.NewDecoder(r).Decode(&f); err != nil {
+		return app.Snapshot{}, fmt.Errorf("decode of snapshot error: %w", err)
+	}
+	if f.Version != Version {
+		return app.Snapshot{}, fmt.Errorf("unsupported snapshot version %d, expected %d", f.Version, Version)
+	}
+	var snapshot app.Snapshot
+	for _, b := range f.Banners {
+		snapshot.Banners = append(snapshot.Banners, app.Banner{
+			ID:          b.ID,
+			Description: b.Description,
+			Creative: app.Creative{
+				TargetURL: b.TargetURL,
+				ImageURL:  b.ImageURL,
+				Width:     b.Width,
+				Height:    b.Height,
+				MIMEType:  b.MIMEType,
+				Metadata:  b.Metadata,
+			},
+			Flight: app.Flight{Start: decodeFlightTime(b.FlightStart), End: decodeFlightTime(b.FlightEnd)},
+		})
+	}
+	for _, s := range f.Slots {
+		snapshot.Slots = append(snapshot.Slots,
+			app.Slot{ID: s.ID, Description: s.Description, Strategy: decodeStrategy(s.Strategy), Format: decodeFormat(s.Format)})
+	}
+	for _, socialGroup := range f.SocialGroups {
+		snapshot.SocialGroups = append(snapshot.SocialGroups,
+			app.SocialGroup{ID: socialGroup.ID, Description: socialGroup.Description})
+	}
+	for _, a := range f.Attachments {
+		decoded := app.Attachment{SlotID: a.SlotID, BannerID: a.BannerID}
+		if a.AttachedAt != 0 {
+			decoded.AttachedAt = time.Unix(a.AttachedAt, 0)
+		}
+		snapshot.Attachments = append(snapshot.Attachments, decoded)
+	}
+	for _, c := range f.Counters {
+		decoded := app.Counter{
+			SlotID:        c.SlotID,
+			SocialGroupID: c.SocialGroupID,
+			BannerID:      c.BannerID,
+			Selects:       c.Selects,
+			Clicks:        c.Clicks,
+		}
+		if c.Start != 0 {
+			decoded.Start = time.Unix(c.Start, 0)
+		}
+		snapshot.Counters = append(snapshot.Counters, decoded)
+	}
+	for _, model := range f.LinearModels {
+		snapshot.LinearModels = append(snapshot.LinearModels, app.LinearModel{
+			SlotID:   model.SlotID,
+			BannerID: model.BannerID,
+			A:        decodeLinearElements(model.A),
+			B:        decodeLinearElements(model.B),
+		})
+	}
+	return snapshot, nil
+}
+
+func encodeStrategy(params app.StrategyParams) strategy {
+	return strategy{
+		Name:              params.Name,
+		UCBExploration:    params.UCBExploration,
+		Epsilon:           params.Epsilon,
+		EpsilonDecay:      params.EpsilonDecay,
+		Alpha:             params.Alpha,
+		Beta:              params.Beta,
+		WindowSeconds:     int64(params.Window / time.Second),
+		Discount:          params.Discount,
+		Prior:             params.Prior,
+		PriorSelects:      params.PriorSelects,
+		PriorClicks:       params.PriorClicks,
+		Pooling:           params.Pooling,
+		PoolingStrength:   params.PoolingStrength,
+		PoolingMinSelects: params.PoolingMinSelects,
+	}
+}
+
+func decodeStrategy(s strategy) app.StrategyParams {
+	return app.StrategyParams{
+		Name:              s.Name,
+		UCBExploration:    s.UCBExploration,
+		Epsilon:           s.Epsilon,
+		EpsilonDecay:      s.EpsilonDecay,
+		Alpha:             s.Alpha,
+		Beta:              s.Beta,
+		Window:            time.Duration(s.WindowSeconds) * time.Second,
+		Discount:          s.Discount,
+		Prior:             s.Prior,
+		PriorSelects:      s.PriorSelects,
+		PriorClicks:       s.PriorClicks,
+		Pooling:           s.Pooling,
+		PoolingStrength:   s.PoolingStrength,
+		PoolingMinSelects: s.PoolingMinSelects,
+	}
+}
+
+func encodeLinearElements(elements []app.LinearElement) []linearElement {
+	encoded := make([]linearElement, len(elements))
+	for i, element := range elements {
+		encoded[i] = linearElement{I: element.I, J: element.J, Value: element.Value}
+	}
+	return encoded
+}
+
+func decodeLinearElements(elements []linearElement) []app.LinearElement {
+	if len(elements) == 0 {
+		return nil
+	}
+	decoded := make([]app.LinearElement, len(elements))
+	for i, element := range elements {
+		decoded[i] = app.LinearElement{I: element.I, J: element.J, Value: element.Value}
+	}
+	return decoded
+}
+
+func encodeFormat(f app.SlotFormat) *format {
+	if f.IsEmpty() {
+		return nil
+	}
+	encoded := &format{MIMETypes: f.MIMETypes}
+	for _, s := range f.Sizes {
+		encoded.Sizes = append(encoded.Sizes, size(s))
+	}
+	return encoded
+}
+
+func decodeFormat(f *format) app.SlotFormat {
+	if f == nil {
+		return app.SlotFormat{}
+	}
+	decoded := app.SlotFormat{MIMETypes: f.MIMETypes}
+	for _, s := range f.Sizes {
+		decoded.Sizes = append(decoded.Sizes, app.Size(s))
+	}
+	return decoded
+}
+
+func encodeFlightTime(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.Unix()
+}
+
+func decodeFlightTime(seconds int64) time.Time {
+	if seconds == 0 {
+		return time.Time{}
+	}
+	return time.Unix(seconds, 0)
+}
@@ -0,0 +1,76 @@
+package snapshot
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ekhvalov/otus-banners-rotation/internal/app"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeDecode(t *testing.T) {
+	snapshot := app.Snapshot{
+		Banners: []app.Banner{
+			{ID: "b1", Description: "banner"},
+			{ID: "b2", Description: "banner with creative", Creative: app.Creative{
+				TargetURL: "https://example.com/landing",
+				ImageURL:  "https://cdn.example.com/banner.png",
+				Width:     300,
+				Height:    250,
+				MIMEType:  "image/png",
+				Metadata:  map[string]string{"campaign": "autumn"},
+			}},
+			{ID: "b3", Description: "banner with flight", Flight: app.Flight{Start: time.Unix(1668945000, 0)}},
+		},
+		Slots: []app.Slot{
+			{ID: "s1", Description: "slot", Strategy: app.StrategyParams{
+				Name:   "ucb1",
+				Window: 2 * time.Hour,
+				Prior:  "pseudo_counts",
+			}},
+			{ID: "s2", Description: "slot with format", Format: app.SlotFormat{
+				Sizes:     []app.Size{{Width: 300, Height: 250}},
+				MIMETypes: []string{"image/*"},
+			}},
+		},
+		SocialGroups: []app.SocialGroup{{ID: "g1", Description: "group"}},
+		Attachments: []app.Attachment{
+			{SlotID: "s1", BannerID: "b1", AttachedAt: time.Unix(1668945000, 0)},
+			{SlotID: "s1", BannerID: "b2"},
+		},
+		Counters: []app.Counter{
+			{SlotID: "s1", SocialGroupID: "g1", BannerID: "b1", Selects: 10, Clicks: 2},
+			{SlotID: "s1", SocialGroupID: "g1", BannerID: "b1", Start: time.Unix(1668945600, 0), Selects: 1},
+		},
+		LinearModels: []app.LinearModel{{
+			SlotID:   "s1",
+			BannerID: "b1",
+			A:        []app.LinearElement{{I: 0, J: 1, Value: 0.5}},
+			B:        []app.LinearElement{{I: 1, Value: 1}},
+		}},
+	}
+	buf := &bytes.Buffer{}
+
+	require.NoError(t, Encode(buf, snapshot))
+	decoded, err := Decode(buf)
+
+	require.NoError(t, err)
+	require.Equal(t, snapshot, decoded)
+}
+
+func TestDecode_Error(t *testing.T) {
+	tests := map[string]string{
+		"invalid json":        `{"version": `,
+		"unsupported version": `{"version": 2}`,
+		"missing version":     `{"banners": []}`,
+	}
+	for name, input := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := Decode(strings.NewReader(input))
+
+			require.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,652 @@
+package bolt
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/ekhvalov/otus-banners-rotation/internal/app"
+	"go.etcd.io/bbolt"
+)
+
+// intervalTTL is a lifetime of the counters of a stats interval.
+const intervalTTL = app.MaxStatsHistory + app.StatsInterval
+
+const (
+	// slotRecordVersion is a version of the slot records which store the unset optional strategy params as absent.
+	slotRecordVersion = 2
+	// optionalExplorationVersion is the first version which stores the unset ucb exploration and epsilon as absent.
+	optionalExplorationVersion = 1
+)
+
+var (
+	bucketBanners      = []byte("banners")
+	bucketSlots        = []byte("slots")
+	bucketSocialGroups = []byte("social_groups")
+	// bucketBannerCreatives contains the creatives of the banners, a banner created before the creatives
+	// has no record.
+	bucketBannerCreatives = []byte("banner_creatives")
+	// bucketBannerFlights contains the flights of the banners, a banner with the unbounded flight has no record.
+	bucketBannerFlights = []byte("banner_flights")
+	// bucketSlotBanners contains a bucket of the attached banners of every slot.
+	bucketSlotBanners = []byte("slot_banners")
+	// bucketCounters contains a bucket of the banners selects and clicks of every counterKey.
+	bucketCounters = []byte("counters")
+	// bucketLinear contains a bucket of the linear model of every banner in a slot.
+	bucketLinear = []byte("linear")
+)
+
+type IDGenerator interface {
+	GenerateID() string
+}
+
+// NewBolt opens (or creates) the storage file. The file is locked till the storage is closed.
+// The clock is used for the attach times and the stats intervals.
+func NewBolt(config Config, idGenerator IDGenerator, deleteMode app.DeleteMode, clock app.Clock) (*Bolt, error) {
+	db, err := bbolt.Open(config.GetPath(), 0o600, &bbolt.Options{Timeout: config.GetTimeout()})
+	if err != nil {
+		return nil, fmt.Errorf("open '%s' error: %w", config.GetPath(), err)
+	}
+	err = db.Update(func(tx *bbolt.Tx) error {
+		for _, name := range [][]byte{
+			bucketBanners, bucketBannerCreatives, bucketBannerFlights, bucketSlots, bucketSocialGroups,
+			bucketSlotBanners, bucketCounters, bucketLinear,
+		} {
+			if _, err := tx.CreateBucketIfNotExists(name); err != nil {
+				return fmt.Errorf("create bucket '%s' error: %w", name, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+	return &Bolt{db: db, idGenerator: idGenerator, deleteMode: deleteMode, clock: clock}, nil
+}
+
+type Bolt struct {
+	db          *bbolt.DB
+	idGenerator IDGenerator
+	deleteMode  app.DeleteMode
+	clock       app.Clock
+	// cleanedUp is a start of the stats interval in which the expired counters were removed last time.
+	// It is changed in the write transactions only.
+	cleanedUp int64
+}
+
+// creativeRecord is a stored creative of a banner.
+type creativeRecord struct {
+	TargetURL string            `json:"target_url,omitempty"`
+	ImageURL  string            `json:"image_url,omitempty"`
+	Width     int               `json:"width,omitempty"`
+	Height    int               `json:"height,omitempty"`
+	MIMEType  string            `json:"mime_type,omitempty"`
+	Metadata  map[string]string `json:"metadata,omitempty"`
+}
+
+// flightRecord is a stored flight of a banner, the times are unix seconds. A zero time is unbounded.
+type flightRecord struct {
+	Start int64 `json:"start,omitempty"`
+	End   int64 `json:"end,omitempty"`
+}
+
+func newFlightRecord(flight app.Flight) flightRecord {
+	var record flightRecord
+	if !flight.Start.IsZero() {
+		record.Start = flight.Start.Unix()
+	}
+	if !flight.End.IsZero() {
+		record.End = flight.End.Unix()
+	}
+	return record
+}
+
+func (r flightRecord) toFlight() app.Flight {
+	var flight app.Flight
+	if r.Start != 0 {
+		flight.Start = time.Unix(r.Start, 0)
+	}
+	if r.End != 0 {
+		flight.End = time.Unix(r.End, 0)
+	}
+	return flight
+}
+
+// putBanner puts the description, the creative and the flight of a banner.
+func putBanner(tx *bbolt.Tx, banner app.Banner) error {
+	if err := tx.Bucket(bucketBanners).Put([]byte(banner.ID), []byte(banner.Description)); err != nil {
+		return fmt.Errorf("put of banner '%s' error: %w", banner.ID, err)
+	}
+	value, err := json.Marshal(creativeRecord(banner.Creative))
+	if err != nil {
+		return fmt.Errorf("marshal of banner '%s' creative error: %w", banner.ID, err)
+	}
+	if err = tx.Bucket(bucketBannerCreatives).Put([]byte(banner.ID), value); err != nil {
+		return fmt.Errorf("put of banner '%s' creative error: %w", banner.ID, err)
+	}
+	if banner.Flight.IsZero() {
+		if err = tx.Bucket(bucketBannerFlights).Delete([]byte(banner.ID)); err != nil {
+			return fmt.Errorf("delete of banner '%s' flight error: %w", banner.ID, err)
+		}
+		return nil
+	}
+	value, err = json.Marshal(newFlightRecord(banner.Flight))
+	if err != nil {
+		return fmt.Errorf("marshal of banner '%s' flight error: %w", banner.ID, err)
+	}
+	if err = tx.Bucket(bucketBannerFlights).Put([]byte(banner.ID), value); err != nil {
+		return fmt.Errorf("put of banner '%s' flight error: %w", banner.ID, err)
+	}
+	return nil
+}
+
+// readBanner returns a banner with the description, its creative and its flight. A banner without the creative
+// record has the empty creative.
+func readBanner(tx *bbolt.Tx, id string, description []byte) (app.Banner, error) {
+	banner := app.Banner{ID: id, Description: string(description)}
+	flight, err := readFlight(tx, id)
+	if err != nil {
+		return app.Banner{}, err
+	}
+	banner.Flight = flight
+	value := tx.Bucket(bucketBannerCreatives).Get([]byte(id))
+	if value == nil {
+		return banner, nil
+	}
+	var record creativeRecord
+	if err := json.Unmarshal(value, &record); err != nil {
+		return app.Banner{}, fmt.Errorf("unmarshal of banner '%s' creative error: %w", id, err)
+	}
+	banner.Creative = app.Creative(record)
+	return banner, nil
+}
+
+// readFlight returns the flight of a banner, the unbounded flight in case of no record.
+func readFlight(tx *bbolt.Tx, id string) (app.Flight, error) {
+	value := tx.Bucket(bucketBannerFlights).Get([]byte(id))
+	if value == nil {
+		return app.Flight{}, nil
+	}
+	var record flightRecord
+	if err := json.Unmarshal(value, &record); err != nil {
+		return app.Flight{}, fmt.Errorf("unmarshal of banner '%s' flight error: %w", id, err)
+	}
+	return record.toFlight(), nil
+}
+
+// slotRecord is a stored slot.
+type slotRecord struct {
+	// Version is less than slotRecordVersion for the records which store some unset optional params as zeros.
+	Version           int      `json:"version,omitempty"`
+	Description       string   `json:"description"`
+	Name              string   `json:"name"`
+	UCBExploration    *float64 `json:"ucb_exploration,omitempty"`
+	Epsilon           *float64 `json:"epsilon,omitempty"`
+	EpsilonDecay      float64  `json:"epsilon_decay"`
+	Alpha             float64  `json:"alpha"`
+	Beta              float64  `json:"beta"`
+	WindowSeconds     int64    `json:"window_seconds"`
+	Discount          float64  `json:"discount"`
+	Prior             string   `json:"prior"`
+	PriorSelects      *float64 `json:"prior_selects,omitempty"`
+	PriorClicks       *float64 `json:"prior_clicks,omitempty"`
+	Pooling           string   `json:"pooling"`
+	PoolingStrength   *float64 `json:"pooling_strength,omitempty"`
+	PoolingMinSelects *float64 `json:"pooling_min_selects,omitempty"`
+	// Sizes and MIMETypes are the slot format, they are omitted in case of they are empty.
+	Sizes     []sizeRecord `json:"sizes,omitempty"`
+	MIMETypes []string     `json:"mime_types,omitempty"`
+}
+
+// sizeRecord is a stored size of a slot format.
+type sizeRecord struct {
+	Width  int `json:"width"`
+	Height int `json:"height"`
+}
+
+func newSlotRecord(description string, strategy app.StrategyParams, format app.SlotFormat) slotRecord {
+	record := slotRecord{
+		Version:           slotRecordVersion,
+		Description:       description,
+		Name:              strategy.Name,
+		UCBExploration:    strategy.UCBExploration,
+		Epsilon:           strategy.Epsilon,
+		EpsilonDecay:      strategy.EpsilonDecay,
+		Alpha:             strategy.Alpha,
+		Beta:              strategy.Beta,
+		WindowSeconds:     int64(strategy.Window / time.Second),
+		Discount:          strategy.Discount,
+		Prior:             strategy.Prior,
+		PriorSelects:      strategy.PriorSelects,
+		PriorClicks:       strategy.PriorClicks,
+		Pooling:           strategy.Pooling,
+		PoolingStrength:   strategy.PoolingStrength,
+		PoolingMinSelects: strategy.PoolingMinSelects,
+	}
+	for _, size := range format.Sizes {
+		record.Sizes = append(record.Sizes, sizeRecord(size))
+	}
+	if len(format.MIMETypes) > 0 {
+		record.MIMETypes = format.MIMETypes
+	}
+	return record
+}
+
+func (r slotRecord) toSlot(id string) app.Slot {
+	if r.Version < optionalExplorationVersion {
+		r.UCBExploration = nilIfZero(r.UCBExploration)
+		r.Epsilon = nilIfZero(r.Epsilon)
+	}
+	if r.Version < slotRecordVersion {
+		r.PriorSelects = nilIfZero(r.PriorSelects)
+		r.PriorClicks = nilIfZero(r.PriorClicks)
+		r.PoolingStrength = nilIfZero(r.PoolingStrength)
+		r.PoolingMinSelects = nilIfZero(r.PoolingMinSelects)
+	}
+	slot := app.Slot{
+		ID:          id,
+		Description: r.Description,
+		Strategy: app.StrategyParams{
+			Name:              r.Name,
+			UCBExploration:    r.UCBExploration,
+			Epsilon:           r.Epsilon,
+			EpsilonDecay:      r.EpsilonDecay,
+			Alpha:             r.Alpha,
+			Beta:              r.Beta,
+			Window:            time.Duration(r.WindowSeconds) * time.Second,
+			Discount:          r.Discount,
+			Prior:             r.Prior,
+			PriorSelects:      r.PriorSelects,
+			PriorClicks:       r.PriorClicks,
+			Pooling:           r.Pooling,
+			PoolingStrength:   r.PoolingStrength,
+			PoolingMinSelects: r.PoolingMinSelects,
+		},
+		Format: app.SlotFormat{MIMETypes: r.MIMETypes},
+	}
+	for _, size := range r.Sizes {
+		slot.Format.Sizes = append(slot.Format.Sizes, app.Size(size))
+	}
+	return slot
+}
+
+// nilIfZero returns nil in case of the value is zero which means the default value in the old slot records.
+func nilIfZero(value *float64) *float64 {
+	if value == nil || *value == 0 {
+		return nil
+	}
+	return value
+}
+
+func (b *Bolt) Close() error {
+	return b.db.Close()
+}
+
+func (b *Bolt) CreateBanner(
+	_ context.Context, description string, creative app.Creative, flight app.Flight,
+) (id string, err error) {
+	id = b.idGenerator.GenerateID()
+	err = b.db.Update(func(tx *bbolt.Tx) error {
+		return putBanner(tx, app.Banner{ID: id, Description: description, Creative: creative, Flight: flight})
+	})
+	if err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+func (b *Bolt) DeleteBanner(_ context.Context, id string) error {
+	return b.db.Update(func(tx *bbolt.Tx) error {
+		slotIDs, err := getBannerSlotIDs(tx, id)
+		if err != nil {
+			return err
+		}
+		if len(slotIDs) > 0 && b.deleteMode == app.DeleteModeRestrict {
+			return app.NewErrInUse(fmt.Sprintf("banner with id '%s' is attached to slot '%s'", id, slotIDs[0]))
+		}
+		if err = tx.Bucket(bucketBanners).Delete([]byte(id)); err != nil {
+			return fmt.Errorf("delete of banner '%s' error: %w", id, err)
+		}
+		if err = tx.Bucket(bucketBannerCreatives).Delete([]byte(id)); err != nil {
+			return fmt.Errorf("delete of banner '%s' creative error: %w", id, err)
+		}
+		if err = tx.Bucket(bucketBannerFlights).Delete([]byte(id)); err != nil {
+			return fmt.Errorf("delete of banner '%s' flight error: %w", id, err)
+		}
+		for _, slotID := range slotIDs {
+			if err = tx.Bucket(bucketSlotBanners).Bucket([]byte(slotID)).Delete([]byte(id)); err != nil {
+				return fmt.Errorf("delete of slot '%s' banner '%s' error: %w", slotID, id, err)
+			}
+		}
+		if err = deleteBannerCounters(tx, id); err != nil {
+			return err
+		}
+		return deleteNestedBuckets(tx.Bucket(bucketLinear), func(name []byte) bool {
+			return bytes.HasSuffix(name, []byte(counterSeparator+id))
+		})
+	})
+}
+
+func (b *Bolt) CreateSlot(
+	_ context.Context,
+	description string,
+	strategy app.StrategyParams,
+	format app.SlotFormat,
+) (id string, err error) {
+	id = b.idGenerator.GenerateID()
+	value, err := json.Marshal(newSlotRecord(description, strategy, format))
+	if err != nil {
+		return "", fmt.Errorf("marshal of slot '%s' error: %w", id, err)
+	}
+	if err = b.put(bucketSlots, id, value); err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+func (b *Bolt) GetSlot(_ context.Context, id string) (app.Slot, error) {
+	var record slotRecord
+	err := b.db.View(func(tx *bbolt.Tx) error {
+		value := tx.Bucket(bucketSlots).Get([]byte(id))
+		if value == nil {
+			return app.NewErrNotFound(fmt.Sprintf("slot with id '%s' is not found", id))
+		}
+		if err := json.Unmarshal(value, &record); err != nil {
+			return fmt.Errorf("unmarshal of slot '%s' error: %w", id, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return app.Slot{}, err
+	}
+	return record.toSlot(id), nil
+}
+
+func (b *Bolt) DeleteSlot(_ context.Context, id string) error {
+	return b.db.Update(func(tx *bbolt.Tx) error {
+		slotBanners := tx.Bucket(bucketSlotBanners).Bucket([]byte(id))
+		if slotBanners != nil && b.deleteMode == app.DeleteModeRestrict {
+			if k, _ := slotBanners.Cursor().First(); k != nil {
+				return app.NewErrInUse(fmt.Sprintf("slot with id '%s' has attached banners", id))
+			}
+		}
+		if err := tx.Bucket(bucketSlots).Delete([]byte(id)); err != nil {
+			return fmt.Errorf("delete of slot '%s' error: %w", id, err)
+		}
+		if slotBanners != nil {
+			if err := tx.Bucket(bucketSlotBanners).DeleteBucket([]byte(id)); err != nil {
+				return fmt.Errorf("delete bucket of slot '%s' banners error: %w", id, err)
+			}
+		}
+		if err := deleteSlotCounters(tx, id); err != nil {
+			return err
+		}
+		return deleteNestedBuckets(tx.Bucket(bucketLinear), func(name []byte) bool {
+			return bytes.HasPrefix(name, []byte(id+counterSeparator))
+		})
+	})
+}
+
+func (b *Bolt) CreateSocialGroup(_ context.Context, description string) (id string, err error) {
+	id = b.idGenerator.GenerateID()
+	if err = b.put(bucketSocialGroups, id, []byte(description)); err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+func (b *Bolt) DeleteSocialGroup(_ context.Context, id string) error {
+	return b.db.Update(func(tx *bbolt.Tx) error {
+		if b.deleteMode == app.DeleteModeRestrict {
+			if slotID, ok := findSocialGroupSlot(tx, id); ok {
+				return app.NewErrInUse(fmt.Sprintf("socialGroup with id '%s' has registered counters in slot '%s'",
+					id, slotID))
+			}
+		}
+		if err := tx.Bucket(bucketSocialGroups).Delete([]byte(id)); err != nil {
+			return fmt.Errorf("delete of social group '%s' error: %w", id, err)
+		}
+		return deleteSocialGroupCounters(tx, id)
+	})
+}
+
+func (b *Bolt) AttachBanner(_ context.Context, slotID, bannerID string) error {
+	return b.db.Update(func(tx *bbolt.Tx) error {
+		if err := hasBanner(tx, bannerID); err != nil {
+			return err
+		}
+		if err := hasSlot(tx, slotID); err != nil {
+			return err
+		}
+		slotBanners, err := tx.Bucket(bucketSlotBanners).CreateBucketIfNotExists([]byte(slotID))
+		if err != nil {
+			return fmt.Errorf("create bucket of slot '%s' banners error: %w", slotID, err)
+		}
+		if slotBanners.Get([]byte(bannerID)) != nil {
+			return nil
+		}
+		if err = slotBanners.Put([]byte(bannerID), encodeAttachedAt(b.clock.Now())); err != nil {
+			return fmt.Errorf("put of slot '%s' banner '%s' error: %w", slotID, bannerID, err)
+		}
+		return nil
+	})
+}
+
+func (b *Bolt) DetachBanner(_ context.Context, slotID, bannerID string) error {
+	return b.db.Update(func(tx *bbolt.Tx) error {
+		if err := hasBannerAttachedToSlot(tx, slotID, bannerID); err != nil {
+			return err
+		}
+		if err := tx.Bucket(bucketSlotBanners).Bucket([]byte(slotID)).Delete([]byte(bannerID)); err != nil {
+			return fmt.Errorf("delete of slot '%s' banner '%s' error: %w", slotID, bannerID, err)
+		}
+		return nil
+	})
+}
+
+func (b *Bolt) GetSlotStats(_ context.Context, slotID, socialGroupID string) (app.SlotStats, error) {
+	var stats app.SlotStats
+	err := b.db.View(func(tx *bbolt.Tx) error {
+		bannerIDs, err := getSlotBannerIDs(tx, slotID, socialGroupID)
+		if err != nil {
+			return err
+		}
+		slotGroup := readCounters(tx, counterKey{slotID: slotID, socialGroupID: socialGroupID})
+		stats = makeSlotStats(bannerIDs, slotGroup, readCounters(tx, counterKey{slotID: slotID}))
+		group := readCounters(tx, counterKey{socialGroupID: socialGroupID})
+		for i, bannerID := range bannerIDs {
+			stats.Banners[i].OtherSelects = math.Max(float64(group.getSelects(bannerID)-slotGroup.getSelects(bannerID)), 0)
+			stats.Banners[i].OtherClicks = math.Max(float64(group.getClicks(bannerID)-slotGroup.getClicks(bannerID)), 0)
+		}
+		return nil
+	})
+	if err != nil {
+		return app.SlotStats{}, err
+	}
+	return stats, nil
+}
+
+func (b *Bolt) GetSlotStatsHistory(
+	_ context.Context,
+	slotID, socialGroupID string,
+	since time.Time,
+) ([]app.IntervalStats, error) {
+	now := b.clock.Now()
+	since, err := app.StatsHistorySince(since, now)
+	if err != nil {
+		return nil, err
+	}
+	var history []app.IntervalStats
+	err = b.db.View(func(tx *bbolt.Tx) error {
+		bannerIDs, err := getSlotBannerIDs(tx, slotID, socialGroupID)
+		if err != nil {
+			return err
+		}
+		end := now.Truncate(app.StatsInterval)
+		for start := since.Truncate(app.StatsInterval); !start.After(end); start = start.Add(app.StatsInterval) {
+			slotGroup := readCounters(tx, counterKey{slotID: slotID, socialGroupID: socialGroupID, start: start.Unix()})
+			slot := readCounters(tx, counterKey{slotID: slotID, start: start.Unix()})
+			history = append(history, app.IntervalStats{Start: start, Stats: makeSlotStats(bannerIDs, slotGroup, slot)})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return history, nil
+}
+
+func (b *Bolt) RegisterSelect(_ context.Context, slotID, bannerID, socialGroupID string) error {
+	return b.register(slotID, bannerID, socialGroupID, func(c *counter) {
+		c.selects++
+	})
+}
+
+func (b *Bolt) RegisterClick(_ context.Context, slotID, bannerID, socialGroupID string) error {
+	return b.register(slotID, bannerID, socialGroupID, func(c *counter) {
+		c.clicks++
+	})
+}
+
+// register checks the banner attachment and increments the counters of a banner in a write transaction.
+// The expired interval counters are removed once per stats interval.
+func (b *Bolt) register(slotID, bannerID, socialGroupID string, increment func(c *counter)) error {
+	now := b.clock.Now()
+	start := now.Truncate(app.StatsInterval).Unix()
+	return b.db.Update(func(tx *bbolt.Tx) error {
+		if err := hasBannerAttachedToSlot(tx, slotID, bannerID); err != nil {
+			return err
+		}
+		if err := hasSocialGroup(tx, socialGroupID); err != nil {
+			return err
+		}
+		keys := []counterKey{
+			{slotID: slotID, socialGroupID: socialGroupID},
+			{slotID: slotID},
+			{socialGroupID: socialGroupID},
+			{slotID: slotID, socialGroupID: socialGroupID, start: start},
+			{slotID: slotID, start: start},
+		}
+		for _, key := range keys {
+			if err := updateCounter(tx, key, bannerID, increment); err != nil {
+				return err
+			}
+		}
+		if b.cleanedUp == start {
+			return nil
+		}
+		if err := deleteExpiredCounters(tx, now.Add(-intervalTTL).Unix()); err != nil {
+			return err
+		}
+		b.cleanedUp = start
+		return nil
+	})
+}
+
+func (b *Bolt) put(bucket []byte, key string, value []byte) error {
+	err := b.db.Update(func(tx *bbolt.Tx) error {
+		return tx.Bucket(bucket).Put([]byte(key), value)
+	})
+	if err != nil {
+		return fmt.Errorf("put of '%s' '%s' error: %w", bucket, key, err)
+	}
+	return nil
+}
+
+// getSlotBannerIDs returns ids of the banners attached to a slot checking the slot and social group exist.
+func getSlotBannerIDs(tx *bbolt.Tx, slotID, socialGroupID string) ([]string, error) {
+	if err := hasSlot(tx, slotID); err != nil {
+		return nil, err
+	}
+	if err := hasSocialGroup(tx, socialGroupID); err != nil {
+		return nil, err
+	}
+	return getAttachedBannerIDs(tx, slotID)
+}
+
+// getAttachedBannerIDs returns sorted ids of the banners attached to a slot.
+func getAttachedBannerIDs(tx *bbolt.Tx, slotID string) ([]string, error) {
+	var bannerIDs []string
+	if slotBanners := tx.Bucket(bucketSlotBanners).Bucket([]byte(slotID)); slotBanners != nil {
+		_ = slotBanners.ForEach(func(k, _ []byte) error {
+			bannerIDs = append(bannerIDs, string(k))
+			return nil
+		})
+	}
+	if len(bannerIDs) == 0 {
+		return nil, app.ErrNoBannersFound
+	}
+	return bannerIDs, nil
+}
+
+// getBannerSlotIDs returns ids of the slots a banner is attached to.
+func getBannerSlotIDs(tx *bbolt.Tx, bannerID string) ([]string, error) {
+	var slotIDs []string
+	slotBanners := tx.Bucket(bucketSlotBanners)
+	err := slotBanners.ForEach(func(slotID, _ []byte) error {
+		if bucket := slotBanners.Bucket(slotID); bucket != nil && bucket.Get([]byte(bannerID)) != nil {
+			slotIDs = append(slotIDs, string(slotID))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("slots of banner '%s' error: %w", bannerID, err)
+	}
+	return slotIDs, nil
+}
+
+// deleteNestedBuckets deletes the nested buckets which names match.
+func deleteNestedBuckets(bucket *bbolt.Bucket, match func(name []byte) bool) error {
+	var names [][]byte
+	_ = bucket.ForEach(func(k, v []byte) error {
+		if v == nil && match(k) {
+			names = append(names, append([]byte(nil), k...))
+		}
+		return nil
+	})
+	for _, name := range names {
+		if err := bucket.DeleteBucket(name); err != nil {
+			return fmt.Errorf("delete bucket '%q' error: %w", name, err)
+		}
+	}
+	return nil
+}
+
+func hasBanner(tx *bbolt.Tx, bannerID string) error {
+	if tx.Bucket(bucketBanners).Get([]byte(bannerID)) == nil {
+		return app.NewErrNotFound(fmt.Sprintf("banner with id '%s' is not found", bannerID))
+	}
+	return nil
+}
+
+func hasSlot(tx *bbolt.Tx, slotID string) error {
+	if tx.Bucket(bucketSlots).Get([]byte(slotID)) == nil {
+		return app.NewErrNotFound(fmt.Sprintf("slot with id '%s' is not found", slotID))
+	}
+	return nil
+}
+
+func hasSocialGroup(tx *bbolt.Tx, socialGroupID string) error {
+	if tx.Bucket(bucketSocialGroups).Get([]byte(socialGroupID)) == nil {
+		return app.NewErrNotFound(fmt.Sprintf("socialGroup with id '%s' is not found", socialGroupID))
+	}
+	return nil
+}
+
+func hasBannerAttachedToSlot(tx *bbolt.Tx, slotID, bannerID string) error {
+	if err := hasSlot(tx, slotID); err != nil {
+		return err
+	}
+	if err := hasBanner(tx, bannerID); err != nil {
+		return err
+	}
+	slotBanners := tx.Bucket(bucketSlotBanners).Bucket([]byte(slotID))
+	if slotBanners == nil || slotBanners.Get([]byte(bannerID)) == nil {
+		return app.NewErrBannerNotAttached(slotID, bannerID)
+	}
+	return nil
+}
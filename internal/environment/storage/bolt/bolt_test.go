@@ -0,0 +1,97 @@
+package bolt
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/ekhvalov/otus-banners-rotation/internal/app"
+	"github.com/ekhvalov/otus-banners-rotation/internal/environment/storage/storagetest"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestBoltStorage(t *testing.T) {
+	suite.Run(t, storagetest.NewSuite(func(t *testing.T, deleteMode app.DeleteMode) app.Storage {
+		return openBolt(t, filepath.Join(t.TempDir(), "rotator.db"), deleteMode)
+	}))
+}
+
+func TestBolt_Reopen(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "rotator.db")
+	storage, err := NewBolt(makeConfig(path), &sequenceGenerator{}, app.DeleteModeCascade, app.NewSystemClock())
+	require.NoError(t, err)
+	slotID, err := storage.CreateSlot(ctx, "slot", app.StrategyParams{Name: "ucb1"}, app.SlotFormat{})
+	require.NoError(t, err)
+	require.NoError(t, storage.Close())
+
+	slot, err := openBolt(t, path, app.DeleteModeCascade).GetSlot(ctx, slotID)
+
+	require.NoError(t, err)
+	require.Equal(t, app.Slot{ID: slotID, Description: "slot", Strategy: app.StrategyParams{Name: "ucb1"}}, slot)
+}
+
+func TestBolt_GetSlot_LegacyRecord(t *testing.T) {
+	storage := openBolt(t, filepath.Join(t.TempDir(), "rotator.db"), app.DeleteModeCascade)
+	value := `{"description":"slot","name":"epsilon_greedy","ucb_exploration":0,"epsilon":0.2}`
+	require.NoError(t, storage.put(bucketSlots, "1", []byte(value)))
+
+	slot, err := storage.GetSlot(context.Background(), "1")
+
+	require.NoError(t, err)
+	epsilon := 0.2
+	want := app.Slot{ID: "1", Description: "slot", Strategy: app.StrategyParams{Name: "epsilon_greedy", Epsilon: &epsilon}}
+	require.Equal(t, want, slot, "the zeros of the legacy record must mean the defaults")
+}
+
+func TestBolt_GetSlot_RecordVersion1(t *testing.T) {
+	storage := openBolt(t, filepath.Join(t.TempDir(), "rotator.db"), app.DeleteModeCascade)
+	value := `{"version":1,"description":"slot","name":"ucb1","ucb_exploration":0,"prior_selects":0,` +
+		`"prior_clicks":0,"pooling_strength":5,"pooling_min_selects":0}`
+	require.NoError(t, storage.put(bucketSlots, "1", []byte(value)))
+
+	slot, err := storage.GetSlot(context.Background(), "1")
+
+	require.NoError(t, err)
+	exploration, strength := 0.0, 5.0
+	want := app.Slot{ID: "1", Description: "slot", Strategy: app.StrategyParams{
+		Name:            "ucb1",
+		UCBExploration:  &exploration,
+		PoolingStrength: &strength,
+	}}
+	require.Equal(t, want, slot, "the zero prior and pooling params of the version 1 record must mean the defaults")
+}
+
+// openBolt opens the storage which is closed on the test cleanup.
+func openBolt(t *testing.T, path string, deleteMode app.DeleteMode) *Bolt {
+	t.Helper()
+	storage, err := NewBolt(makeConfig(path), &sequenceGenerator{}, deleteMode, app.NewSystemClock())
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, storage.Close())
+	})
+	return storage
+}
+
+// sequenceGenerator generates sequential ids.
+type sequenceGenerator struct {
+	mu sync.Mutex
+	id int
+}
+
+func (g *sequenceGenerator) GenerateID() string {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.id++
+	return fmt.Sprint(g.id)
+}
+
+func makeConfig(path string) Config {
+	v := viper.New()
+	v.Set("bolt.path", path)
+	return NewConfig(v)
+}
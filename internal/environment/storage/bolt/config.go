@@ -0,0 +1,31 @@
+package bolt
+
+import (
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+func NewConfig(v *viper.Viper) Config {
+	return Config{v: v}
+}
+
+type Config struct {
+	v *viper.Viper
+}
+
+// GetPath returns a path of the storage file, "rotator.db" by default.
+func (c *Config) GetPath() string {
+	if path := c.v.GetString("bolt.path"); path != "" {
+		return path
+	}
+	return "rotator.db"
+}
+
+// GetTimeout returns a time to wait for the lock of the storage file, 1 second by default.
+func (c *Config) GetTimeout() time.Duration {
+	if timeout := c.v.GetDuration("bolt.timeout"); timeout > 0 {
+		return timeout
+	}
+	return time.Second
+}
@@ -0,0 +1,266 @@
+package bolt
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/ekhvalov/otus-banners-rotation/internal/app"
+	"go.etcd.io/bbolt"
+)
+
+// errStopIteration stops the iteration over a bucket.
+var errStopIteration = errors.New("stop iteration")
+
+// counterSeparator separates the parts of a counters bucket name. Ids never contain it.
+const counterSeparator = "\x00"
+
+// counterKey is a key of the counters of a slot for a social group. An empty slot id means all slots,
+// an empty social group id means all social groups. A zero start means all-time counters.
+type counterKey struct {
+	slotID        string
+	socialGroupID string
+	start         int64
+}
+
+func (k counterKey) bucketName() []byte {
+	return []byte(k.slotID + counterSeparator + k.socialGroupID + counterSeparator + strconv.FormatInt(k.start, 10))
+}
+
+// counter is a stored value of a banner counters: selects and clicks as big endian uint64.
+type counter struct {
+	selects uint64
+	clicks  uint64
+}
+
+func decodeCounter(value []byte) counter {
+	if len(value) != 16 {
+		return counter{}
+	}
+	return counter{selects: binary.BigEndian.Uint64(value[:8]), clicks: binary.BigEndian.Uint64(value[8:])}
+}
+
+func (c counter) encode() []byte {
+	value := make([]byte, 16)
+	binary.BigEndian.PutUint64(value[:8], c.selects)
+	binary.BigEndian.PutUint64(value[8:], c.clicks)
+	return value
+}
+
+type counters struct {
+	selects map[string]int64
+	clicks  map[string]int64
+	total   int64
+}
+
+// readCounters reads the counters of all banners including the detached ones, so the total is
+// the count of all selects.
+func readCounters(tx *bbolt.Tx, key counterKey) *counters {
+	c := &counters{selects: make(map[string]int64), clicks: make(map[string]int64)}
+	bucket := tx.Bucket(bucketCounters).Bucket(key.bucketName())
+	if bucket == nil {
+		return c
+	}
+	_ = bucket.ForEach(func(k, v []byte) error {
+		value := decodeCounter(v)
+		c.selects[string(k)] = int64(value.selects)
+		c.clicks[string(k)] = int64(value.clicks)
+		c.total += int64(value.selects)
+		return nil
+	})
+	return c
+}
+
+func updateCounter(tx *bbolt.Tx, key counterKey, bannerID string, update func(c *counter)) error {
+	bucket, err := tx.Bucket(bucketCounters).CreateBucketIfNotExists(key.bucketName())
+	if err != nil {
+		return fmt.Errorf("create bucket of counters '%q' error: %w", key.bucketName(), err)
+	}
+	value := decodeCounter(bucket.Get([]byte(bannerID)))
+	update(&value)
+	if err = bucket.Put([]byte(bannerID), value.encode()); err != nil {
+		return fmt.Errorf("put of counters '%q' banner '%s' error: %w", key.bucketName(), bannerID, err)
+	}
+	return nil
+}
+
+// deleteExpiredCounters removes the buckets of the interval counters started before expired.
+func deleteExpiredCounters(tx *bbolt.Tx, expired int64) error {
+	bucket := tx.Bucket(bucketCounters)
+	var names [][]byte
+	_ = bucket.ForEach(func(k, _ []byte) error {
+		if key, ok := parseCounterBucketName(k); ok && key.start != 0 && key.start < expired {
+			names = append(names, append([]byte(nil), k...))
+		}
+		return nil
+	})
+	for _, name := range names {
+		if err := bucket.DeleteBucket(name); err != nil {
+			return fmt.Errorf("delete bucket of counters '%q' error: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// deleteBannerCounters deletes the counters of a banner in all counter sets.
+func deleteBannerCounters(tx *bbolt.Tx, bannerID string) error {
+	root := tx.Bucket(bucketCounters)
+	var names [][]byte
+	_ = root.ForEach(func(k, _ []byte) error {
+		names = append(names, append([]byte(nil), k...))
+		return nil
+	})
+	for _, name := range names {
+		if err := root.Bucket(name).Delete([]byte(bannerID)); err != nil {
+			return fmt.Errorf("delete of counters '%q' banner '%s' error: %w", name, bannerID, err)
+		}
+	}
+	return nil
+}
+
+// deleteSlotCounters deletes the counters of a slot. The all-time counters of the slot for every social group
+// are subtracted from the counters of the social group in all slots.
+func deleteSlotCounters(tx *bbolt.Tx, slotID string) error {
+	return deleteCounters(tx, func(key counterKey) (counterKey, bool) {
+		if key.slotID != slotID {
+			return counterKey{}, false
+		}
+		if key.socialGroupID == "" || key.start != 0 {
+			return counterKey{}, true
+		}
+		return counterKey{socialGroupID: key.socialGroupID}, true
+	})
+}
+
+// deleteSocialGroupCounters deletes the counters of a social group. The counters of a slot for the social group
+// are subtracted from the pooled counters of the slot.
+func deleteSocialGroupCounters(tx *bbolt.Tx, socialGroupID string) error {
+	return deleteCounters(tx, func(key counterKey) (counterKey, bool) {
+		if key.socialGroupID != socialGroupID {
+			return counterKey{}, false
+		}
+		if key.slotID == "" {
+			return counterKey{}, true
+		}
+		return counterKey{slotID: key.slotID, start: key.start}, true
+	})
+}
+
+// findSocialGroupSlot returns an id of a slot which has the all-time counters for a social group.
+func findSocialGroupSlot(tx *bbolt.Tx, socialGroupID string) (string, bool) {
+	var slotID string
+	_ = tx.Bucket(bucketCounters).ForEach(func(k, _ []byte) error {
+		if key, ok := parseCounterBucketName(k); ok && key.socialGroupID == socialGroupID &&
+			key.slotID != "" && key.start == 0 {
+			slotID = key.slotID
+			return errStopIteration
+		}
+		return nil
+	})
+	return slotID, slotID != ""
+}
+
+// deleteCounters deletes the counter sets for which match returns true. The deleted counters are subtracted
+// from the counter set returned by match unless it is the zero key.
+func deleteCounters(tx *bbolt.Tx, match func(key counterKey) (subtractFrom counterKey, ok bool)) error {
+	root := tx.Bucket(bucketCounters)
+	type deletion struct {
+		name         []byte
+		subtractFrom counterKey
+	}
+	var deletions []deletion
+	_ = root.ForEach(func(k, _ []byte) error {
+		key, ok := parseCounterBucketName(k)
+		if !ok {
+			return nil
+		}
+		if subtractFrom, ok := match(key); ok {
+			deletions = append(deletions, deletion{name: append([]byte(nil), k...), subtractFrom: subtractFrom})
+		}
+		return nil
+	})
+	for _, d := range deletions {
+		if d.subtractFrom != (counterKey{}) {
+			if err := subtractCounters(tx, d.subtractFrom, root.Bucket(d.name)); err != nil {
+				return err
+			}
+		}
+		if err := root.DeleteBucket(d.name); err != nil {
+			return fmt.Errorf("delete bucket of counters '%q' error: %w", d.name, err)
+		}
+	}
+	return nil
+}
+
+// subtractCounters subtracts the counters of the source bucket, the emptied counters of the banners are removed.
+func subtractCounters(tx *bbolt.Tx, key counterKey, source *bbolt.Bucket) error {
+	target := tx.Bucket(bucketCounters).Bucket(key.bucketName())
+	if target == nil {
+		return nil
+	}
+	return source.ForEach(func(bannerID, v []byte) error {
+		value := decodeCounter(target.Get(bannerID))
+		subtracted := decodeCounter(v)
+		value.selects -= minUint64(value.selects, subtracted.selects)
+		value.clicks -= minUint64(value.clicks, subtracted.clicks)
+		var err error
+		if value == (counter{}) {
+			err = target.Delete(bannerID)
+		} else {
+			err = target.Put(bannerID, value.encode())
+		}
+		if err != nil {
+			return fmt.Errorf("subtract of counters '%q' banner '%s' error: %w", key.bucketName(), bannerID, err)
+		}
+		return nil
+	})
+}
+
+func parseCounterBucketName(name []byte) (counterKey, bool) {
+	parts := bytes.Split(name, []byte(counterSeparator))
+	if len(parts) != 3 {
+		return counterKey{}, false
+	}
+	start, err := strconv.ParseInt(string(parts[2]), 10, 64)
+	if err != nil {
+		return counterKey{}, false
+	}
+	return counterKey{slotID: string(parts[0]), socialGroupID: string(parts[1]), start: start}, true
+}
+
+func minUint64(a, b uint64) uint64 {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func (c *counters) getSelects(bannerID string) int64 {
+	return c.selects[bannerID]
+}
+
+func (c *counters) getClicks(bannerID string) int64 {
+	return c.clicks[bannerID]
+}
+
+// makeSlotStats makes the stats of the banners from the counters of a slot for a social group
+// and the pooled counters of a slot for all social groups.
+func makeSlotStats(bannerIDs []string, slotGroup, slot *counters) app.SlotStats {
+	stats := app.SlotStats{
+		Banners:            make([]app.BannerStats, len(bannerIDs)),
+		TotalSelects:       float64(slotGroup.total),
+		PooledTotalSelects: float64(slot.total),
+	}
+	for i, bannerID := range bannerIDs {
+		stats.Banners[i] = app.BannerStats{
+			BannerID:      bannerID,
+			Selects:       float64(slotGroup.getSelects(bannerID)),
+			Clicks:        float64(slotGroup.getClicks(bannerID)),
+			PooledSelects: float64(slot.getSelects(bannerID)),
+			PooledClicks:  float64(slot.getClicks(bannerID)),
+		}
+	}
+	return stats
+}
@@ -0,0 +1,264 @@
+package bolt
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/ekhvalov/otus-banners-rotation/internal/app"
+	"go.etcd.io/bbolt"
+)
+
+func (b *Bolt) GetBanner(_ context.Context, id string) (app.Banner, error) {
+	var banner app.Banner
+	err := b.db.View(func(tx *bbolt.Tx) error {
+		if err := hasBanner(tx, id); err != nil {
+			return err
+		}
+		var err error
+		banner, err = readBanner(tx, id, tx.Bucket(bucketBanners).Get([]byte(id)))
+		return err
+	})
+	if err != nil {
+		return app.Banner{}, err
+	}
+	return banner, nil
+}
+
+func (b *Bolt) GetSocialGroup(_ context.Context, id string) (app.SocialGroup, error) {
+	var socialGroup app.SocialGroup
+	err := b.db.View(func(tx *bbolt.Tx) error {
+		if err := hasSocialGroup(tx, id); err != nil {
+			return err
+		}
+		socialGroup = app.SocialGroup{ID: id, Description: string(tx.Bucket(bucketSocialGroups).Get([]byte(id)))}
+		return nil
+	})
+	return socialGroup, err
+}
+
+func (b *Bolt) UpdateBanner(_ context.Context, id, description string) (app.Banner, error) {
+	var banner app.Banner
+	err := b.db.Update(func(tx *bbolt.Tx) error {
+		if err := hasBanner(tx, id); err != nil {
+			return err
+		}
+		if err := tx.Bucket(bucketBanners).Put([]byte(id), []byte(description)); err != nil {
+			return fmt.Errorf("put of banner '%s' error: %w", id, err)
+		}
+		var err error
+		banner, err = readBanner(tx, id, []byte(description))
+		return err
+	})
+	if err != nil {
+		return app.Banner{}, err
+	}
+	return banner, nil
+}
+
+func (b *Bolt) UpdateSlot(_ context.Context, id, description string) (app.Slot, error) {
+	var record slotRecord
+	err := b.db.Update(func(tx *bbolt.Tx) error {
+		value := tx.Bucket(bucketSlots).Get([]byte(id))
+		if value == nil {
+			return app.NewErrNotFound(fmt.Sprintf("slot with id '%s' is not found", id))
+		}
+		if err := json.Unmarshal(value, &record); err != nil {
+			return fmt.Errorf("unmarshal of slot '%s' error: %w", id, err)
+		}
+		record.Description = description
+		value, err := json.Marshal(record)
+		if err != nil {
+			return fmt.Errorf("marshal of slot '%s' error: %w", id, err)
+		}
+		if err = tx.Bucket(bucketSlots).Put([]byte(id), value); err != nil {
+			return fmt.Errorf("put of slot '%s' error: %w", id, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return app.Slot{}, err
+	}
+	return record.toSlot(id), nil
+}
+
+func (b *Bolt) UpdateSocialGroup(_ context.Context, id, description string) (app.SocialGroup, error) {
+	err := b.db.Update(func(tx *bbolt.Tx) error {
+		if err := hasSocialGroup(tx, id); err != nil {
+			return err
+		}
+		if err := tx.Bucket(bucketSocialGroups).Put([]byte(id), []byte(description)); err != nil {
+			return fmt.Errorf("put of social group '%s' error: %w", id, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return app.SocialGroup{}, err
+	}
+	return app.SocialGroup{ID: id, Description: description}, nil
+}
+
+func (b *Bolt) ListBanners(_ context.Context, filter app.ListFilter) ([]app.Banner, error) {
+	var banners []app.Banner
+	err := b.db.View(func(tx *bbolt.Tx) error {
+		return listBucket(tx.Bucket(bucketBanners), filter, func(id string, value []byte) (bool, error) {
+			if !filter.Match(id, string(value)) {
+				return false, nil
+			}
+			banner, err := readBanner(tx, id, value)
+			if err != nil {
+				return false, err
+			}
+			banners = append(banners, banner)
+			return true, nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return banners, nil
+}
+
+func (b *Bolt) ListSlots(_ context.Context, filter app.ListFilter) ([]app.Slot, error) {
+	var slots []app.Slot
+	err := b.db.View(func(tx *bbolt.Tx) error {
+		return listBucket(tx.Bucket(bucketSlots), filter, func(id string, value []byte) (bool, error) {
+			var record slotRecord
+			if err := json.Unmarshal(value, &record); err != nil {
+				return false, fmt.Errorf("unmarshal of slot '%s' error: %w", id, err)
+			}
+			if !filter.Match(id, record.Description) {
+				return false, nil
+			}
+			slots = append(slots, record.toSlot(id))
+			return true, nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return slots, nil
+}
+
+func (b *Bolt) ListSocialGroups(_ context.Context, filter app.ListFilter) ([]app.SocialGroup, error) {
+	var socialGroups []app.SocialGroup
+	err := b.db.View(func(tx *bbolt.Tx) error {
+		return listBucket(tx.Bucket(bucketSocialGroups), filter, func(id string, value []byte) (bool, error) {
+			if !filter.Match(id, string(value)) {
+				return false, nil
+			}
+			socialGroups = append(socialGroups, app.SocialGroup{ID: id, Description: string(value)})
+			return true, nil
+		})
+	})
+	return socialGroups, err
+}
+
+func (b *Bolt) ListSlotBanners(_ context.Context, slotID string, filter app.ListFilter) ([]app.Attachment, error) {
+	var attachments []app.Attachment
+	err := b.db.View(func(tx *bbolt.Tx) error {
+		if err := hasSlot(tx, slotID); err != nil {
+			return err
+		}
+		slotBanners := tx.Bucket(bucketSlotBanners).Bucket([]byte(slotID))
+		if slotBanners == nil {
+			return nil
+		}
+		return listBucket(slotBanners, filter, func(bannerID string, value []byte) (bool, error) {
+			attachments = append(attachments,
+				app.Attachment{SlotID: slotID, BannerID: bannerID, AttachedAt: decodeAttachedAt(value)})
+			return true, nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return attachments, nil
+}
+
+func (b *Bolt) ListBannerSlots(_ context.Context, bannerID string, filter app.ListFilter) ([]app.Attachment, error) {
+	var attachments []app.Attachment
+	err := b.db.View(func(tx *bbolt.Tx) error {
+		if err := hasBanner(tx, bannerID); err != nil {
+			return err
+		}
+		slotBanners := tx.Bucket(bucketSlotBanners)
+		return listBucket(slotBanners, filter, func(slotID string, _ []byte) (bool, error) {
+			value := slotBanners.Bucket([]byte(slotID)).Get([]byte(bannerID))
+			if value == nil {
+				return false, nil
+			}
+			attachments = append(attachments,
+				app.Attachment{SlotID: slotID, BannerID: bannerID, AttachedAt: decodeAttachedAt(value)})
+			return true, nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return attachments, nil
+}
+
+func (b *Bolt) GetBannerFlights(_ context.Context, bannerIDs []string) (map[string]app.Flight, error) {
+	var flights map[string]app.Flight
+	err := b.db.View(func(tx *bbolt.Tx) error {
+		for _, id := range bannerIDs {
+			if tx.Bucket(bucketBannerFlights).Get([]byte(id)) == nil {
+				continue
+			}
+			flight, err := readFlight(tx, id)
+			if err != nil {
+				return err
+			}
+			if flights == nil {
+				flights = make(map[string]app.Flight)
+			}
+			flights[id] = flight
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return flights, nil
+}
+
+// encodeAttachedAt encodes the attach time of a banner as the big-endian unix seconds.
+func encodeAttachedAt(attachedAt time.Time) []byte {
+	value := make([]byte, 8)
+	binary.BigEndian.PutUint64(value, uint64(attachedAt.Unix()))
+	return value
+}
+
+// decodeAttachedAt decodes the attach time of a banner, a zero time is returned in case of the value is invalid.
+func decodeAttachedAt(value []byte) time.Time {
+	if len(value) != 8 || binary.BigEndian.Uint64(value) == 0 {
+		return time.Time{}
+	}
+	return time.Unix(int64(binary.BigEndian.Uint64(value)), 0)
+}
+
+// listBucket calls add for the records of the bucket which ids are greater than filter.AfterID in the order of ids.
+// The add returns whether the record is added, the iteration stops when filter.Limit records are added.
+func listBucket(bucket *bbolt.Bucket, filter app.ListFilter, add func(id string, value []byte) (bool, error)) error {
+	added := 0
+	cursor := bucket.Cursor()
+	for k, v := cursor.Seek([]byte(filter.AfterID)); k != nil; k, v = cursor.Next() {
+		if filter.Limit > 0 && added == filter.Limit {
+			return nil
+		}
+		if string(k) == filter.AfterID {
+			continue
+		}
+		ok, err := add(string(k), v)
+		if err != nil {
+			return err
+		}
+		if ok {
+			added++
+		}
+	}
+	return nil
+}
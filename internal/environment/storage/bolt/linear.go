@@ -0,0 +1,147 @@
+package bolt
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/ekhvalov/otus-banners-rotation/internal/app"
+	"go.etcd.io/bbolt"
+)
+
+// The elements of a linear model are stored as "a:i:j" and "b:i" keys with float64 bits values.
+const (
+	linearFieldA = "a"
+	linearFieldB = "b"
+)
+
+func (b *Bolt) GetLinearStats(_ context.Context, slotID string, dimension int) ([]app.LinearStats, error) {
+	var stats []app.LinearStats
+	err := b.db.View(func(tx *bbolt.Tx) error {
+		if err := hasSlot(tx, slotID); err != nil {
+			return err
+		}
+		bannerIDs, err := getAttachedBannerIDs(tx, slotID)
+		if err != nil {
+			return err
+		}
+		stats = make([]app.LinearStats, len(bannerIDs))
+		for i, bannerID := range bannerIDs {
+			stats[i] = app.LinearStats{
+				BannerID: bannerID,
+				A:        make([]float64, dimension*dimension),
+				B:        make([]float64, dimension),
+			}
+			bucket := tx.Bucket(bucketLinear).Bucket(linearBucketName(slotID, bannerID))
+			if bucket == nil {
+				continue
+			}
+			err = bucket.ForEach(func(k, v []byte) error {
+				return readLinearElement(stats[i], dimension, k, v)
+			})
+			if err != nil {
+				return fmt.Errorf("linear stats of slot '%s' banner '%s' error: %w", slotID, bannerID, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
+func (b *Bolt) RegisterLinearSelect(_ context.Context, slotID, bannerID string, vector []float64) error {
+	return b.updateLinear(slotID, bannerID, func(bucket *bbolt.Bucket) error {
+		for i, xi := range vector {
+			if xi == 0 {
+				continue
+			}
+			for j, xj := range vector {
+				if xj == 0 {
+					continue
+				}
+				if err := addLinearElement(bucket, linearFieldA+":"+strconv.Itoa(i)+":"+strconv.Itoa(j), xi*xj); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+}
+
+func (b *Bolt) RegisterLinearClick(_ context.Context, slotID, bannerID string, vector []float64) error {
+	return b.updateLinear(slotID, bannerID, func(bucket *bbolt.Bucket) error {
+		for i, xi := range vector {
+			if xi == 0 {
+				continue
+			}
+			if err := addLinearElement(bucket, linearFieldB+":"+strconv.Itoa(i), xi); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (b *Bolt) updateLinear(slotID, bannerID string, update func(bucket *bbolt.Bucket) error) error {
+	return b.db.Update(func(tx *bbolt.Tx) error {
+		if err := hasBannerAttachedToSlot(tx, slotID, bannerID); err != nil {
+			return err
+		}
+		bucket, err := tx.Bucket(bucketLinear).CreateBucketIfNotExists(linearBucketName(slotID, bannerID))
+		if err != nil {
+			return fmt.Errorf("create bucket of slot '%s' banner '%s' linear stats error: %w", slotID, bannerID, err)
+		}
+		if err = update(bucket); err != nil {
+			return fmt.Errorf("update of slot '%s' banner '%s' linear stats error: %w", slotID, bannerID, err)
+		}
+		return nil
+	})
+}
+
+func linearBucketName(slotID, bannerID string) []byte {
+	return []byte(slotID + counterSeparator + bannerID)
+}
+
+func addLinearElement(bucket *bbolt.Bucket, key string, delta float64) error {
+	var value float64
+	if v := bucket.Get([]byte(key)); len(v) == 8 {
+		value = math.Float64frombits(binary.BigEndian.Uint64(v))
+	}
+	encoded := make([]byte, 8)
+	binary.BigEndian.PutUint64(encoded, math.Float64bits(value+delta))
+	return bucket.Put([]byte(key), encoded)
+}
+
+// readLinearElement sets an element of the stats. The elements out of the dimension are ignored.
+func readLinearElement(stats app.LinearStats, dimension int, key, value []byte) error {
+	if len(value) != 8 {
+		return fmt.Errorf("invalid value of '%s'", key)
+	}
+	parts := bytes.Split(key, []byte(":"))
+	indexes := make([]int, len(parts)-1)
+	for i, part := range parts[1:] {
+		index, err := strconv.Atoi(string(part))
+		if err != nil {
+			return fmt.Errorf("invalid index of '%s': %w", key, err)
+		}
+		if index >= dimension {
+			return nil
+		}
+		indexes[i] = index
+	}
+	element := math.Float64frombits(binary.BigEndian.Uint64(value))
+	switch {
+	case string(parts[0]) == linearFieldA && len(indexes) == 2:
+		stats.A[indexes[0]*dimension+indexes[1]] = element
+	case string(parts[0]) == linearFieldB && len(indexes) == 1:
+		stats.B[indexes[0]] = element
+	default:
+		return fmt.Errorf("invalid key '%s'", key)
+	}
+	return nil
+}
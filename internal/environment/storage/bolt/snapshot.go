@@ -0,0 +1,258 @@
+package bolt
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/ekhvalov/otus-banners-rotation/internal/app"
+	"go.etcd.io/bbolt"
+)
+
+func (b *Bolt) ExportSnapshot(_ context.Context) (app.Snapshot, error) {
+	var snapshot app.Snapshot
+	err := b.db.View(func(tx *bbolt.Tx) error {
+		err := tx.Bucket(bucketBanners).ForEach(func(k, v []byte) error {
+			banner, err := readBanner(tx, string(k), v)
+			if err != nil {
+				return err
+			}
+			snapshot.Banners = append(snapshot.Banners, banner)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		err = tx.Bucket(bucketSlots).ForEach(func(k, v []byte) error {
+			var record slotRecord
+			if err := json.Unmarshal(v, &record); err != nil {
+				return fmt.Errorf("unmarshal of slot '%s' error: %w", k, err)
+			}
+			snapshot.Slots = append(snapshot.Slots, record.toSlot(string(k)))
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		_ = tx.Bucket(bucketSocialGroups).ForEach(func(k, v []byte) error {
+			snapshot.SocialGroups = append(snapshot.SocialGroups, app.SocialGroup{ID: string(k), Description: string(v)})
+			return nil
+		})
+		slotBanners := tx.Bucket(bucketSlotBanners)
+		_ = slotBanners.ForEach(func(slotID, _ []byte) error {
+			return slotBanners.Bucket(slotID).ForEach(func(bannerID, v []byte) error {
+				snapshot.Attachments = append(snapshot.Attachments, app.Attachment{
+					SlotID:     string(slotID),
+					BannerID:   string(bannerID),
+					AttachedAt: decodeAttachedAt(v),
+				})
+				return nil
+			})
+		})
+		snapshot.Counters = exportCounters(tx, b.clock.Now().Add(-intervalTTL).Unix())
+		snapshot.LinearModels, err = exportLinearModels(tx)
+		return err
+	})
+	if err != nil {
+		return app.Snapshot{}, fmt.Errorf("export of snapshot error: %w", err)
+	}
+	snapshot.Sort()
+	return snapshot, nil
+}
+
+func (b *Bolt) ImportSnapshot(_ context.Context, snapshot app.Snapshot) error {
+	now := b.clock.Now()
+	expired := now.Add(-intervalTTL)
+	err := b.db.Update(func(tx *bbolt.Tx) error {
+		for _, banner := range snapshot.Banners {
+			if err := putBanner(tx, banner); err != nil {
+				return err
+			}
+		}
+		for _, slot := range snapshot.Slots {
+			value, err := json.Marshal(newSlotRecord(slot.Description, slot.Strategy, slot.Format))
+			if err != nil {
+				return fmt.Errorf("marshal of slot '%s' error: %w", slot.ID, err)
+			}
+			if err = tx.Bucket(bucketSlots).Put([]byte(slot.ID), value); err != nil {
+				return fmt.Errorf("put of slot '%s' error: %w", slot.ID, err)
+			}
+		}
+		for _, socialGroup := range snapshot.SocialGroups {
+			err := tx.Bucket(bucketSocialGroups).Put([]byte(socialGroup.ID), []byte(socialGroup.Description))
+			if err != nil {
+				return fmt.Errorf("put of social group '%s' error: %w", socialGroup.ID, err)
+			}
+		}
+		for _, attachment := range snapshot.Attachments {
+			if err := importAttachment(tx, attachment, now); err != nil {
+				return err
+			}
+		}
+		for _, c := range snapshot.Counters {
+			if err := importCounter(tx, c, expired); err != nil {
+				return err
+			}
+		}
+		for _, model := range snapshot.LinearModels {
+			if err := importLinearModel(tx, model); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("import of snapshot error: %w", err)
+	}
+	return nil
+}
+
+// exportCounters returns the non-zero counters of the slots for the social groups which are not expired.
+func exportCounters(tx *bbolt.Tx, expired int64) []app.Counter {
+	var exported []app.Counter
+	root := tx.Bucket(bucketCounters)
+	_ = root.ForEach(func(k, _ []byte) error {
+		key, ok := parseCounterBucketName(k)
+		// The pooled counters are the sums of the counters of a slot for the social groups.
+		if !ok || key.slotID == "" || key.socialGroupID == "" || (key.start != 0 && key.start < expired) {
+			return nil
+		}
+		var start time.Time
+		if key.start != 0 {
+			start = time.Unix(key.start, 0)
+		}
+		return root.Bucket(k).ForEach(func(bannerID, v []byte) error {
+			value := decodeCounter(v)
+			if value == (counter{}) {
+				return nil
+			}
+			exported = append(exported, app.Counter{
+				SlotID:        key.slotID,
+				SocialGroupID: key.socialGroupID,
+				BannerID:      string(bannerID),
+				Start:         start,
+				Selects:       int64(value.selects),
+				Clicks:        int64(value.clicks),
+			})
+			return nil
+		})
+	})
+	return exported
+}
+
+// importCounter adds a counter to the counters of the slot for the social group and to the pooled counters.
+// The expired interval counter is skipped.
+func importCounter(tx *bbolt.Tx, c app.Counter, expired time.Time) error {
+	var keys []counterKey
+	if c.Start.IsZero() {
+		keys = []counterKey{
+			{slotID: c.SlotID, socialGroupID: c.SocialGroupID},
+			{slotID: c.SlotID},
+			{socialGroupID: c.SocialGroupID},
+		}
+	} else if !c.Start.Before(expired) {
+		start := c.Start.Unix()
+		keys = []counterKey{
+			{slotID: c.SlotID, socialGroupID: c.SocialGroupID, start: start},
+			{slotID: c.SlotID, start: start},
+		}
+	}
+	for _, key := range keys {
+		err := updateCounter(tx, key, c.BannerID, func(value *counter) {
+			value.selects += uint64(c.Selects)
+			value.clicks += uint64(c.Clicks)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// importAttachment attaches the banner at the attach time of the attachment or at now if it is not set.
+func importAttachment(tx *bbolt.Tx, attachment app.Attachment, now time.Time) error {
+	slotBanners, err := tx.Bucket(bucketSlotBanners).CreateBucketIfNotExists([]byte(attachment.SlotID))
+	if err != nil {
+		return fmt.Errorf("create bucket of slot '%s' banners error: %w", attachment.SlotID, err)
+	}
+	if slotBanners.Get([]byte(attachment.BannerID)) != nil {
+		return nil
+	}
+	attachedAt := attachment.AttachedAt
+	if attachedAt.IsZero() {
+		attachedAt = now
+	}
+	if err = slotBanners.Put([]byte(attachment.BannerID), encodeAttachedAt(attachedAt)); err != nil {
+		return fmt.Errorf("put of slot '%s' banner '%s' error: %w", attachment.SlotID, attachment.BannerID, err)
+	}
+	return nil
+}
+
+func exportLinearModels(tx *bbolt.Tx) ([]app.LinearModel, error) {
+	var models []app.LinearModel
+	root := tx.Bucket(bucketLinear)
+	err := root.ForEach(func(name, _ []byte) error {
+		parts := bytes.Split(name, []byte(counterSeparator))
+		if len(parts) != 2 {
+			return nil
+		}
+		model := app.LinearModel{SlotID: string(parts[0]), BannerID: string(parts[1])}
+		err := root.Bucket(name).ForEach(func(k, v []byte) error {
+			if len(v) != 8 {
+				return fmt.Errorf("invalid value of '%s'", k)
+			}
+			element := app.LinearElement{Value: math.Float64frombits(binary.BigEndian.Uint64(v))}
+			fields := bytes.Split(k, []byte(":"))
+			indexes := make([]int, len(fields)-1)
+			for i, field := range fields[1:] {
+				index, err := strconv.Atoi(string(field))
+				if err != nil {
+					return fmt.Errorf("invalid index of '%s': %w", k, err)
+				}
+				indexes[i] = index
+			}
+			switch {
+			case string(fields[0]) == linearFieldA && len(indexes) == 2:
+				element.I, element.J = indexes[0], indexes[1]
+				model.A = append(model.A, element)
+			case string(fields[0]) == linearFieldB && len(indexes) == 1:
+				element.I = indexes[0]
+				model.B = append(model.B, element)
+			default:
+				return fmt.Errorf("invalid key '%s'", k)
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("linear stats of slot '%s' banner '%s' error: %w", model.SlotID, model.BannerID, err)
+		}
+		models = append(models, model)
+		return nil
+	})
+	return models, err
+}
+
+func importLinearModel(tx *bbolt.Tx, model app.LinearModel) error {
+	bucket, err := tx.Bucket(bucketLinear).CreateBucketIfNotExists(linearBucketName(model.SlotID, model.BannerID))
+	if err != nil {
+		return fmt.Errorf("create bucket of slot '%s' banner '%s' linear stats error: %w",
+			model.SlotID, model.BannerID, err)
+	}
+	for _, element := range model.A {
+		key := linearFieldA + ":" + strconv.Itoa(element.I) + ":" + strconv.Itoa(element.J)
+		if err = addLinearElement(bucket, key, element.Value); err != nil {
+			return err
+		}
+	}
+	for _, element := range model.B {
+		if err = addLinearElement(bucket, linearFieldB+":"+strconv.Itoa(element.I), element.Value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,251 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/ekhvalov/otus-banners-rotation/internal/app"
+)
+
+func (m *Memory) GetBanner(_ context.Context, id string) (app.Banner, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if err := m.hasBanner(id); err != nil {
+		return app.Banner{}, err
+	}
+	return m.getBanner(id), nil
+}
+
+func (m *Memory) GetSocialGroup(_ context.Context, id string) (app.SocialGroup, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if err := m.hasSocialGroup(id); err != nil {
+		return app.SocialGroup{}, err
+	}
+	return app.SocialGroup{ID: id, Description: m.socialGroups[id]}, nil
+}
+
+func (m *Memory) UpdateBanner(_ context.Context, id, description string) (app.Banner, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := m.hasBanner(id); err != nil {
+		return app.Banner{}, err
+	}
+	m.banners[id] = description
+	return m.getBanner(id), nil
+}
+
+func (m *Memory) UpdateSlot(_ context.Context, id, description string) (app.Slot, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	s, err := m.getSlot(id)
+	if err != nil {
+		return app.Slot{}, err
+	}
+	s.description = description
+	return s.toSlot(id), nil
+}
+
+func (m *Memory) UpdateSocialGroup(_ context.Context, id, description string) (app.SocialGroup, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := m.hasSocialGroup(id); err != nil {
+		return app.SocialGroup{}, err
+	}
+	m.socialGroups[id] = description
+	return app.SocialGroup{ID: id, Description: description}, nil
+}
+
+func (m *Memory) ListBanners(_ context.Context, filter app.ListFilter) ([]app.Banner, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	var banners []app.Banner
+	for _, id := range listIDs(m.banners, filter) {
+		banners = append(banners, m.getBanner(id))
+	}
+	return banners, nil
+}
+
+func (m *Memory) ListSlots(_ context.Context, filter app.ListFilter) ([]app.Slot, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	descriptions := make(map[string]string, len(m.slots))
+	for id, s := range m.slots {
+		descriptions[id] = s.description
+	}
+	var slots []app.Slot
+	for _, id := range listIDs(descriptions, filter) {
+		s := m.slots[id]
+		slots = append(slots, s.toSlot(id))
+	}
+	return slots, nil
+}
+
+func (m *Memory) ListSocialGroups(_ context.Context, filter app.ListFilter) ([]app.SocialGroup, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	var socialGroups []app.SocialGroup
+	for _, id := range listIDs(m.socialGroups, filter) {
+		socialGroups = append(socialGroups, app.SocialGroup{ID: id, Description: m.socialGroups[id]})
+	}
+	return socialGroups, nil
+}
+
+func (m *Memory) ListSlotBanners(_ context.Context, slotID string, filter app.ListFilter) ([]app.Attachment, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	s, err := m.getSlot(slotID)
+	if err != nil {
+		return nil, err
+	}
+	var attachments []app.Attachment
+	for bannerID, attachedAt := range s.attachedAt {
+		if bannerID > filter.AfterID {
+			attachments = append(attachments, app.Attachment{SlotID: slotID, BannerID: bannerID, AttachedAt: attachedAt})
+		}
+	}
+	sort.Slice(attachments, func(i, j int) bool {
+		return attachments[i].BannerID < attachments[j].BannerID
+	})
+	return limitAttachments(attachments, filter.Limit), nil
+}
+
+func (m *Memory) ListBannerSlots(_ context.Context, bannerID string, filter app.ListFilter) ([]app.Attachment, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if err := m.hasBanner(bannerID); err != nil {
+		return nil, err
+	}
+	var attachments []app.Attachment
+	for slotID, s := range m.slots {
+		if attachedAt, ok := s.attachedAt[bannerID]; ok && slotID > filter.AfterID {
+			attachments = append(attachments, app.Attachment{SlotID: slotID, BannerID: bannerID, AttachedAt: attachedAt})
+		}
+	}
+	sort.Slice(attachments, func(i, j int) bool {
+		return attachments[i].SlotID < attachments[j].SlotID
+	})
+	return limitAttachments(attachments, filter.Limit), nil
+}
+
+func (m *Memory) GetBannerFlights(_ context.Context, bannerIDs []string) (map[string]app.Flight, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	var flights map[string]app.Flight
+	for _, id := range bannerIDs {
+		if flight, ok := m.flights[id]; ok {
+			if flights == nil {
+				flights = make(map[string]app.Flight)
+			}
+			flights[id] = flight
+		}
+	}
+	return flights, nil
+}
+
+func limitAttachments(attachments []app.Attachment, limit int) []app.Attachment {
+	if limit > 0 && len(attachments) > limit {
+		return attachments[:limit]
+	}
+	return attachments
+}
+
+// getBanner returns the banner with a copy of its creative, the banner must exist.
+func (m *Memory) getBanner(id string) app.Banner {
+	return app.Banner{
+		ID:          id,
+		Description: m.banners[id],
+		Creative:    copyCreative(m.creatives[id]),
+		Flight:      m.flights[id],
+	}
+}
+
+// setFlight keeps the flight of the banner truncated to seconds, the unbounded flights are not kept.
+func (m *Memory) setFlight(id string, flight app.Flight) {
+	if flight.IsZero() {
+		delete(m.flights, id)
+		return
+	}
+	m.flights[id] = app.Flight{Start: truncateTime(flight.Start), End: truncateTime(flight.End)}
+}
+
+// truncateTime truncates the time to seconds as the other storages do, the zero time is kept.
+func truncateTime(t time.Time) time.Time {
+	if t.IsZero() {
+		return t
+	}
+	return time.Unix(t.Unix(), 0)
+}
+
+// copyCreative copies the metadata of the creative, so it is not shared with the callers.
+// Empty metadata is copied as nil.
+func copyCreative(creative app.Creative) app.Creative {
+	if len(creative.Metadata) == 0 {
+		creative.Metadata = nil
+		return creative
+	}
+	metadata := make(map[string]string, len(creative.Metadata))
+	for key, value := range creative.Metadata {
+		metadata[key] = value
+	}
+	creative.Metadata = metadata
+	return creative
+}
+
+// toSlot returns the slot with the copied strategy and format, so they are not shared with the callers.
+func (s *slot) toSlot(id string) app.Slot {
+	return app.Slot{
+		ID:          id,
+		Description: s.description,
+		Strategy:    copyStrategyParams(s.strategy),
+		Format:      copySlotFormat(s.format),
+	}
+}
+
+// copyStrategyParams copies the optional values of the strategy, so they are not shared with the callers.
+func copyStrategyParams(strategy app.StrategyParams) app.StrategyParams {
+	strategy.UCBExploration = copyFloat64(strategy.UCBExploration)
+	strategy.Epsilon = copyFloat64(strategy.Epsilon)
+	strategy.PriorSelects = copyFloat64(strategy.PriorSelects)
+	strategy.PriorClicks = copyFloat64(strategy.PriorClicks)
+	strategy.PoolingStrength = copyFloat64(strategy.PoolingStrength)
+	strategy.PoolingMinSelects = copyFloat64(strategy.PoolingMinSelects)
+	return strategy
+}
+
+func copyFloat64(value *float64) *float64 {
+	if value == nil {
+		return nil
+	}
+	copied := *value
+	return &copied
+}
+
+// copySlotFormat copies the sizes and the MIME types of the format, so they are not shared with the callers.
+// Empty ones are copied as nil.
+func copySlotFormat(format app.SlotFormat) app.SlotFormat {
+	var copied app.SlotFormat
+	if len(format.Sizes) > 0 {
+		copied.Sizes = append([]app.Size(nil), format.Sizes...)
+	}
+	if len(format.MIMETypes) > 0 {
+		copied.MIMETypes = append([]string(nil), format.MIMETypes...)
+	}
+	return copied
+}
+
+// listIDs returns the sorted ids of the descriptions selected by the filter.
+func listIDs(descriptions map[string]string, filter app.ListFilter) []string {
+	var ids []string
+	for id, description := range descriptions {
+		if filter.Match(id, description) {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+	if filter.Limit > 0 && len(ids) > filter.Limit {
+		ids = ids[:filter.Limit]
+	}
+	return ids
+}
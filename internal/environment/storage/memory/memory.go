@@ -0,0 +1,544 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ekhvalov/otus-banners-rotation/internal/app"
+)
+
+// intervalTTL is a lifetime of the counters of a stats interval.
+const intervalTTL = app.MaxStatsHistory + app.StatsInterval
+
+type IDGenerator interface {
+	GenerateID() string
+}
+
+// NewMemory creates a storage which keeps everything in memory. It is safe for concurrent use.
+// The clock is used for the attach times and the stats intervals.
+func NewMemory(idGenerator IDGenerator, deleteMode app.DeleteMode, clock app.Clock) *Memory {
+	return &Memory{
+		idGenerator:  idGenerator,
+		deleteMode:   deleteMode,
+		clock:        clock,
+		banners:      make(map[string]string),
+		creatives:    make(map[string]app.Creative),
+		flights:      make(map[string]app.Flight),
+		slots:        make(map[string]*slot),
+		socialGroups: make(map[string]string),
+		counters:     make(map[counterKey]*counters),
+		linear:       make(map[linearKey]*linearModel),
+	}
+}
+
+type Memory struct {
+	mu           sync.RWMutex
+	idGenerator  IDGenerator
+	deleteMode   app.DeleteMode
+	clock        app.Clock
+	banners      map[string]string
+	creatives    map[string]app.Creative
+	flights      map[string]app.Flight
+	slots        map[string]*slot
+	socialGroups map[string]string
+	counters     map[counterKey]*counters
+	linear       map[linearKey]*linearModel
+	// cleanedUp is a start of the stats interval in which the expired counters were removed last time.
+	cleanedUp int64
+}
+
+type slot struct {
+	description string
+	strategy    app.StrategyParams
+	format      app.SlotFormat
+	// attachedAt contains the attach times of the attached banners by their ids.
+	attachedAt map[string]time.Time
+}
+
+// counterKey is a key of the counters of a slot for a social group. An empty slot id means all slots,
+// an empty social group id means all social groups. A zero start means all-time counters.
+type counterKey struct {
+	slotID        string
+	socialGroupID string
+	start         int64
+}
+
+type counters struct {
+	selects map[string]int64
+	clicks  map[string]int64
+	total   int64
+}
+
+type linearKey struct {
+	slotID   string
+	bannerID string
+}
+
+// linearModel keeps the sparse statistics of a linear model of a banner.
+type linearModel struct {
+	a map[[2]int]float64
+	b map[int]float64
+}
+
+func (m *Memory) CreateBanner(
+	_ context.Context, description string, creative app.Creative, flight app.Flight,
+) (id string, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	id = m.idGenerator.GenerateID()
+	m.banners[id] = description
+	m.creatives[id] = copyCreative(creative)
+	m.setFlight(id, flight)
+	return id, nil
+}
+
+func (m *Memory) DeleteBanner(_ context.Context, id string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for slotID, s := range m.slots {
+		if _, ok := s.attachedAt[id]; ok && m.deleteMode == app.DeleteModeRestrict {
+			return app.NewErrInUse(fmt.Sprintf("banner with id '%s' is attached to slot '%s'", id, slotID))
+		}
+	}
+	delete(m.banners, id)
+	delete(m.creatives, id)
+	delete(m.flights, id)
+	for _, s := range m.slots {
+		delete(s.attachedAt, id)
+	}
+	for _, c := range m.counters {
+		c.total -= c.selects[id]
+		delete(c.selects, id)
+		delete(c.clicks, id)
+	}
+	for key := range m.linear {
+		if key.bannerID == id {
+			delete(m.linear, key)
+		}
+	}
+	return nil
+}
+
+func (m *Memory) CreateSlot(
+	_ context.Context,
+	description string,
+	strategy app.StrategyParams,
+	format app.SlotFormat,
+) (id string, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	id = m.idGenerator.GenerateID()
+	m.slots[id] = &slot{
+		description: description,
+		strategy:    copyStrategyParams(strategy),
+		format:      copySlotFormat(format),
+		attachedAt:  make(map[string]time.Time),
+	}
+	return id, nil
+}
+
+func (m *Memory) GetSlot(_ context.Context, id string) (app.Slot, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	s, err := m.getSlot(id)
+	if err != nil {
+		return app.Slot{}, err
+	}
+	return s.toSlot(id), nil
+}
+
+func (m *Memory) DeleteSlot(_ context.Context, id string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if s, ok := m.slots[id]; ok && len(s.attachedAt) > 0 && m.deleteMode == app.DeleteModeRestrict {
+		return app.NewErrInUse(fmt.Sprintf("slot with id '%s' has attached banners", id))
+	}
+	delete(m.slots, id)
+	for key, c := range m.counters {
+		if key.slotID != id {
+			continue
+		}
+		// The counters of the slot are removed from the counters of the social group in all slots.
+		if key.socialGroupID != "" && key.start == 0 {
+			m.counters[counterKey{socialGroupID: key.socialGroupID}].subtract(c)
+		}
+		delete(m.counters, key)
+	}
+	for key := range m.linear {
+		if key.slotID == id {
+			delete(m.linear, key)
+		}
+	}
+	return nil
+}
+
+func (m *Memory) CreateSocialGroup(_ context.Context, description string) (id string, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	id = m.idGenerator.GenerateID()
+	m.socialGroups[id] = description
+	return id, nil
+}
+
+func (m *Memory) DeleteSocialGroup(_ context.Context, id string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.deleteMode == app.DeleteModeRestrict {
+		for key := range m.counters {
+			if key.socialGroupID == id && key.slotID != "" && key.start == 0 {
+				return app.NewErrInUse(fmt.Sprintf("socialGroup with id '%s' has registered counters in slot '%s'",
+					id, key.slotID))
+			}
+		}
+	}
+	delete(m.socialGroups, id)
+	for key, c := range m.counters {
+		if key.socialGroupID != id {
+			continue
+		}
+		// The counters of the social group are removed from the pooled counters of the slot.
+		if key.slotID != "" {
+			m.counters[counterKey{slotID: key.slotID, start: key.start}].subtract(c)
+		}
+		delete(m.counters, key)
+	}
+	return nil
+}
+
+func (m *Memory) AttachBanner(_ context.Context, slotID, bannerID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := m.hasBanner(bannerID); err != nil {
+		return err
+	}
+	s, err := m.getSlot(slotID)
+	if err != nil {
+		return err
+	}
+	if _, ok := s.attachedAt[bannerID]; !ok {
+		s.attachedAt[bannerID] = time.Unix(m.clock.Now().Unix(), 0)
+	}
+	return nil
+}
+
+func (m *Memory) DetachBanner(_ context.Context, slotID, bannerID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := m.hasBannerAttachedToSlot(slotID, bannerID); err != nil {
+		return err
+	}
+	delete(m.slots[slotID].attachedAt, bannerID)
+	return nil
+}
+
+func (m *Memory) GetSlotStats(_ context.Context, slotID, socialGroupID string) (app.SlotStats, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	bannerIDs, err := m.getSlotBannerIDs(slotID, socialGroupID)
+	if err != nil {
+		return app.SlotStats{}, err
+	}
+	slotGroup := m.counters[counterKey{slotID: slotID, socialGroupID: socialGroupID}]
+	stats := makeSlotStats(bannerIDs, slotGroup, m.counters[counterKey{slotID: slotID}])
+	group := m.counters[counterKey{socialGroupID: socialGroupID}]
+	for i, bannerID := range bannerIDs {
+		stats.Banners[i].OtherSelects = math.Max(float64(group.getSelects(bannerID)-slotGroup.getSelects(bannerID)), 0)
+		stats.Banners[i].OtherClicks = math.Max(float64(group.getClicks(bannerID)-slotGroup.getClicks(bannerID)), 0)
+	}
+	return stats, nil
+}
+
+func (m *Memory) GetSlotStatsHistory(
+	_ context.Context,
+	slotID, socialGroupID string,
+	since time.Time,
+) ([]app.IntervalStats, error) {
+	now := m.clock.Now()
+	since, err := app.StatsHistorySince(since, now)
+	if err != nil {
+		return nil, err
+	}
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	bannerIDs, err := m.getSlotBannerIDs(slotID, socialGroupID)
+	if err != nil {
+		return nil, err
+	}
+	var history []app.IntervalStats
+	end := now.Truncate(app.StatsInterval)
+	for start := since.Truncate(app.StatsInterval); !start.After(end); start = start.Add(app.StatsInterval) {
+		slotGroup := m.counters[counterKey{slotID: slotID, socialGroupID: socialGroupID, start: start.Unix()}]
+		slot := m.counters[counterKey{slotID: slotID, start: start.Unix()}]
+		history = append(history, app.IntervalStats{Start: start, Stats: makeSlotStats(bannerIDs, slotGroup, slot)})
+	}
+	return history, nil
+}
+
+func (m *Memory) RegisterSelect(_ context.Context, slotID, bannerID, socialGroupID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := m.checkRegister(slotID, bannerID, socialGroupID); err != nil {
+		return err
+	}
+	for _, key := range m.registerCounterKeys(slotID, socialGroupID) {
+		c := m.getCounters(key)
+		c.selects[bannerID]++
+		c.total++
+	}
+	return nil
+}
+
+func (m *Memory) RegisterClick(_ context.Context, slotID, bannerID, socialGroupID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := m.checkRegister(slotID, bannerID, socialGroupID); err != nil {
+		return err
+	}
+	for _, key := range m.registerCounterKeys(slotID, socialGroupID) {
+		m.getCounters(key).clicks[bannerID]++
+	}
+	return nil
+}
+
+func (m *Memory) GetLinearStats(_ context.Context, slotID string, dimension int) ([]app.LinearStats, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	bannerIDs, err := m.getAttachedBannerIDs(slotID)
+	if err != nil {
+		return nil, err
+	}
+	stats := make([]app.LinearStats, len(bannerIDs))
+	for i, bannerID := range bannerIDs {
+		stats[i] = app.LinearStats{
+			BannerID: bannerID,
+			A:        make([]float64, dimension*dimension),
+			B:        make([]float64, dimension),
+		}
+		model, ok := m.linear[linearKey{slotID: slotID, bannerID: bannerID}]
+		if !ok {
+			continue
+		}
+		for index, value := range model.a {
+			if index[0] < dimension && index[1] < dimension {
+				stats[i].A[index[0]*dimension+index[1]] = value
+			}
+		}
+		for index, value := range model.b {
+			if index < dimension {
+				stats[i].B[index] = value
+			}
+		}
+	}
+	return stats, nil
+}
+
+func (m *Memory) RegisterLinearSelect(_ context.Context, slotID, bannerID string, vector []float64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := m.hasBannerAttachedToSlot(slotID, bannerID); err != nil {
+		return err
+	}
+	model := m.getLinearModel(slotID, bannerID)
+	for i, xi := range vector {
+		if xi == 0 {
+			continue
+		}
+		for j, xj := range vector {
+			if xj != 0 {
+				model.a[[2]int{i, j}] += xi * xj
+			}
+		}
+	}
+	return nil
+}
+
+func (m *Memory) RegisterLinearClick(_ context.Context, slotID, bannerID string, vector []float64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := m.hasBannerAttachedToSlot(slotID, bannerID); err != nil {
+		return err
+	}
+	model := m.getLinearModel(slotID, bannerID)
+	for i, xi := range vector {
+		if xi != 0 {
+			model.b[i] += xi
+		}
+	}
+	return nil
+}
+
+// registerCounterKeys returns keys of the counters which are incremented by a select or a click.
+// The expired interval counters are removed once per stats interval.
+func (m *Memory) registerCounterKeys(slotID, socialGroupID string) []counterKey {
+	now := m.clock.Now()
+	start := now.Truncate(app.StatsInterval).Unix()
+	if m.cleanedUp != start {
+		expired := now.Add(-intervalTTL).Unix()
+		for key := range m.counters {
+			if key.start != 0 && key.start < expired {
+				delete(m.counters, key)
+			}
+		}
+		m.cleanedUp = start
+	}
+	return []counterKey{
+		{slotID: slotID, socialGroupID: socialGroupID},
+		{slotID: slotID},
+		{socialGroupID: socialGroupID},
+		{slotID: slotID, socialGroupID: socialGroupID, start: start},
+		{slotID: slotID, start: start},
+	}
+}
+
+func (m *Memory) getCounters(key counterKey) *counters {
+	c, ok := m.counters[key]
+	if !ok {
+		c = &counters{selects: make(map[string]int64), clicks: make(map[string]int64)}
+		m.counters[key] = c
+	}
+	return c
+}
+
+func (m *Memory) getLinearModel(slotID, bannerID string) *linearModel {
+	key := linearKey{slotID: slotID, bannerID: bannerID}
+	model, ok := m.linear[key]
+	if !ok {
+		model = &linearModel{a: make(map[[2]int]float64), b: make(map[int]float64)}
+		m.linear[key] = model
+	}
+	return model
+}
+
+// getSlotBannerIDs returns ids of the banners attached to a slot checking the slot and social group exist.
+func (m *Memory) getSlotBannerIDs(slotID, socialGroupID string) ([]string, error) {
+	if _, err := m.getSlot(slotID); err != nil {
+		return nil, err
+	}
+	if err := m.hasSocialGroup(socialGroupID); err != nil {
+		return nil, err
+	}
+	return m.getAttachedBannerIDs(slotID)
+}
+
+// getAttachedBannerIDs returns sorted ids of the banners attached to a slot.
+func (m *Memory) getAttachedBannerIDs(slotID string) ([]string, error) {
+	s, err := m.getSlot(slotID)
+	if err != nil {
+		return nil, err
+	}
+	if len(s.attachedAt) == 0 {
+		return nil, app.ErrNoBannersFound
+	}
+	bannerIDs := make([]string, 0, len(s.attachedAt))
+	for bannerID := range s.attachedAt {
+		bannerIDs = append(bannerIDs, bannerID)
+	}
+	sort.Strings(bannerIDs)
+	return bannerIDs, nil
+}
+
+func (m *Memory) getSlot(slotID string) (*slot, error) {
+	s, ok := m.slots[slotID]
+	if !ok {
+		return nil, app.NewErrNotFound(fmt.Sprintf("slot with id '%s' is not found", slotID))
+	}
+	return s, nil
+}
+
+func (m *Memory) hasBanner(bannerID string) error {
+	if _, ok := m.banners[bannerID]; !ok {
+		return app.NewErrNotFound(fmt.Sprintf("banner with id '%s' is not found", bannerID))
+	}
+	return nil
+}
+
+func (m *Memory) hasSocialGroup(socialGroupID string) error {
+	if _, ok := m.socialGroups[socialGroupID]; !ok {
+		return app.NewErrNotFound(fmt.Sprintf("socialGroup with id '%s' is not found", socialGroupID))
+	}
+	return nil
+}
+
+func (m *Memory) hasBannerAttachedToSlot(slotID, bannerID string) error {
+	s, err := m.getSlot(slotID)
+	if err != nil {
+		return err
+	}
+	if err := m.hasBanner(bannerID); err != nil {
+		return err
+	}
+	if _, ok := s.attachedAt[bannerID]; !ok {
+		return app.NewErrBannerNotAttached(slotID, bannerID)
+	}
+	return nil
+}
+
+func (m *Memory) checkRegister(slotID, bannerID, socialGroupID string) error {
+	if err := m.hasBannerAttachedToSlot(slotID, bannerID); err != nil {
+		return err
+	}
+	return m.hasSocialGroup(socialGroupID)
+}
+
+// subtract subtracts the other counters, the emptied counters of the banners are removed.
+func (c *counters) subtract(other *counters) {
+	if c == nil {
+		return
+	}
+	for bannerID, selects := range other.selects {
+		if c.selects[bannerID] -= selects; c.selects[bannerID] <= 0 {
+			delete(c.selects, bannerID)
+		}
+	}
+	for bannerID, clicks := range other.clicks {
+		if c.clicks[bannerID] -= clicks; c.clicks[bannerID] <= 0 {
+			delete(c.clicks, bannerID)
+		}
+	}
+	c.total -= other.total
+}
+
+func (c *counters) getSelects(bannerID string) int64 {
+	if c == nil {
+		return 0
+	}
+	return c.selects[bannerID]
+}
+
+func (c *counters) getClicks(bannerID string) int64 {
+	if c == nil {
+		return 0
+	}
+	return c.clicks[bannerID]
+}
+
+func (c *counters) getTotal() int64 {
+	if c == nil {
+		return 0
+	}
+	return c.total
+}
+
+// makeSlotStats makes the stats of the banners from the counters of a slot for a social group
+// and the pooled counters of a slot for all social groups.
+func makeSlotStats(bannerIDs []string, slotGroup, slot *counters) app.SlotStats {
+	stats := app.SlotStats{
+		Banners:            make([]app.BannerStats, len(bannerIDs)),
+		TotalSelects:       float64(slotGroup.getTotal()),
+		PooledTotalSelects: float64(slot.getTotal()),
+	}
+	for i, bannerID := range bannerIDs {
+		stats.Banners[i] = app.BannerStats{
+			BannerID:      bannerID,
+			Selects:       float64(slotGroup.getSelects(bannerID)),
+			Clicks:        float64(slotGroup.getClicks(bannerID)),
+			PooledSelects: float64(slot.getSelects(bannerID)),
+			PooledClicks:  float64(slot.getClicks(bannerID)),
+		}
+	}
+	return stats
+}
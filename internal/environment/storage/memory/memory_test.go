@@ -0,0 +1,47 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/ekhvalov/otus-banners-rotation/internal/app"
+	"github.com/ekhvalov/otus-banners-rotation/internal/environment/storage/storagetest"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestMemoryStorage(t *testing.T) {
+	suite.Run(t, storagetest.NewSuite(func(t *testing.T, deleteMode app.DeleteMode) app.Storage {
+		return NewMemory(&sequenceGenerator{}, deleteMode, app.NewSystemClock())
+	}))
+}
+
+func TestMemory_CreateBanner(t *testing.T) {
+	storage := NewMemory(&sequenceGenerator{}, app.DeleteModeCascade, app.NewSystemClock())
+
+	metadata := map[string]string{"campaign": "autumn"}
+	creative := app.Creative{Width: 300, Metadata: metadata}
+	id, err := storage.CreateBanner(context.Background(), "banner", creative, app.Flight{})
+	metadata["campaign"] = "winter"
+
+	require.NoError(t, err)
+	require.Equal(t, "1", id)
+	require.Equal(t, "banner", storage.banners[id])
+	require.Equal(t, app.Creative{Width: 300, Metadata: map[string]string{"campaign": "autumn"}}, storage.creatives[id],
+		"the metadata must not be shared with the caller")
+}
+
+// sequenceGenerator generates sequential ids.
+type sequenceGenerator struct {
+	mu sync.Mutex
+	id int
+}
+
+func (g *sequenceGenerator) GenerateID() string {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.id++
+	return fmt.Sprint(g.id)
+}
@@ -0,0 +1,149 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"github.com/ekhvalov/otus-banners-rotation/internal/app"
+)
+
+func (m *Memory) ExportSnapshot(_ context.Context) (app.Snapshot, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	var snapshot app.Snapshot
+	for id := range m.banners {
+		snapshot.Banners = append(snapshot.Banners, m.getBanner(id))
+	}
+	for id, s := range m.slots {
+		snapshot.Slots = append(snapshot.Slots, s.toSlot(id))
+		for bannerID, attachedAt := range s.attachedAt {
+			snapshot.Attachments = append(snapshot.Attachments,
+				app.Attachment{SlotID: id, BannerID: bannerID, AttachedAt: attachedAt})
+		}
+	}
+	for id, description := range m.socialGroups {
+		snapshot.SocialGroups = append(snapshot.SocialGroups, app.SocialGroup{ID: id, Description: description})
+	}
+	expired := m.clock.Now().Add(-intervalTTL).Unix()
+	for key, c := range m.counters {
+		// The pooled counters are the sums of the counters of a slot for the social groups.
+		if key.slotID == "" || key.socialGroupID == "" || (key.start != 0 && key.start < expired) {
+			continue
+		}
+		snapshot.Counters = append(snapshot.Counters, exportCounters(key, c)...)
+	}
+	for key, model := range m.linear {
+		exported := app.LinearModel{SlotID: key.slotID, BannerID: key.bannerID}
+		for index, value := range model.a {
+			exported.A = append(exported.A, app.LinearElement{I: index[0], J: index[1], Value: value})
+		}
+		for index, value := range model.b {
+			exported.B = append(exported.B, app.LinearElement{I: index, Value: value})
+		}
+		snapshot.LinearModels = append(snapshot.LinearModels, exported)
+	}
+	snapshot.Sort()
+	return snapshot, nil
+}
+
+func (m *Memory) ImportSnapshot(_ context.Context, snapshot app.Snapshot) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, banner := range snapshot.Banners {
+		m.banners[banner.ID] = banner.Description
+		m.creatives[banner.ID] = copyCreative(banner.Creative)
+		m.setFlight(banner.ID, banner.Flight)
+	}
+	for _, s := range snapshot.Slots {
+		attachedAt := make(map[string]time.Time)
+		if existing, ok := m.slots[s.ID]; ok {
+			attachedAt = existing.attachedAt
+		}
+		m.slots[s.ID] = &slot{
+			description: s.Description,
+			strategy:    copyStrategyParams(s.Strategy),
+			format:      copySlotFormat(s.Format),
+			attachedAt:  attachedAt,
+		}
+	}
+	for _, socialGroup := range snapshot.SocialGroups {
+		m.socialGroups[socialGroup.ID] = socialGroup.Description
+	}
+	now := time.Unix(m.clock.Now().Unix(), 0)
+	for _, attachment := range snapshot.Attachments {
+		s, ok := m.slots[attachment.SlotID]
+		if !ok {
+			continue
+		}
+		if _, ok = s.attachedAt[attachment.BannerID]; ok {
+			continue
+		}
+		s.attachedAt[attachment.BannerID] = now
+		if !attachment.AttachedAt.IsZero() {
+			s.attachedAt[attachment.BannerID] = time.Unix(attachment.AttachedAt.Unix(), 0)
+		}
+	}
+	expired := m.clock.Now().Add(-intervalTTL)
+	for _, counter := range snapshot.Counters {
+		var keys []counterKey
+		if counter.Start.IsZero() {
+			keys = []counterKey{
+				{slotID: counter.SlotID, socialGroupID: counter.SocialGroupID},
+				{slotID: counter.SlotID},
+				{socialGroupID: counter.SocialGroupID},
+			}
+		} else if !counter.Start.Before(expired) {
+			start := counter.Start.Unix()
+			keys = []counterKey{
+				{slotID: counter.SlotID, socialGroupID: counter.SocialGroupID, start: start},
+				{slotID: counter.SlotID, start: start},
+			}
+		}
+		for _, key := range keys {
+			c := m.getCounters(key)
+			c.selects[counter.BannerID] += counter.Selects
+			c.clicks[counter.BannerID] += counter.Clicks
+			c.total += counter.Selects
+		}
+	}
+	for _, exported := range snapshot.LinearModels {
+		model := m.getLinearModel(exported.SlotID, exported.BannerID)
+		for _, element := range exported.A {
+			model.a[[2]int{element.I, element.J}] += element.Value
+		}
+		for _, element := range exported.B {
+			model.b[element.I] += element.Value
+		}
+	}
+	return nil
+}
+
+// exportCounters returns the non-zero counters of the banners.
+func exportCounters(key counterKey, c *counters) []app.Counter {
+	var start time.Time
+	if key.start != 0 {
+		start = time.Unix(key.start, 0)
+	}
+	bannerIDs := make(map[string]struct{}, len(c.selects))
+	for bannerID := range c.selects {
+		bannerIDs[bannerID] = struct{}{}
+	}
+	for bannerID := range c.clicks {
+		bannerIDs[bannerID] = struct{}{}
+	}
+	var exported []app.Counter
+	for bannerID := range bannerIDs {
+		if c.selects[bannerID] == 0 && c.clicks[bannerID] == 0 {
+			continue
+		}
+		exported = append(exported, app.Counter{
+			SlotID:        key.slotID,
+			SocialGroupID: key.socialGroupID,
+			BannerID:      bannerID,
+			Start:         start,
+			Selects:       c.selects[bannerID],
+			Clicks:        c.clicks[bannerID],
+		})
+	}
+	return exported
+}
@@ -0,0 +1,55 @@
+package postgres
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/spf13/viper"
+)
+
+func NewConfig(v *viper.Viper) Config {
+	return Config{v: v}
+}
+
+type Config struct {
+	v *viper.Viper
+}
+
+func (c *Config) GetHost() string {
+	return c.v.GetString("postgres.host")
+}
+
+func (c *Config) GetPort() int {
+	return c.v.GetInt("postgres.port")
+}
+
+func (c *Config) GetUsername() string {
+	return c.v.GetString("postgres.username")
+}
+
+func (c *Config) GetPassword() string {
+	return c.v.GetString("postgres.password")
+}
+
+func (c *Config) GetDatabase() string {
+	return c.v.GetString("postgres.database")
+}
+
+// GetSSLMode returns the libpq sslmode, "disable" by default.
+func (c *Config) GetSSLMode() string {
+	if mode := c.v.GetString("postgres.sslmode"); mode != "" {
+		return mode
+	}
+	return "disable"
+}
+
+func (c *Config) GetDSN() string {
+	dsn := url.URL{
+		Scheme:   "postgres",
+		User:     url.UserPassword(c.GetUsername(), c.GetPassword()),
+		Host:     fmt.Sprintf("%s:%d", c.GetHost(), c.GetPort()),
+		Path:     c.GetDatabase(),
+		RawQuery: url.Values{"sslmode": []string{c.GetSSLMode()}}.Encode(),
+	}
+	return dsn.String()
+}
@@ -0,0 +1,235 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ekhvalov/otus-banners-rotation/internal/app"
+	"github.com/lib/pq"
+)
+
+// listCondition selects the rows of a ListFilter. The ids are compared in the "C" collation,
+// so the rows are ordered in the same way as the ids of the other storages.
+const listCondition = `WHERE id > $1 COLLATE "C" AND ($2::TEXT = '' OR strpos(description, $2) > 0)
+ORDER BY id COLLATE "C" LIMIT $3`
+
+func (p *Postgres) GetBanner(ctx context.Context, id string) (app.Banner, error) {
+	banner, err := scanBanner(p.db.QueryRowContext(ctx, "SELECT "+bannerColumns+" FROM banners WHERE id = $1", id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return app.Banner{}, app.NewErrNotFound(fmt.Sprintf("banner with id '%s' is not found", id))
+		}
+		return app.Banner{}, fmt.Errorf("select of banner '%s' error: %w", id, err)
+	}
+	return banner, nil
+}
+
+func (p *Postgres) GetSocialGroup(ctx context.Context, id string) (app.SocialGroup, error) {
+	socialGroup := app.SocialGroup{ID: id}
+	err := p.db.QueryRowContext(ctx, "SELECT description FROM social_groups WHERE id = $1", id).
+		Scan(&socialGroup.Description)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return app.SocialGroup{}, app.NewErrNotFound(fmt.Sprintf("socialGroup with id '%s' is not found", id))
+		}
+		return app.SocialGroup{}, fmt.Errorf("select of social group '%s' error: %w", id, err)
+	}
+	return socialGroup, nil
+}
+
+func (p *Postgres) UpdateBanner(ctx context.Context, id, description string) (app.Banner, error) {
+	row := p.db.QueryRowContext(ctx, "UPDATE banners SET description = $2 WHERE id = $1 RETURNING "+bannerColumns,
+		id, description)
+	banner, err := scanBanner(row)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return app.Banner{}, app.NewErrNotFound(fmt.Sprintf("banner with id '%s' is not found", id))
+		}
+		return app.Banner{}, fmt.Errorf("update of banner '%s' error: %w", id, err)
+	}
+	return banner, nil
+}
+
+func (p *Postgres) UpdateSlot(ctx context.Context, id, description string) (app.Slot, error) {
+	row := p.db.QueryRowContext(ctx, "UPDATE slots SET description = $2 WHERE id = $1 RETURNING "+slotColumns,
+		id, description)
+	slot, err := scanSlot(row)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return app.Slot{}, app.NewErrNotFound(fmt.Sprintf("slot with id '%s' is not found", id))
+		}
+		return app.Slot{}, fmt.Errorf("update of slot '%s' error: %w", id, err)
+	}
+	return slot, nil
+}
+
+func (p *Postgres) UpdateSocialGroup(ctx context.Context, id, description string) (app.SocialGroup, error) {
+	err := updateDescription(ctx, p.db, "social_groups", id, description)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return app.SocialGroup{}, app.NewErrNotFound(fmt.Sprintf("socialGroup with id '%s' is not found", id))
+		}
+		return app.SocialGroup{}, err
+	}
+	return app.SocialGroup{ID: id, Description: description}, nil
+}
+
+func (p *Postgres) ListBanners(ctx context.Context, filter app.ListFilter) ([]app.Banner, error) {
+	var banners []app.Banner
+	err := selectRows(ctx, p.db, "SELECT "+bannerColumns+" FROM banners "+listCondition, func(rows *sql.Rows) error {
+		banner, err := scanBanner(rows)
+		if err != nil {
+			return err
+		}
+		banners = append(banners, banner)
+		return nil
+	}, listArgs(filter)...)
+	if err != nil {
+		return nil, err
+	}
+	return banners, nil
+}
+
+func (p *Postgres) ListSlots(ctx context.Context, filter app.ListFilter) ([]app.Slot, error) {
+	var slots []app.Slot
+	err := selectRows(ctx, p.db, "SELECT "+slotColumns+" FROM slots "+listCondition, func(rows *sql.Rows) error {
+		slot, err := scanSlot(rows)
+		if err != nil {
+			return err
+		}
+		slots = append(slots, slot)
+		return nil
+	}, listArgs(filter)...)
+	if err != nil {
+		return nil, err
+	}
+	return slots, nil
+}
+
+func (p *Postgres) ListSocialGroups(ctx context.Context, filter app.ListFilter) ([]app.SocialGroup, error) {
+	var socialGroups []app.SocialGroup
+	query := "SELECT id, description FROM social_groups " + listCondition
+	err := selectRows(ctx, p.db, query, func(rows *sql.Rows) error {
+		var socialGroup app.SocialGroup
+		if err := rows.Scan(&socialGroup.ID, &socialGroup.Description); err != nil {
+			return err
+		}
+		socialGroups = append(socialGroups, socialGroup)
+		return nil
+	}, listArgs(filter)...)
+	if err != nil {
+		return nil, err
+	}
+	return socialGroups, nil
+}
+
+func (p *Postgres) ListSlotBanners(
+	ctx context.Context,
+	slotID string,
+	filter app.ListFilter,
+) ([]app.Attachment, error) {
+	if err := hasSlot(ctx, p.db, slotID); err != nil {
+		return nil, err
+	}
+	query := "SELECT " + attachmentColumns + ` FROM slot_banners WHERE slot_id = $1 AND banner_id > $2 COLLATE "C"
+ORDER BY banner_id COLLATE "C" LIMIT $3`
+	return selectAttachments(ctx, p.db, query, slotID, filter)
+}
+
+func (p *Postgres) ListBannerSlots(
+	ctx context.Context,
+	bannerID string,
+	filter app.ListFilter,
+) ([]app.Attachment, error) {
+	if err := hasBanner(ctx, p.db, bannerID); err != nil {
+		return nil, err
+	}
+	query := "SELECT " + attachmentColumns + ` FROM slot_banners WHERE banner_id = $1 AND slot_id > $2 COLLATE "C"
+ORDER BY slot_id COLLATE "C" LIMIT $3`
+	return selectAttachments(ctx, p.db, query, bannerID, filter)
+}
+
+func (p *Postgres) GetBannerFlights(ctx context.Context, bannerIDs []string) (map[string]app.Flight, error) {
+	var flights map[string]app.Flight
+	query := `SELECT id, flight_start, flight_end FROM banners
+WHERE id = ANY($1) AND (flight_start IS NOT NULL OR flight_end IS NOT NULL)`
+	err := selectRows(ctx, p.db, query, func(rows *sql.Rows) error {
+		var id string
+		var start, end sql.NullTime
+		if err := rows.Scan(&id, &start, &end); err != nil {
+			return err
+		}
+		if flights == nil {
+			flights = make(map[string]app.Flight)
+		}
+		flights[id] = makeFlight(start, end)
+		return nil
+	}, pq.Array(bannerIDs))
+	if err != nil {
+		return nil, err
+	}
+	return flights, nil
+}
+
+// attachmentColumns are the columns of the slot_banners scanned by the scanAttachment.
+const attachmentColumns = "slot_id, banner_id, attached_at"
+
+func scanAttachment(row rowScanner) (app.Attachment, error) {
+	var attachment app.Attachment
+	if err := row.Scan(&attachment.SlotID, &attachment.BannerID, &attachment.AttachedAt); err != nil {
+		return app.Attachment{}, err
+	}
+	attachment.AttachedAt = time.Unix(attachment.AttachedAt.Unix(), 0)
+	return attachment, nil
+}
+
+// selectAttachments selects the attachments by the query which arguments are an id, the filter.AfterID
+// and the filter.Limit.
+func selectAttachments(
+	ctx context.Context,
+	q queryer,
+	query, id string,
+	filter app.ListFilter,
+) ([]app.Attachment, error) {
+	var attachments []app.Attachment
+	limit := sql.NullInt64{Int64: int64(filter.Limit), Valid: filter.Limit > 0}
+	err := selectRows(ctx, q, query, func(rows *sql.Rows) error {
+		attachment, err := scanAttachment(rows)
+		if err != nil {
+			return err
+		}
+		attachments = append(attachments, attachment)
+		return nil
+	}, id, filter.AfterID, limit)
+	if err != nil {
+		return nil, err
+	}
+	return attachments, nil
+}
+
+// listArgs returns the arguments of the listCondition, a zero limit is passed as NULL which means no limit.
+func listArgs(filter app.ListFilter) []interface{} {
+	limit := sql.NullInt64{Int64: int64(filter.Limit), Valid: filter.Limit > 0}
+	return []interface{}{filter.AfterID, filter.DescriptionContains, limit}
+}
+
+// updateDescription changes the description of a row of the table. Returns sql.ErrNoRows in case of the row
+// is not found.
+func updateDescription(ctx context.Context, q queryer, table, id, description string) error {
+	query := fmt.Sprintf("UPDATE %s SET description = $2 WHERE id = $1", table)
+	result, err := q.ExecContext(ctx, query, id, description)
+	if err != nil {
+		return fmt.Errorf("update of %s '%s' error: %w", table, id, err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("update of %s '%s' rows affected error: %w", table, id, err)
+	}
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
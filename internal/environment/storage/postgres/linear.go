@@ -0,0 +1,129 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ekhvalov/otus-banners-rotation/internal/app"
+	"github.com/lib/pq"
+)
+
+func (p *Postgres) GetLinearStats(ctx context.Context, slotID string, dimension int) ([]app.LinearStats, error) {
+	if err := hasSlot(ctx, p.db, slotID); err != nil {
+		return nil, err
+	}
+	bannerIDs, err := getAttachedBannerIDs(ctx, p.db, slotID)
+	if err != nil {
+		return nil, err
+	}
+	stats := make([]app.LinearStats, len(bannerIDs))
+	indexes := make(map[string]int, len(bannerIDs))
+	for i, bannerID := range bannerIDs {
+		stats[i] = app.LinearStats{
+			BannerID: bannerID,
+			A:        make([]float64, dimension*dimension),
+			B:        make([]float64, dimension),
+		}
+		indexes[bannerID] = i
+	}
+	// The elements out of the dimension are ignored.
+	rows, err := p.db.QueryContext(ctx, `SELECT banner_id, i, j, value
+FROM linear_stats_a
+WHERE slot_id = $1
+  AND i < $2
+  AND j < $2`, slotID, dimension)
+	if err != nil {
+		return nil, fmt.Errorf("linear stats of slot '%s' error: %w", slotID, err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var bannerID string
+		var i, j int
+		var value float64
+		if err = rows.Scan(&bannerID, &i, &j, &value); err != nil {
+			return nil, fmt.Errorf("linear stats of slot '%s' scan error: %w", slotID, err)
+		}
+		if k, ok := indexes[bannerID]; ok {
+			stats[k].A[i*dimension+j] = value
+		}
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("linear stats of slot '%s' rows error: %w", slotID, err)
+	}
+	rows, err = p.db.QueryContext(ctx, `SELECT banner_id, i, value
+FROM linear_stats_b
+WHERE slot_id = $1
+  AND i < $2`, slotID, dimension)
+	if err != nil {
+		return nil, fmt.Errorf("linear stats of slot '%s' error: %w", slotID, err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var bannerID string
+		var i int
+		var value float64
+		if err = rows.Scan(&bannerID, &i, &value); err != nil {
+			return nil, fmt.Errorf("linear stats of slot '%s' scan error: %w", slotID, err)
+		}
+		if k, ok := indexes[bannerID]; ok {
+			stats[k].B[i] = value
+		}
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("linear stats of slot '%s' rows error: %w", slotID, err)
+	}
+	return stats, nil
+}
+
+func (p *Postgres) RegisterLinearSelect(ctx context.Context, slotID, bannerID string, vector []float64) error {
+	var is, js []int64
+	var values []float64
+	for i, xi := range vector {
+		if xi == 0 {
+			continue
+		}
+		for j, xj := range vector {
+			if xj != 0 {
+				is, js, values = append(is, int64(i)), append(js, int64(j)), append(values, xi*xj)
+			}
+		}
+	}
+	return p.inTx(ctx, func(tx queryer) error {
+		if err := hasBannerAttachedToSlot(ctx, tx, slotID, bannerID); err != nil {
+			return err
+		}
+		_, err := tx.ExecContext(ctx, `INSERT INTO linear_stats_a (slot_id, banner_id, i, j, value)
+SELECT $1::TEXT, $2::TEXT, t.i, t.j, t.value
+FROM unnest($3::INTEGER[], $4::INTEGER[], $5::DOUBLE PRECISION[]) AS t(i, j, value)
+ON CONFLICT (slot_id, banner_id, i, j) DO UPDATE SET value = linear_stats_a.value + excluded.value`,
+			slotID, bannerID, pq.Array(is), pq.Array(js), pq.Array(values))
+		if err != nil {
+			return fmt.Errorf("linear select of slot '%s' banner '%s' error: %w", slotID, bannerID, err)
+		}
+		return nil
+	})
+}
+
+func (p *Postgres) RegisterLinearClick(ctx context.Context, slotID, bannerID string, vector []float64) error {
+	var is []int64
+	var values []float64
+	for i, xi := range vector {
+		if xi != 0 {
+			is, values = append(is, int64(i)), append(values, xi)
+		}
+	}
+	return p.inTx(ctx, func(tx queryer) error {
+		if err := hasBannerAttachedToSlot(ctx, tx, slotID, bannerID); err != nil {
+			return err
+		}
+		_, err := tx.ExecContext(ctx, `INSERT INTO linear_stats_b (slot_id, banner_id, i, value)
+SELECT $1::TEXT, $2::TEXT, t.i, t.value
+FROM unnest($3::INTEGER[], $4::DOUBLE PRECISION[]) AS t(i, value)
+ON CONFLICT (slot_id, banner_id, i) DO UPDATE SET value = linear_stats_b.value + excluded.value`,
+			slotID, bannerID, pq.Array(is), pq.Array(values))
+		if err != nil {
+			return fmt.Errorf("linear click of slot '%s' banner '%s' error: %w", slotID, bannerID, err)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,72 @@
+package postgres
+
+import (
+	"context"
+	"embed"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+)
+
+// migrationsLockID is a key of the advisory lock which prevents concurrent migrations.
+const migrationsLockID = 7140461
+
+//go:embed migrations/*.sql
+var migrations embed.FS
+
+// Migrate applies the embedded migrations which are not applied yet in a single transaction.
+// Returns the versions of the applied migrations.
+func (p *Postgres) Migrate(ctx context.Context) (applied []string, err error) {
+	entries, err := migrations.ReadDir("migrations")
+	if err != nil {
+		return nil, fmt.Errorf("read migrations error: %w", err)
+	}
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+	sort.Strings(names)
+
+	err = p.inTx(ctx, func(tx queryer) error {
+		if _, err := tx.ExecContext(ctx, "SELECT pg_advisory_xact_lock($1)", migrationsLockID); err != nil {
+			return fmt.Errorf("migrations lock error: %w", err)
+		}
+		_, err := tx.ExecContext(ctx, `CREATE TABLE IF NOT EXISTS schema_migrations
+(
+    version    TEXT PRIMARY KEY,
+    applied_at TIMESTAMPTZ NOT NULL DEFAULT now()
+)`)
+		if err != nil {
+			return fmt.Errorf("create schema_migrations error: %w", err)
+		}
+		for _, name := range names {
+			version := strings.TrimSuffix(name, path.Ext(name))
+			var exists bool
+			err := tx.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM schema_migrations WHERE version = $1)", version).
+				Scan(&exists)
+			if err != nil {
+				return fmt.Errorf("migration '%s' check error: %w", version, err)
+			}
+			if exists {
+				continue
+			}
+			query, err := migrations.ReadFile(path.Join("migrations", name))
+			if err != nil {
+				return fmt.Errorf("migration '%s' read error: %w", version, err)
+			}
+			if _, err = tx.ExecContext(ctx, string(query)); err != nil {
+				return fmt.Errorf("migration '%s' error: %w", version, err)
+			}
+			if _, err = tx.ExecContext(ctx, "INSERT INTO schema_migrations (version) VALUES ($1)", version); err != nil {
+				return fmt.Errorf("migration '%s' register error: %w", version, err)
+			}
+			applied = append(applied, version)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return applied, nil
+}
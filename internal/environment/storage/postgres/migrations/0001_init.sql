@@ -0,0 +1,84 @@
+CREATE TABLE banners
+(
+    id          TEXT PRIMARY KEY,
+    description TEXT NOT NULL
+);
+
+CREATE TABLE slots
+(
+    id                           TEXT PRIMARY KEY,
+    description                  TEXT             NOT NULL,
+    strategy_name                TEXT             NOT NULL DEFAULT '',
+    strategy_ucb_exploration     DOUBLE PRECISION NOT NULL DEFAULT 0,
+    strategy_epsilon             DOUBLE PRECISION NOT NULL DEFAULT 0,
+    strategy_epsilon_decay       DOUBLE PRECISION NOT NULL DEFAULT 0,
+    strategy_alpha               DOUBLE PRECISION NOT NULL DEFAULT 0,
+    strategy_beta                DOUBLE PRECISION NOT NULL DEFAULT 0,
+    strategy_window_seconds      BIGINT           NOT NULL DEFAULT 0,
+    strategy_discount            DOUBLE PRECISION NOT NULL DEFAULT 0,
+    strategy_prior               TEXT             NOT NULL DEFAULT '',
+    strategy_prior_selects       DOUBLE PRECISION NOT NULL DEFAULT 0,
+    strategy_prior_clicks        DOUBLE PRECISION NOT NULL DEFAULT 0,
+    strategy_pooling             TEXT             NOT NULL DEFAULT '',
+    strategy_pooling_strength    DOUBLE PRECISION NOT NULL DEFAULT 0,
+    strategy_pooling_min_selects DOUBLE PRECISION NOT NULL DEFAULT 0
+);
+
+CREATE TABLE social_groups
+(
+    id          TEXT PRIMARY KEY,
+    description TEXT NOT NULL
+);
+
+CREATE TABLE slot_banners
+(
+    slot_id     TEXT        NOT NULL,
+    banner_id   TEXT        NOT NULL,
+    attached_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (slot_id, banner_id)
+);
+
+-- The counters are deleted with the slots, banners and social groups in the cascade delete mode.
+CREATE TABLE banner_stats
+(
+    slot_id         TEXT   NOT NULL,
+    social_group_id TEXT   NOT NULL,
+    banner_id       TEXT   NOT NULL,
+    selects         BIGINT NOT NULL DEFAULT 0,
+    clicks          BIGINT NOT NULL DEFAULT 0,
+    PRIMARY KEY (slot_id, social_group_id, banner_id)
+);
+
+CREATE INDEX banner_stats_social_group_id_idx ON banner_stats (social_group_id, banner_id);
+
+CREATE TABLE banner_interval_stats
+(
+    slot_id         TEXT        NOT NULL,
+    social_group_id TEXT        NOT NULL,
+    banner_id       TEXT        NOT NULL,
+    interval_start  TIMESTAMPTZ NOT NULL,
+    selects         BIGINT      NOT NULL DEFAULT 0,
+    clicks          BIGINT      NOT NULL DEFAULT 0,
+    PRIMARY KEY (slot_id, interval_start, social_group_id, banner_id)
+);
+
+CREATE INDEX banner_interval_stats_interval_start_idx ON banner_interval_stats (interval_start);
+
+CREATE TABLE linear_stats_a
+(
+    slot_id   TEXT             NOT NULL,
+    banner_id TEXT             NOT NULL,
+    i         INTEGER          NOT NULL,
+    j         INTEGER          NOT NULL,
+    value     DOUBLE PRECISION NOT NULL,
+    PRIMARY KEY (slot_id, banner_id, i, j)
+);
+
+CREATE TABLE linear_stats_b
+(
+    slot_id   TEXT             NOT NULL,
+    banner_id TEXT             NOT NULL,
+    i         INTEGER          NOT NULL,
+    value     DOUBLE PRECISION NOT NULL,
+    PRIMARY KEY (slot_id, banner_id, i)
+);
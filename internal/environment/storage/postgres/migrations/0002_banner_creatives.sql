@@ -0,0 +1,7 @@
+ALTER TABLE banners
+    ADD COLUMN creative_target_url TEXT    NOT NULL DEFAULT '',
+    ADD COLUMN creative_image_url  TEXT    NOT NULL DEFAULT '',
+    ADD COLUMN creative_width      INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN creative_height     INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN creative_mime_type  TEXT    NOT NULL DEFAULT '',
+    ADD COLUMN creative_metadata   JSONB   NOT NULL DEFAULT '{}';
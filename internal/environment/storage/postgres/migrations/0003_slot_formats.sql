@@ -0,0 +1,3 @@
+ALTER TABLE slots
+    ADD COLUMN format_sizes      JSONB NOT NULL DEFAULT '[]',
+    ADD COLUMN format_mime_types JSONB NOT NULL DEFAULT '[]';
@@ -0,0 +1,3 @@
+ALTER TABLE banners
+    ADD COLUMN flight_start TIMESTAMPTZ NULL,
+    ADD COLUMN flight_end   TIMESTAMPTZ NULL;
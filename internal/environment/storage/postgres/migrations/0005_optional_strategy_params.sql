@@ -0,0 +1,8 @@
+ALTER TABLE slots
+    ALTER COLUMN strategy_ucb_exploration DROP NOT NULL,
+    ALTER COLUMN strategy_ucb_exploration DROP DEFAULT,
+    ALTER COLUMN strategy_epsilon DROP NOT NULL,
+    ALTER COLUMN strategy_epsilon DROP DEFAULT;
+
+UPDATE slots SET strategy_ucb_exploration = NULL WHERE strategy_ucb_exploration = 0;
+UPDATE slots SET strategy_epsilon = NULL WHERE strategy_epsilon = 0;
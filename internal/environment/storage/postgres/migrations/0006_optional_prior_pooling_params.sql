@@ -0,0 +1,14 @@
+ALTER TABLE slots
+    ALTER COLUMN strategy_prior_selects DROP NOT NULL,
+    ALTER COLUMN strategy_prior_selects DROP DEFAULT,
+    ALTER COLUMN strategy_prior_clicks DROP NOT NULL,
+    ALTER COLUMN strategy_prior_clicks DROP DEFAULT,
+    ALTER COLUMN strategy_pooling_strength DROP NOT NULL,
+    ALTER COLUMN strategy_pooling_strength DROP DEFAULT,
+    ALTER COLUMN strategy_pooling_min_selects DROP NOT NULL,
+    ALTER COLUMN strategy_pooling_min_selects DROP DEFAULT;
+
+UPDATE slots SET strategy_prior_selects = NULL WHERE strategy_prior_selects = 0;
+UPDATE slots SET strategy_prior_clicks = NULL WHERE strategy_prior_clicks = 0;
+UPDATE slots SET strategy_pooling_strength = NULL WHERE strategy_pooling_strength = 0;
+UPDATE slots SET strategy_pooling_min_selects = NULL WHERE strategy_pooling_min_selects = 0;
@@ -0,0 +1,669 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ekhvalov/otus-banners-rotation/internal/app"
+	_ "github.com/lib/pq" // The postgres driver.
+)
+
+// intervalTTL is a lifetime of the counters of a stats interval.
+const intervalTTL = app.MaxStatsHistory + app.StatsInterval
+
+type IDGenerator interface {
+	GenerateID() string
+}
+
+// NewPostgres creates a storage on the PostgreSQL database. The schema is created by Migrate.
+// The clock is used for the attach times and the stats intervals.
+func NewPostgres(
+	config Config, idGenerator IDGenerator, deleteMode app.DeleteMode, clock app.Clock,
+) (*Postgres, error) {
+	db, err := sql.Open("postgres", config.GetDSN())
+	if err != nil {
+		return nil, fmt.Errorf("open postgres error: %w", err)
+	}
+	return &Postgres{db: db, idGenerator: idGenerator, deleteMode: deleteMode, clock: clock}, nil
+}
+
+type Postgres struct {
+	db          *sql.DB
+	idGenerator IDGenerator
+	deleteMode  app.DeleteMode
+	clock       app.Clock
+	// cleanedUp is a start of the stats interval in which the expired counters were removed last time.
+	cleanedUp int64
+	// cleanUpMu allows a single removal of the expired counters at a time.
+	cleanUpMu sync.Mutex
+}
+
+// bannerColumns are the columns of a banner scanned by scanBanner.
+const bannerColumns = `id, description, creative_target_url, creative_image_url, creative_width, creative_height,
+    creative_mime_type, creative_metadata, flight_start, flight_end`
+
+// bannerValues are the placeholders of the bannerColumns.
+const bannerValues = "$1, $2, $3, $4, $5, $6, $7, $8, $9, $10"
+
+// slotColumns are the columns of a slot scanned by scanSlot.
+const slotColumns = `id, description, strategy_name, strategy_ucb_exploration, strategy_epsilon,
+    strategy_epsilon_decay, strategy_alpha, strategy_beta, strategy_window_seconds, strategy_discount, strategy_prior,
+    strategy_prior_selects, strategy_prior_clicks, strategy_pooling, strategy_pooling_strength,
+    strategy_pooling_min_selects, format_sizes, format_mime_types`
+
+// slotValues are the placeholders of the slotColumns.
+const slotValues = "$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18"
+
+// queryer is implemented by *sql.DB and *sql.Tx.
+type queryer interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+func (p *Postgres) Close() error {
+	return p.db.Close()
+}
+
+func (p *Postgres) CreateBanner(
+	ctx context.Context, description string, creative app.Creative, flight app.Flight,
+) (id string, err error) {
+	id = p.idGenerator.GenerateID()
+	args, err := bannerArgs(app.Banner{ID: id, Description: description, Creative: creative, Flight: flight})
+	if err != nil {
+		return "", err
+	}
+	_, err = p.db.ExecContext(ctx, "INSERT INTO banners ("+bannerColumns+") VALUES ("+bannerValues+")", args...)
+	if err != nil {
+		return "", fmt.Errorf("insert of banner '%s' error: %w", id, err)
+	}
+	return id, nil
+}
+
+func (p *Postgres) DeleteBanner(ctx context.Context, id string) error {
+	return p.inTx(ctx, func(tx queryer) error {
+		if p.deleteMode == app.DeleteModeRestrict {
+			err := checkNotReferenced(ctx, tx, "SELECT slot_id FROM slot_banners WHERE banner_id = $1", id,
+				"banner with id '%s' is attached to slot '%s'")
+			if err != nil {
+				return err
+			}
+		}
+		return deleteRows(ctx, tx, id, "DELETE FROM banners WHERE id = $1",
+			"DELETE FROM slot_banners WHERE banner_id = $1",
+			"DELETE FROM banner_stats WHERE banner_id = $1",
+			"DELETE FROM banner_interval_stats WHERE banner_id = $1",
+			"DELETE FROM linear_stats_a WHERE banner_id = $1",
+			"DELETE FROM linear_stats_b WHERE banner_id = $1",
+		)
+	})
+}
+
+func (p *Postgres) CreateSlot(
+	ctx context.Context,
+	description string,
+	strategy app.StrategyParams,
+	format app.SlotFormat,
+) (id string, err error) {
+	id = p.idGenerator.GenerateID()
+	args, err := slotArgs(app.Slot{ID: id, Description: description, Strategy: strategy, Format: format})
+	if err != nil {
+		return "", err
+	}
+	_, err = p.db.ExecContext(ctx, "INSERT INTO slots ("+slotColumns+") VALUES ("+slotValues+")", args...)
+	if err != nil {
+		return "", fmt.Errorf("insert of slot '%s' error: %w", id, err)
+	}
+	return id, nil
+}
+
+func (p *Postgres) GetSlot(ctx context.Context, id string) (app.Slot, error) {
+	row := p.db.QueryRowContext(ctx, "SELECT "+slotColumns+" FROM slots WHERE id = $1", id)
+	slot, err := scanSlot(row)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return app.Slot{}, app.NewErrNotFound(fmt.Sprintf("slot with id '%s' is not found", id))
+		}
+		return app.Slot{}, fmt.Errorf("select of slot '%s' error: %w", id, err)
+	}
+	return slot, nil
+}
+
+func (p *Postgres) DeleteSlot(ctx context.Context, id string) error {
+	return p.inTx(ctx, func(tx queryer) error {
+		if p.deleteMode == app.DeleteModeRestrict {
+			err := checkNotReferenced(ctx, tx, "SELECT banner_id FROM slot_banners WHERE slot_id = $1", id,
+				"slot with id '%s' has attached banner '%s'")
+			if err != nil {
+				return err
+			}
+		}
+		return deleteRows(ctx, tx, id, "DELETE FROM slots WHERE id = $1",
+			"DELETE FROM slot_banners WHERE slot_id = $1",
+			"DELETE FROM banner_stats WHERE slot_id = $1",
+			"DELETE FROM banner_interval_stats WHERE slot_id = $1",
+			"DELETE FROM linear_stats_a WHERE slot_id = $1",
+			"DELETE FROM linear_stats_b WHERE slot_id = $1",
+		)
+	})
+}
+
+func (p *Postgres) CreateSocialGroup(ctx context.Context, description string) (id string, err error) {
+	id = p.idGenerator.GenerateID()
+	_, err = p.db.ExecContext(ctx, "INSERT INTO social_groups (id, description) VALUES ($1, $2)", id, description)
+	if err != nil {
+		return "", fmt.Errorf("insert of social group '%s' error: %w", id, err)
+	}
+	return id, nil
+}
+
+func (p *Postgres) DeleteSocialGroup(ctx context.Context, id string) error {
+	return p.inTx(ctx, func(tx queryer) error {
+		if p.deleteMode == app.DeleteModeRestrict {
+			err := checkNotReferenced(ctx, tx, "SELECT slot_id FROM banner_stats WHERE social_group_id = $1", id,
+				"socialGroup with id '%s' has registered counters in slot '%s'")
+			if err != nil {
+				return err
+			}
+		}
+		return deleteRows(ctx, tx, id, "DELETE FROM social_groups WHERE id = $1",
+			"DELETE FROM banner_stats WHERE social_group_id = $1",
+			"DELETE FROM banner_interval_stats WHERE social_group_id = $1",
+		)
+	})
+}
+
+func (p *Postgres) AttachBanner(ctx context.Context, slotID, bannerID string) error {
+	return p.inTx(ctx, func(tx queryer) error {
+		if err := hasBanner(ctx, tx, bannerID); err != nil {
+			return err
+		}
+		if err := hasSlot(ctx, tx, slotID); err != nil {
+			return err
+		}
+		_, err := tx.ExecContext(ctx, `INSERT INTO slot_banners (slot_id, banner_id, attached_at) VALUES ($1, $2, $3)
+ON CONFLICT (slot_id, banner_id) DO NOTHING`, slotID, bannerID, p.clock.Now())
+		if err != nil {
+			return fmt.Errorf("insert of slot '%s' banner '%s' error: %w", slotID, bannerID, err)
+		}
+		return nil
+	})
+}
+
+func (p *Postgres) DetachBanner(ctx context.Context, slotID, bannerID string) error {
+	return p.inTx(ctx, func(tx queryer) error {
+		if err := hasBannerAttachedToSlot(ctx, tx, slotID, bannerID); err != nil {
+			return err
+		}
+		_, err := tx.ExecContext(ctx, "DELETE FROM slot_banners WHERE slot_id = $1 AND banner_id = $2", slotID, bannerID)
+		if err != nil {
+			return fmt.Errorf("delete of slot '%s' banner '%s' error: %w", slotID, bannerID, err)
+		}
+		return nil
+	})
+}
+
+func (p *Postgres) GetSlotStats(ctx context.Context, slotID, socialGroupID string) (app.SlotStats, error) {
+	if err := p.checkSlotSocialGroup(ctx, slotID, socialGroupID); err != nil {
+		return app.SlotStats{}, err
+	}
+	rows, err := p.db.QueryContext(ctx, `SELECT sb.banner_id,
+       COALESCE(sg.selects, 0), COALESCE(sg.clicks, 0),
+       COALESCE(s.selects, 0), COALESCE(s.clicks, 0),
+       COALESCE(g.selects, 0), COALESCE(g.clicks, 0)
+FROM slot_banners sb
+         LEFT JOIN banner_stats sg
+                   ON sg.slot_id = sb.slot_id AND sg.social_group_id = $2 AND sg.banner_id = sb.banner_id
+         LEFT JOIN (SELECT banner_id, SUM(selects)::BIGINT AS selects, SUM(clicks)::BIGINT AS clicks
+                    FROM banner_stats
+                    WHERE slot_id = $1
+                    GROUP BY banner_id) s ON s.banner_id = sb.banner_id
+         LEFT JOIN (SELECT banner_id, SUM(selects)::BIGINT AS selects, SUM(clicks)::BIGINT AS clicks
+                    FROM banner_stats
+                    WHERE social_group_id = $2
+                      AND slot_id <> $1
+                    GROUP BY banner_id) g ON g.banner_id = sb.banner_id
+WHERE sb.slot_id = $1
+ORDER BY sb.banner_id`, slotID, socialGroupID)
+	if err != nil {
+		return app.SlotStats{}, fmt.Errorf("stats of slot '%s' social group '%s' error: %w", slotID, socialGroupID, err)
+	}
+	defer rows.Close()
+	var stats app.SlotStats
+	for rows.Next() {
+		var banner app.BannerStats
+		var selects, clicks, pooledSelects, pooledClicks, otherSelects, otherClicks int64
+		err = rows.Scan(&banner.BannerID, &selects, &clicks, &pooledSelects, &pooledClicks, &otherSelects, &otherClicks)
+		if err != nil {
+			return app.SlotStats{}, fmt.Errorf("stats of slot '%s' scan error: %w", slotID, err)
+		}
+		banner.Selects, banner.Clicks = float64(selects), float64(clicks)
+		banner.PooledSelects, banner.PooledClicks = float64(pooledSelects), float64(pooledClicks)
+		banner.OtherSelects, banner.OtherClicks = float64(otherSelects), float64(otherClicks)
+		stats.Banners = append(stats.Banners, banner)
+	}
+	if err = rows.Err(); err != nil {
+		return app.SlotStats{}, fmt.Errorf("stats of slot '%s' rows error: %w", slotID, err)
+	}
+	if len(stats.Banners) == 0 {
+		return app.SlotStats{}, app.ErrNoBannersFound
+	}
+	var total, pooledTotal int64
+	err = p.db.QueryRowContext(ctx, `SELECT COALESCE(SUM(selects) FILTER (WHERE social_group_id = $2), 0)::BIGINT,
+       COALESCE(SUM(selects), 0)::BIGINT
+FROM banner_stats
+WHERE slot_id = $1`, slotID, socialGroupID).Scan(&total, &pooledTotal)
+	if err != nil {
+		return app.SlotStats{}, fmt.Errorf("total selects of slot '%s' error: %w", slotID, err)
+	}
+	stats.TotalSelects, stats.PooledTotalSelects = float64(total), float64(pooledTotal)
+	return stats, nil
+}
+
+func (p *Postgres) GetSlotStatsHistory(
+	ctx context.Context,
+	slotID, socialGroupID string,
+	since time.Time,
+) ([]app.IntervalStats, error) {
+	now := p.clock.Now()
+	since, err := app.StatsHistorySince(since, now)
+	if err != nil {
+		return nil, err
+	}
+	if err = p.checkSlotSocialGroup(ctx, slotID, socialGroupID); err != nil {
+		return nil, err
+	}
+	bannerIDs, err := getAttachedBannerIDs(ctx, p.db, slotID)
+	if err != nil {
+		return nil, err
+	}
+	indexes := make(map[string]int, len(bannerIDs))
+	for i, bannerID := range bannerIDs {
+		indexes[bannerID] = i
+	}
+	var history []app.IntervalStats
+	intervals := make(map[int64]*app.IntervalStats)
+	start := since.Truncate(app.StatsInterval)
+	end := now.Truncate(app.StatsInterval)
+	for ; !start.After(end); start = start.Add(app.StatsInterval) {
+		history = append(history, app.IntervalStats{Start: start, Stats: makeEmptySlotStats(bannerIDs)})
+	}
+	for i := range history {
+		intervals[history[i].Start.Unix()] = &history[i]
+	}
+
+	rows, err := p.db.QueryContext(ctx, `SELECT interval_start, banner_id,
+       COALESCE(SUM(selects) FILTER (WHERE social_group_id = $2), 0)::BIGINT,
+       COALESCE(SUM(clicks) FILTER (WHERE social_group_id = $2), 0)::BIGINT,
+       SUM(selects)::BIGINT,
+       SUM(clicks)::BIGINT
+FROM banner_interval_stats
+WHERE slot_id = $1
+  AND interval_start >= $3
+GROUP BY interval_start, banner_id`, slotID, socialGroupID, since.Truncate(app.StatsInterval))
+	if err != nil {
+		return nil, fmt.Errorf("stats history of slot '%s' social group '%s' error: %w", slotID, socialGroupID, err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var intervalStart time.Time
+		var bannerID string
+		var selects, clicks, pooledSelects, pooledClicks int64
+		if err = rows.Scan(&intervalStart, &bannerID, &selects, &clicks, &pooledSelects, &pooledClicks); err != nil {
+			return nil, fmt.Errorf("stats history of slot '%s' scan error: %w", slotID, err)
+		}
+		interval, ok := intervals[intervalStart.Unix()]
+		if !ok {
+			continue
+		}
+		// The totals contain the selects of the detached banners like the all-time totals.
+		interval.Stats.TotalSelects += float64(selects)
+		interval.Stats.PooledTotalSelects += float64(pooledSelects)
+		if i, ok := indexes[bannerID]; ok {
+			banner := &interval.Stats.Banners[i]
+			banner.Selects, banner.Clicks = float64(selects), float64(clicks)
+			banner.PooledSelects, banner.PooledClicks = float64(pooledSelects), float64(pooledClicks)
+		}
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("stats history of slot '%s' rows error: %w", slotID, err)
+	}
+	return history, nil
+}
+
+func (p *Postgres) RegisterSelect(ctx context.Context, slotID, bannerID, socialGroupID string) error {
+	return p.register(ctx, slotID, bannerID, socialGroupID, "selects")
+}
+
+func (p *Postgres) RegisterClick(ctx context.Context, slotID, bannerID, socialGroupID string) error {
+	return p.register(ctx, slotID, bannerID, socialGroupID, "clicks")
+}
+
+// register increments the all-time and the interval counter of a banner in a transaction.
+// The attachment of the banner is locked, so it can not be detached concurrently.
+func (p *Postgres) register(ctx context.Context, slotID, bannerID, socialGroupID, column string) error {
+	now := p.clock.Now()
+	if err := p.cleanUpIntervals(ctx, now); err != nil {
+		return err
+	}
+	start := now.Truncate(app.StatsInterval)
+	return p.inTx(ctx, func(tx queryer) error {
+		if err := hasBannerAttachedToSlot(ctx, tx, slotID, bannerID); err != nil {
+			return err
+		}
+		if err := hasSocialGroup(ctx, tx, socialGroupID); err != nil {
+			return err
+		}
+		query := fmt.Sprintf(`INSERT INTO banner_stats (slot_id, social_group_id, banner_id, %[1]s)
+VALUES ($1, $2, $3, 1)
+ON CONFLICT (slot_id, social_group_id, banner_id) DO UPDATE SET %[1]s = banner_stats.%[1]s + 1`, column)
+		if _, err := tx.ExecContext(ctx, query, slotID, socialGroupID, bannerID); err != nil {
+			return fmt.Errorf("increment of %s of slot '%s' banner '%s' error: %w", column, slotID, bannerID, err)
+		}
+		query = fmt.Sprintf(`INSERT INTO banner_interval_stats (slot_id, social_group_id, banner_id, interval_start, %[1]s)
+VALUES ($1, $2, $3, $4, 1)
+ON CONFLICT (slot_id, interval_start, social_group_id, banner_id)
+    DO UPDATE SET %[1]s = banner_interval_stats.%[1]s + 1`, column)
+		if _, err := tx.ExecContext(ctx, query, slotID, socialGroupID, bannerID, start); err != nil {
+			return fmt.Errorf("increment of interval %s of slot '%s' banner '%s' error: %w", column, slotID, bannerID, err)
+		}
+		return nil
+	})
+}
+
+// cleanUpIntervals removes the expired interval counters once per stats interval. The removal is a separate
+// statement, so the registration transactions do not hold its locks, and the other registrations do not wait
+// for it. The interval is marked as cleaned up after the removal succeeds, so a failed removal is retried.
+func (p *Postgres) cleanUpIntervals(ctx context.Context, now time.Time) error {
+	start := now.Truncate(app.StatsInterval).Unix()
+	if atomic.LoadInt64(&p.cleanedUp) == start || !p.cleanUpMu.TryLock() {
+		return nil
+	}
+	defer p.cleanUpMu.Unlock()
+	if atomic.LoadInt64(&p.cleanedUp) == start {
+		return nil
+	}
+	_, err := p.db.ExecContext(ctx, "DELETE FROM banner_interval_stats WHERE interval_start < $1", now.Add(-intervalTTL))
+	if err != nil {
+		return fmt.Errorf("delete of expired interval stats error: %w", err)
+	}
+	atomic.StoreInt64(&p.cleanedUp, start)
+	return nil
+}
+
+func (p *Postgres) checkSlotSocialGroup(ctx context.Context, slotID, socialGroupID string) error {
+	if err := hasSlot(ctx, p.db, slotID); err != nil {
+		return err
+	}
+	return hasSocialGroup(ctx, p.db, socialGroupID)
+}
+
+// inTx executes fn in a transaction which is committed in case of fn returns no error.
+func (p *Postgres) inTx(ctx context.Context, fn func(tx queryer) error) error {
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin transaction error: %w", err)
+	}
+	if err = fn(tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction error: %w", err)
+	}
+	return nil
+}
+
+// getAttachedBannerIDs returns sorted ids of the banners attached to a slot.
+func getAttachedBannerIDs(ctx context.Context, q queryer, slotID string) ([]string, error) {
+	rows, err := q.QueryContext(ctx, "SELECT banner_id FROM slot_banners WHERE slot_id = $1 ORDER BY banner_id", slotID)
+	if err != nil {
+		return nil, fmt.Errorf("select of slot '%s' banners error: %w", slotID, err)
+	}
+	defer rows.Close()
+	var bannerIDs []string
+	for rows.Next() {
+		var bannerID string
+		if err = rows.Scan(&bannerID); err != nil {
+			return nil, fmt.Errorf("select of slot '%s' banners scan error: %w", slotID, err)
+		}
+		bannerIDs = append(bannerIDs, bannerID)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("select of slot '%s' banners rows error: %w", slotID, err)
+	}
+	if len(bannerIDs) == 0 {
+		return nil, app.ErrNoBannersFound
+	}
+	return bannerIDs, nil
+}
+
+// checkNotReferenced returns ErrInUse in case of the query selects a reference of the entity with the id.
+// The message is formatted with the id and the selected reference.
+func checkNotReferenced(ctx context.Context, q queryer, query, id, message string) error {
+	var reference string
+	err := q.QueryRowContext(ctx, query+" LIMIT 1", id).Scan(&reference)
+	if err == nil {
+		return app.NewErrInUse(fmt.Sprintf(message, id, reference))
+	}
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	return fmt.Errorf("select of '%s' references error: %w", id, err)
+}
+
+// deleteRows executes the delete queries with the id.
+func deleteRows(ctx context.Context, q queryer, id string, queries ...string) error {
+	for _, query := range queries {
+		if _, err := q.ExecContext(ctx, query, id); err != nil {
+			return fmt.Errorf("delete of '%s' error: %w", id, err)
+		}
+	}
+	return nil
+}
+
+func exists(ctx context.Context, q queryer, table, id string) (bool, error) {
+	var ok bool
+	query := fmt.Sprintf("SELECT EXISTS(SELECT 1 FROM %s WHERE id = $1)", table)
+	if err := q.QueryRowContext(ctx, query, id).Scan(&ok); err != nil {
+		return false, fmt.Errorf("select of %s '%s' error: %w", table, id, err)
+	}
+	return ok, nil
+}
+
+func hasBanner(ctx context.Context, q queryer, bannerID string) error {
+	ok, err := exists(ctx, q, "banners", bannerID)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return app.NewErrNotFound(fmt.Sprintf("banner with id '%s' is not found", bannerID))
+	}
+	return nil
+}
+
+func hasSlot(ctx context.Context, q queryer, slotID string) error {
+	ok, err := exists(ctx, q, "slots", slotID)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return app.NewErrNotFound(fmt.Sprintf("slot with id '%s' is not found", slotID))
+	}
+	return nil
+}
+
+func hasSocialGroup(ctx context.Context, q queryer, socialGroupID string) error {
+	ok, err := exists(ctx, q, "social_groups", socialGroupID)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return app.NewErrNotFound(fmt.Sprintf("socialGroup with id '%s' is not found", socialGroupID))
+	}
+	return nil
+}
+
+// hasBannerAttachedToSlot checks the banner is attached to the slot and locks the attachment
+// till the end of the transaction.
+func hasBannerAttachedToSlot(ctx context.Context, q queryer, slotID, bannerID string) error {
+	if err := hasSlot(ctx, q, slotID); err != nil {
+		return err
+	}
+	if err := hasBanner(ctx, q, bannerID); err != nil {
+		return err
+	}
+	var one int
+	err := q.QueryRowContext(ctx, "SELECT 1 FROM slot_banners WHERE slot_id = $1 AND banner_id = $2 FOR SHARE",
+		slotID, bannerID).Scan(&one)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return app.NewErrBannerNotAttached(slotID, bannerID)
+		}
+		return fmt.Errorf("select of slot '%s' banner '%s' error: %w", slotID, bannerID, err)
+	}
+	return nil
+}
+
+// rowScanner is implemented by *sql.Row and *sql.Rows.
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanBanner scans a banner selected with bannerColumns.
+func scanBanner(row rowScanner) (app.Banner, error) {
+	var banner app.Banner
+	var metadata []byte
+	var start, end sql.NullTime
+	err := row.Scan(
+		&banner.ID, &banner.Description, &banner.Creative.TargetURL, &banner.Creative.ImageURL,
+		&banner.Creative.Width, &banner.Creative.Height, &banner.Creative.MIMEType, &metadata, &start, &end,
+	)
+	if err != nil {
+		return app.Banner{}, err
+	}
+	banner.Flight = makeFlight(start, end)
+	if err = json.Unmarshal(metadata, &banner.Creative.Metadata); err != nil {
+		return app.Banner{}, fmt.Errorf("unmarshal of banner '%s' metadata error: %w", banner.ID, err)
+	}
+	if len(banner.Creative.Metadata) == 0 {
+		banner.Creative.Metadata = nil
+	}
+	return banner, nil
+}
+
+// bannerArgs returns the values of the bannerColumns of a banner.
+func bannerArgs(banner app.Banner) ([]interface{}, error) {
+	metadata := banner.Creative.Metadata
+	if metadata == nil {
+		metadata = map[string]string{}
+	}
+	encoded, err := json.Marshal(metadata)
+	if err != nil {
+		return nil, fmt.Errorf("marshal of banner '%s' metadata error: %w", banner.ID, err)
+	}
+	return []interface{}{
+		banner.ID, banner.Description, banner.Creative.TargetURL, banner.Creative.ImageURL,
+		banner.Creative.Width, banner.Creative.Height, banner.Creative.MIMEType, string(encoded),
+		flightTimeArg(banner.Flight.Start), flightTimeArg(banner.Flight.End),
+	}, nil
+}
+
+// makeFlight returns the flight of the nullable times, a NULL time is unbounded.
+func makeFlight(start, end sql.NullTime) app.Flight {
+	var flight app.Flight
+	if start.Valid {
+		flight.Start = time.Unix(start.Time.Unix(), 0)
+	}
+	if end.Valid {
+		flight.End = time.Unix(end.Time.Unix(), 0)
+	}
+	return flight
+}
+
+// flightTimeArg returns the value of a flight time truncated to seconds, nil in case of the time is unbounded.
+func flightTimeArg(t time.Time) interface{} {
+	if t.IsZero() {
+		return nil
+	}
+	return time.Unix(t.Unix(), 0)
+}
+
+// scanSlot scans a slot selected with slotColumns.
+func scanSlot(row rowScanner) (app.Slot, error) {
+	var slot app.Slot
+	var windowSeconds int64
+	var sizes, mimeTypes []byte
+	err := row.Scan(
+		&slot.ID, &slot.Description, &slot.Strategy.Name, &slot.Strategy.UCBExploration, &slot.Strategy.Epsilon,
+		&slot.Strategy.EpsilonDecay, &slot.Strategy.Alpha, &slot.Strategy.Beta, &windowSeconds,
+		&slot.Strategy.Discount, &slot.Strategy.Prior, &slot.Strategy.PriorSelects, &slot.Strategy.PriorClicks,
+		&slot.Strategy.Pooling, &slot.Strategy.PoolingStrength, &slot.Strategy.PoolingMinSelects,
+		&sizes, &mimeTypes,
+	)
+	if err != nil {
+		return app.Slot{}, err
+	}
+	slot.Strategy.Window = time.Duration(windowSeconds) * time.Second
+	var records []sizeRecord
+	if err = json.Unmarshal(sizes, &records); err != nil {
+		return app.Slot{}, fmt.Errorf("unmarshal of slot '%s' sizes error: %w", slot.ID, err)
+	}
+	for _, record := range records {
+		slot.Format.Sizes = append(slot.Format.Sizes, app.Size(record))
+	}
+	if err = json.Unmarshal(mimeTypes, &slot.Format.MIMETypes); err != nil {
+		return app.Slot{}, fmt.Errorf("unmarshal of slot '%s' mime types error: %w", slot.ID, err)
+	}
+	if len(slot.Format.MIMETypes) == 0 {
+		slot.Format.MIMETypes = nil
+	}
+	return slot, nil
+}
+
+// sizeRecord is a stored size of a slot format.
+type sizeRecord struct {
+	Width  int `json:"width"`
+	Height int `json:"height"`
+}
+
+// slotArgs returns the values of the slotColumns of a slot.
+func slotArgs(slot app.Slot) ([]interface{}, error) {
+	records := make([]sizeRecord, 0, len(slot.Format.Sizes))
+	for _, size := range slot.Format.Sizes {
+		records = append(records, sizeRecord(size))
+	}
+	sizes, err := json.Marshal(records)
+	if err != nil {
+		return nil, fmt.Errorf("marshal of slot '%s' sizes error: %w", slot.ID, err)
+	}
+	mimeTypes := slot.Format.MIMETypes
+	if mimeTypes == nil {
+		mimeTypes = []string{}
+	}
+	encodedMIMETypes, err := json.Marshal(mimeTypes)
+	if err != nil {
+		return nil, fmt.Errorf("marshal of slot '%s' mime types error: %w", slot.ID, err)
+	}
+	strategy := slot.Strategy
+	return []interface{}{
+		slot.ID, slot.Description, strategy.Name, strategy.UCBExploration, strategy.Epsilon, strategy.EpsilonDecay,
+		strategy.Alpha, strategy.Beta, int64(strategy.Window / time.Second), strategy.Discount, strategy.Prior,
+		strategy.PriorSelects, strategy.PriorClicks, strategy.Pooling, strategy.PoolingStrength,
+		strategy.PoolingMinSelects, string(sizes), string(encodedMIMETypes),
+	}, nil
+}
+
+func makeEmptySlotStats(bannerIDs []string) app.SlotStats {
+	stats := app.SlotStats{Banners: make([]app.BannerStats, len(bannerIDs))}
+	for i, bannerID := range bannerIDs {
+		stats.Banners[i].BannerID = bannerID
+	}
+	return stats
+}
@@ -0,0 +1,85 @@
+//go:build integration
+
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ekhvalov/otus-banners-rotation/internal/app"
+	"github.com/ekhvalov/otus-banners-rotation/internal/environment/storage/storagetest"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	defaultPostgresHost     = "localhost"
+	defaultPostgresPort     = "5432"
+	defaultPostgresUsername = "postgres"
+	defaultPostgresPassword = "postgres"
+	defaultPostgresDatabase = "rotator_test"
+)
+
+func TestPostgresStorage(t *testing.T) {
+	storage := newMigratedStorage(t)
+	suite.Run(t, storagetest.NewSuite(func(t *testing.T, deleteMode app.DeleteMode) app.Storage {
+		_, err := storage.db.ExecContext(context.Background(), `TRUNCATE banners, slots, social_groups, slot_banners,
+    banner_stats, banner_interval_stats, linear_stats_a, linear_stats_b`)
+		require.NoError(t, err)
+		storage.deleteMode = deleteMode
+		return storage
+	}))
+}
+
+func TestPostgres_Migrate_Idempotent(t *testing.T) {
+	storage := newMigratedStorage(t)
+
+	applied, err := storage.Migrate(context.Background())
+
+	require.NoError(t, err)
+	require.Empty(t, applied)
+}
+
+// newMigratedStorage creates the storage with the applied migrations which is closed on the test cleanup.
+func newMigratedStorage(t *testing.T) *Postgres {
+	t.Helper()
+	storage, err := NewPostgres(makeConfig(), &sequenceGenerator{}, app.DeleteModeCascade, app.NewSystemClock())
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, storage.Close())
+	})
+	_, err = storage.Migrate(context.Background())
+	require.NoError(t, err)
+	return storage
+}
+
+// sequenceGenerator generates sequential ids which are unique across the test runs.
+type sequenceGenerator struct {
+	mu sync.Mutex
+	id int
+}
+
+func (g *sequenceGenerator) GenerateID() string {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.id++
+	return fmt.Sprintf("%d-%06d", time.Now().Unix(), g.id)
+}
+
+func makeConfig() Config {
+	v := viper.New()
+	v.SetEnvPrefix("TESTS")
+	v.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
+	v.AutomaticEnv()
+	v.SetDefault("postgres.host", defaultPostgresHost)
+	v.SetDefault("postgres.port", defaultPostgresPort)
+	v.SetDefault("postgres.username", defaultPostgresUsername)
+	v.SetDefault("postgres.password", defaultPostgresPassword)
+	v.SetDefault("postgres.database", defaultPostgresDatabase)
+	return NewConfig(v)
+}
@@ -0,0 +1,326 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/ekhvalov/otus-banners-rotation/internal/app"
+)
+
+func (p *Postgres) ExportSnapshot(ctx context.Context) (app.Snapshot, error) {
+	var snapshot app.Snapshot
+	// The snapshot is selected in a repeatable read transaction, so it is consistent.
+	tx, err := p.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+	if err != nil {
+		return app.Snapshot{}, fmt.Errorf("begin transaction error: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+	err = selectRows(ctx, tx, "SELECT "+bannerColumns+" FROM banners", func(rows *sql.Rows) error {
+		banner, err := scanBanner(rows)
+		if err != nil {
+			return err
+		}
+		snapshot.Banners = append(snapshot.Banners, banner)
+		return nil
+	})
+	if err != nil {
+		return app.Snapshot{}, err
+	}
+	err = selectRows(ctx, tx, "SELECT "+slotColumns+" FROM slots", func(rows *sql.Rows) error {
+		slot, err := scanSlot(rows)
+		if err != nil {
+			return err
+		}
+		snapshot.Slots = append(snapshot.Slots, slot)
+		return nil
+	})
+	if err != nil {
+		return app.Snapshot{}, err
+	}
+	err = selectRows(ctx, tx, "SELECT id, description FROM social_groups", func(rows *sql.Rows) error {
+		var socialGroup app.SocialGroup
+		if err := rows.Scan(&socialGroup.ID, &socialGroup.Description); err != nil {
+			return err
+		}
+		snapshot.SocialGroups = append(snapshot.SocialGroups, socialGroup)
+		return nil
+	})
+	if err != nil {
+		return app.Snapshot{}, err
+	}
+	err = selectRows(ctx, tx, "SELECT "+attachmentColumns+" FROM slot_banners", func(rows *sql.Rows) error {
+		attachment, err := scanAttachment(rows)
+		if err != nil {
+			return err
+		}
+		snapshot.Attachments = append(snapshot.Attachments, attachment)
+		return nil
+	})
+	if err != nil {
+		return app.Snapshot{}, err
+	}
+	if snapshot.Counters, err = exportCounters(ctx, tx, p.clock.Now().Add(-intervalTTL)); err != nil {
+		return app.Snapshot{}, err
+	}
+	if snapshot.LinearModels, err = exportLinearModels(ctx, tx); err != nil {
+		return app.Snapshot{}, err
+	}
+	snapshot.Sort()
+	return snapshot, nil
+}
+
+func (p *Postgres) ImportSnapshot(ctx context.Context, snapshot app.Snapshot) error {
+	now := p.clock.Now()
+	expired := now.Add(-intervalTTL)
+	return p.inTx(ctx, func(tx queryer) error {
+		for _, banner := range snapshot.Banners {
+			if err := upsertBanner(ctx, tx, banner); err != nil {
+				return err
+			}
+		}
+		for _, slot := range snapshot.Slots {
+			if err := upsertSlot(ctx, tx, slot); err != nil {
+				return err
+			}
+		}
+		for _, socialGroup := range snapshot.SocialGroups {
+			_, err := tx.ExecContext(ctx, `INSERT INTO social_groups (id, description) VALUES ($1, $2)
+ON CONFLICT (id) DO UPDATE SET description = excluded.description`, socialGroup.ID, socialGroup.Description)
+			if err != nil {
+				return fmt.Errorf("upsert of social group '%s' error: %w", socialGroup.ID, err)
+			}
+		}
+		for _, a := range snapshot.Attachments {
+			attachedAt := a.AttachedAt
+			if attachedAt.IsZero() {
+				attachedAt = now
+			}
+			_, err := tx.ExecContext(ctx, `INSERT INTO slot_banners (slot_id, banner_id, attached_at)
+VALUES ($1, $2, $3) ON CONFLICT (slot_id, banner_id) DO NOTHING`, a.SlotID, a.BannerID, attachedAt)
+			if err != nil {
+				return fmt.Errorf("insert of slot '%s' banner '%s' error: %w", a.SlotID, a.BannerID, err)
+			}
+		}
+		for _, c := range snapshot.Counters {
+			if err := importCounter(ctx, tx, c, expired); err != nil {
+				return err
+			}
+		}
+		for _, model := range snapshot.LinearModels {
+			if err := importLinearModel(ctx, tx, model); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// exportCounters selects the non-zero counters, the interval counters are selected since the expired time.
+func exportCounters(ctx context.Context, q queryer, expired time.Time) ([]app.Counter, error) {
+	var counters []app.Counter
+	err := selectRows(ctx, q, `SELECT slot_id, social_group_id, banner_id, selects, clicks
+FROM banner_stats
+WHERE selects <> 0
+   OR clicks <> 0`, func(rows *sql.Rows) error {
+		var c app.Counter
+		if err := rows.Scan(&c.SlotID, &c.SocialGroupID, &c.BannerID, &c.Selects, &c.Clicks); err != nil {
+			return err
+		}
+		counters = append(counters, c)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = selectRows(ctx, q, `SELECT slot_id, social_group_id, banner_id, interval_start, selects, clicks
+FROM banner_interval_stats
+WHERE interval_start >= $1
+  AND (selects <> 0 OR clicks <> 0)`, func(rows *sql.Rows) error {
+		var c app.Counter
+		if err := rows.Scan(&c.SlotID, &c.SocialGroupID, &c.BannerID, &c.Start, &c.Selects, &c.Clicks); err != nil {
+			return err
+		}
+		c.Start = time.Unix(c.Start.Unix(), 0)
+		counters = append(counters, c)
+		return nil
+	}, expired)
+	if err != nil {
+		return nil, err
+	}
+	return counters, nil
+}
+
+// importCounter adds a counter to the stored one. The expired interval counter is skipped.
+func importCounter(ctx context.Context, q queryer, c app.Counter, expired time.Time) error {
+	if c.Start.IsZero() {
+		_, err := q.ExecContext(ctx, `INSERT INTO banner_stats (slot_id, social_group_id, banner_id, selects, clicks)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (slot_id, social_group_id, banner_id)
+    DO UPDATE SET selects = banner_stats.selects + excluded.selects, clicks = banner_stats.clicks + excluded.clicks`,
+			c.SlotID, c.SocialGroupID, c.BannerID, c.Selects, c.Clicks)
+		if err != nil {
+			return fmt.Errorf("import of counters of slot '%s' banner '%s' error: %w", c.SlotID, c.BannerID, err)
+		}
+		return nil
+	}
+	if c.Start.Before(expired) {
+		return nil
+	}
+	_, err := q.ExecContext(ctx, `INSERT INTO banner_interval_stats
+    (slot_id, social_group_id, banner_id, interval_start, selects, clicks)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (slot_id, interval_start, social_group_id, banner_id)
+    DO UPDATE SET selects = banner_interval_stats.selects + excluded.selects,
+                  clicks  = banner_interval_stats.clicks + excluded.clicks`,
+		c.SlotID, c.SocialGroupID, c.BannerID, c.Start, c.Selects, c.Clicks)
+	if err != nil {
+		return fmt.Errorf("import of interval counters of slot '%s' banner '%s' error: %w", c.SlotID, c.BannerID, err)
+	}
+	return nil
+}
+
+func exportLinearModels(ctx context.Context, q queryer) ([]app.LinearModel, error) {
+	type linearKey struct {
+		slotID   string
+		bannerID string
+	}
+	var models []app.LinearModel
+	indexes := make(map[linearKey]int)
+	getModel := func(slotID, bannerID string) *app.LinearModel {
+		key := linearKey{slotID: slotID, bannerID: bannerID}
+		i, ok := indexes[key]
+		if !ok {
+			i = len(models)
+			indexes[key] = i
+			models = append(models, app.LinearModel{SlotID: slotID, BannerID: bannerID})
+		}
+		return &models[i]
+	}
+	err := selectRows(ctx, q, "SELECT slot_id, banner_id, i, j, value FROM linear_stats_a", func(rows *sql.Rows) error {
+		var slotID, bannerID string
+		var element app.LinearElement
+		if err := rows.Scan(&slotID, &bannerID, &element.I, &element.J, &element.Value); err != nil {
+			return err
+		}
+		model := getModel(slotID, bannerID)
+		model.A = append(model.A, element)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = selectRows(ctx, q, "SELECT slot_id, banner_id, i, value FROM linear_stats_b", func(rows *sql.Rows) error {
+		var slotID, bannerID string
+		var element app.LinearElement
+		if err := rows.Scan(&slotID, &bannerID, &element.I, &element.Value); err != nil {
+			return err
+		}
+		model := getModel(slotID, bannerID)
+		model.B = append(model.B, element)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return models, nil
+}
+
+func importLinearModel(ctx context.Context, q queryer, model app.LinearModel) error {
+	for _, element := range model.A {
+		_, err := q.ExecContext(ctx, `INSERT INTO linear_stats_a (slot_id, banner_id, i, j, value)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (slot_id, banner_id, i, j) DO UPDATE SET value = linear_stats_a.value + excluded.value`,
+			model.SlotID, model.BannerID, element.I, element.J, element.Value)
+		if err != nil {
+			return fmt.Errorf("import of linear stats of slot '%s' banner '%s' error: %w", model.SlotID, model.BannerID, err)
+		}
+	}
+	for _, element := range model.B {
+		_, err := q.ExecContext(ctx, `INSERT INTO linear_stats_b (slot_id, banner_id, i, value)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (slot_id, banner_id, i) DO UPDATE SET value = linear_stats_b.value + excluded.value`,
+			model.SlotID, model.BannerID, element.I, element.Value)
+		if err != nil {
+			return fmt.Errorf("import of linear stats of slot '%s' banner '%s' error: %w", model.SlotID, model.BannerID, err)
+		}
+	}
+	return nil
+}
+
+func upsertBanner(ctx context.Context, q queryer, banner app.Banner) error {
+	args, err := bannerArgs(banner)
+	if err != nil {
+		return err
+	}
+	_, err = q.ExecContext(ctx, `INSERT INTO banners (`+bannerColumns+`)
+VALUES (`+bannerValues+`)
+ON CONFLICT (id) DO UPDATE SET description         = excluded.description,
+                               creative_target_url = excluded.creative_target_url,
+                               creative_image_url  = excluded.creative_image_url,
+                               creative_width      = excluded.creative_width,
+                               creative_height     = excluded.creative_height,
+                               creative_mime_type  = excluded.creative_mime_type,
+                               creative_metadata   = excluded.creative_metadata,
+                               flight_start        = excluded.flight_start,
+                               flight_end          = excluded.flight_end`, args...)
+	if err != nil {
+		return fmt.Errorf("upsert of banner '%s' error: %w", banner.ID, err)
+	}
+	return nil
+}
+
+func upsertSlot(ctx context.Context, q queryer, slot app.Slot) error {
+	args, err := slotArgs(slot)
+	if err != nil {
+		return err
+	}
+	_, err = q.ExecContext(ctx, `INSERT INTO slots (`+slotColumns+`)
+VALUES (`+slotValues+`)
+ON CONFLICT (id) DO UPDATE SET description                  = excluded.description,
+                               strategy_name                = excluded.strategy_name,
+                               strategy_ucb_exploration     = excluded.strategy_ucb_exploration,
+                               strategy_epsilon             = excluded.strategy_epsilon,
+                               strategy_epsilon_decay       = excluded.strategy_epsilon_decay,
+                               strategy_alpha               = excluded.strategy_alpha,
+                               strategy_beta                = excluded.strategy_beta,
+                               strategy_window_seconds      = excluded.strategy_window_seconds,
+                               strategy_discount            = excluded.strategy_discount,
+                               strategy_prior               = excluded.strategy_prior,
+                               strategy_prior_selects       = excluded.strategy_prior_selects,
+                               strategy_prior_clicks        = excluded.strategy_prior_clicks,
+                               strategy_pooling             = excluded.strategy_pooling,
+                               strategy_pooling_strength    = excluded.strategy_pooling_strength,
+                               strategy_pooling_min_selects = excluded.strategy_pooling_min_selects,
+                               format_sizes                 = excluded.format_sizes,
+                               format_mime_types            = excluded.format_mime_types`, args...)
+	if err != nil {
+		return fmt.Errorf("upsert of slot '%s' error: %w", slot.ID, err)
+	}
+	return nil
+}
+
+// selectRows executes the query and calls scan for every selected row.
+func selectRows(
+	ctx context.Context,
+	q queryer,
+	query string,
+	scan func(rows *sql.Rows) error,
+	args ...interface{},
+) error {
+	rows, err := q.QueryContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("select '%s' error: %w", query, err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		if err = scan(rows); err != nil {
+			return fmt.Errorf("select '%s' scan error: %w", query, err)
+		}
+	}
+	if err = rows.Err(); err != nil {
+		return fmt.Errorf("select '%s' rows error: %w", query, err)
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+package redis
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"os"
+
+	rediscli "github.com/go-redis/redis/v9"
+)
+
+// The deployment modes of the redis.
+const (
+	ModeSingle   = "single"
+	ModeSentinel = "sentinel"
+	ModeCluster  = "cluster"
+)
+
+// newClient creates a client of a single node, of a master monitored by the sentinels or of a cluster.
+func newClient(config Config) (rediscli.UniversalClient, error) {
+	options, err := makeUniversalOptions(config)
+	if err != nil {
+		return nil, err
+	}
+	switch mode := config.GetMode(); mode {
+	case ModeSingle:
+		return rediscli.NewClient(options.Simple()), nil
+	case ModeSentinel:
+		if options.MasterName == "" {
+			return nil, fmt.Errorf("sentinel master name is not set")
+		}
+		return rediscli.NewFailoverClient(options.Failover()), nil
+	case ModeCluster:
+		if options.DB != 0 {
+			return nil, fmt.Errorf("cluster supports database 0 only, got %d", options.DB)
+		}
+		return rediscli.NewClusterClient(options.Cluster()), nil
+	default:
+		return nil, fmt.Errorf("unknown redis mode '%s'", mode)
+	}
+}
+
+func makeUniversalOptions(config Config) (*rediscli.UniversalOptions, error) {
+	options := &rediscli.UniversalOptions{
+		Addrs:            config.GetAddresses(),
+		DB:               config.GetDatabase(),
+		Username:         config.GetUsername(),
+		Password:         config.GetPassword(),
+		MasterName:       config.GetSentinelMasterName(),
+		SentinelUsername: config.GetSentinelUsername(),
+		SentinelPassword: config.GetSentinelPassword(),
+		PoolSize:         config.GetPoolSize(),
+		MinIdleConns:     config.GetMinIdleConns(),
+		PoolTimeout:      config.GetPoolTimeout(),
+		DialTimeout:      config.GetDialTimeout(),
+		ReadTimeout:      config.GetReadTimeout(),
+		WriteTimeout:     config.GetWriteTimeout(),
+	}
+	if config.GetTLSEnabled() {
+		tlsConfig, err := makeTLSConfig(config)
+		if err != nil {
+			return nil, err
+		}
+		options.TLSConfig = tlsConfig
+	}
+	return options, nil
+}
+
+func makeTLSConfig(config Config) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		ServerName:         config.GetTLSServerName(),
+		InsecureSkipVerify: config.GetTLSInsecureSkipVerify(), //nolint:gosec // Enabled explicitly by the config
+	}
+	// The nodes of a cluster and the master monitored by the sentinels are discovered, so their name is set
+	// explicitly.
+	if tlsConfig.ServerName == "" && !tlsConfig.InsecureSkipVerify {
+		if config.GetMode() != ModeSingle {
+			return nil, fmt.Errorf("tls server name is not set")
+		}
+		host, _, err := net.SplitHostPort(config.GetAddresses()[0])
+		if err != nil {
+			return nil, fmt.Errorf("split of address '%s' error: %w", config.GetAddresses()[0], err)
+		}
+		tlsConfig.ServerName = host
+	}
+	if caFile := config.GetTLSCAFile(); caFile != "" {
+		pem, err := os.ReadFile(caFile)
+		if err != nil {
+			return nil, fmt.Errorf("read of tls ca file '%s' error: %w", caFile, err)
+		}
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("tls ca file '%s' has no certificates", caFile)
+		}
+	}
+	certFile, keyFile := config.GetTLSCertFile(), config.GetTLSKeyFile()
+	if certFile != "" || keyFile != "" {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, fmt.Errorf("load of tls key pair '%s' '%s' error: %w", certFile, keyFile, err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	return tlsConfig, nil
+}
@@ -2,6 +2,7 @@ package redis
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/spf13/viper"
 )
@@ -26,6 +27,35 @@ func (c *Config) GetAddress() string {
 	return fmt.Sprintf("%s:%d", c.GetHost(), c.GetPort())
 }
 
+// GetMode returns a deployment mode of the redis: "single" (default), "sentinel" or "cluster".
+func (c *Config) GetMode() string {
+	if mode := c.v.GetString("redis.mode"); mode != "" {
+		return mode
+	}
+	return ModeSingle
+}
+
+// GetAddresses returns the addresses of the sentinels or of the cluster nodes, the host and port address by default.
+func (c *Config) GetAddresses() []string {
+	if addresses := c.v.GetStringSlice("redis.addresses"); len(addresses) > 0 {
+		return addresses
+	}
+	return []string{c.GetAddress()}
+}
+
+// GetSentinelMasterName returns a name of the master monitored by the sentinels.
+func (c *Config) GetSentinelMasterName() string {
+	return c.v.GetString("redis.sentinel.master_name")
+}
+
+func (c *Config) GetSentinelUsername() string {
+	return c.v.GetString("redis.sentinel.username")
+}
+
+func (c *Config) GetSentinelPassword() string {
+	return c.v.GetString("redis.sentinel.password")
+}
+
 func (c *Config) GetUsername() string {
 	return c.v.GetString("redis.username")
 }
@@ -37,3 +67,68 @@ func (c *Config) GetPassword() string {
 func (c *Config) GetDatabase() int {
 	return c.v.GetInt("redis.database")
 }
+
+// GetTLSEnabled returns whether the connections use TLS.
+func (c *Config) GetTLSEnabled() bool {
+	return c.v.GetBool("redis.tls.enabled")
+}
+
+// GetTLSCAFile returns a path of the PEM encoded certificate authorities, the system ones are used by default.
+func (c *Config) GetTLSCAFile() string {
+	return c.v.GetString("redis.tls.ca_file")
+}
+
+// GetTLSCertFile returns a path of the PEM encoded client certificate.
+func (c *Config) GetTLSCertFile() string {
+	return c.v.GetString("redis.tls.cert_file")
+}
+
+// GetTLSKeyFile returns a path of the PEM encoded client certificate key.
+func (c *Config) GetTLSKeyFile() string {
+	return c.v.GetString("redis.tls.key_file")
+}
+
+// GetTLSServerName returns a name to verify the server certificate, the host of an address by default.
+func (c *Config) GetTLSServerName() string {
+	return c.v.GetString("redis.tls.server_name")
+}
+
+// GetTLSInsecureSkipVerify returns whether the server certificate is not verified.
+func (c *Config) GetTLSInsecureSkipVerify() bool {
+	return c.v.GetBool("redis.tls.insecure_skip_verify")
+}
+
+// GetPoolSize returns a maximum number of the connections per node, 0 means the client default.
+func (c *Config) GetPoolSize() int {
+	return c.v.GetInt("redis.pool_size")
+}
+
+// GetMinIdleConns returns a minimum number of the idle connections per node.
+func (c *Config) GetMinIdleConns() int {
+	return c.v.GetInt("redis.min_idle_conns")
+}
+
+// GetDialTimeout returns a timeout of a connection establishment, 0 means the client default.
+func (c *Config) GetDialTimeout() time.Duration {
+	return c.v.GetDuration("redis.dial_timeout")
+}
+
+// GetReadTimeout returns a timeout of a socket read, 0 means the client default.
+func (c *Config) GetReadTimeout() time.Duration {
+	return c.v.GetDuration("redis.read_timeout")
+}
+
+// GetWriteTimeout returns a timeout of a socket write, 0 means the client default.
+func (c *Config) GetWriteTimeout() time.Duration {
+	return c.v.GetDuration("redis.write_timeout")
+}
+
+// GetPoolTimeout returns a time to wait for a free connection, 0 means the client default.
+func (c *Config) GetPoolTimeout() time.Duration {
+	return c.v.GetDuration("redis.pool_timeout")
+}
+
+// GetKeyPrefix returns a prefix of all keys of the storage, so several environments can share a database.
+func (c *Config) GetKeyPrefix() string {
+	return c.v.GetString("redis.key_prefix")
+}
@@ -0,0 +1,113 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	rediscli "github.com/go-redis/redis/v9"
+)
+
+// counterKeys are keys of the banners selects and clicks counters and of the total selects counter.
+// An empty total key means that there is no total selects counter.
+type counterKeys struct {
+	selects string
+	clicks  string
+	total   string
+}
+
+type counterCmds struct {
+	keys    counterKeys
+	selects *rediscli.SliceCmd
+	clicks  *rediscli.SliceCmd
+	total   *rediscli.StringCmd
+}
+
+type counters struct {
+	selects []int64
+	clicks  []int64
+	total   int64
+}
+
+func makeSlotSocialGroupCounterKeys(slotID, socialGroupID string) counterKeys {
+	return counterKeys{
+		selects: makeSlotSocialGroupSelectsKey(slotID, socialGroupID),
+		clicks:  makeSlotSocialGroupClicksKey(slotID, socialGroupID),
+		total:   makeSlotSocialGroupSelectsTotalKey(slotID, socialGroupID),
+	}
+}
+
+func makeSlotSocialGroupIntervalCounterKeys(slotID, socialGroupID string, start time.Time) counterKeys {
+	return counterKeys{
+		selects: makeSlotSocialGroupIntervalSelectsKey(slotID, socialGroupID, start),
+		clicks:  makeSlotSocialGroupIntervalClicksKey(slotID, socialGroupID, start),
+		total:   makeSlotSocialGroupIntervalSelectsTotalKey(slotID, socialGroupID, start),
+	}
+}
+
+func makeSlotCounterKeys(slotID string) counterKeys {
+	return counterKeys{
+		selects: makeSlotSelectsKey(slotID),
+		clicks:  makeSlotClicksKey(slotID),
+		total:   makeSlotSelectsTotalKey(slotID),
+	}
+}
+
+func makeSlotIntervalCounterKeys(slotID string, start time.Time) counterKeys {
+	return counterKeys{
+		selects: makeIntervalKey(makeSlotSelectsKey(slotID), start),
+		clicks:  makeIntervalKey(makeSlotClicksKey(slotID), start),
+		total:   makeIntervalKey(makeSlotSelectsTotalKey(slotID), start),
+	}
+}
+
+// queueGetCounters queues the commands which get the counters of the banners.
+func queueGetCounters(ctx context.Context, pipe rediscli.Pipeliner, keys counterKeys, bannerIDs []string) counterCmds {
+	cmds := counterCmds{
+		keys:    keys,
+		selects: pipe.HMGet(ctx, keys.selects, bannerIDs...),
+		clicks:  pipe.HMGet(ctx, keys.clicks, bannerIDs...),
+	}
+	if keys.total != "" {
+		cmds.total = pipe.Get(ctx, keys.total)
+	}
+	return cmds
+}
+
+func (c counterCmds) result(bannerIDs []string) (counters, error) {
+	var result counters
+	var err error
+	if result.selects, err = parseInt64s(c.keys.selects, bannerIDs, c.selects.Val()); err != nil {
+		return counters{}, err
+	}
+	if result.clicks, err = parseInt64s(c.keys.clicks, bannerIDs, c.clicks.Val()); err != nil {
+		return counters{}, err
+	}
+	if c.total == nil {
+		return result, nil
+	}
+	if err = c.total.Err(); err != nil {
+		if errors.Is(err, rediscli.Nil) {
+			return result, nil
+		}
+		return counters{}, fmt.Errorf("get of '%s' error: %w", c.keys.total, err)
+	}
+	if result.total, err = c.total.Int64(); err != nil {
+		return counters{}, fmt.Errorf("get of '%s' parse int error: %w", c.keys.total, err)
+	}
+	return result, nil
+}
+
+// execPipelined executes the pipeline ignoring the errors of the missing keys,
+// the errors of the commands are checked on their results.
+func (r *Redis) execPipelined(ctx context.Context, fn func(pipe rediscli.Pipeliner)) error {
+	_, err := r.client.Pipelined(ctx, func(pipe rediscli.Pipeliner) error {
+		fn(pipe)
+		return nil
+	})
+	if err != nil && !errors.Is(err, rediscli.Nil) {
+		return err
+	}
+	return nil
+}
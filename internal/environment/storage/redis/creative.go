@@ -0,0 +1,108 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ekhvalov/otus-banners-rotation/internal/app"
+	rediscli "github.com/go-redis/redis/v9"
+)
+
+const (
+	fieldCreativeTargetURL = "target_url"
+	fieldCreativeImageURL  = "image_url"
+	fieldCreativeWidth     = "width"
+	fieldCreativeHeight    = "height"
+	fieldCreativeMIMEType  = "mime_type"
+	// fieldCreativeMetadataPrefix prefixes the keys of the metadata, so they do not clash with the other fields.
+	fieldCreativeMetadataPrefix = "metadata:"
+)
+
+// creativeToFields returns the hash fields of the non-empty values of a creative.
+func creativeToFields(creative app.Creative) map[string]interface{} {
+	fields := make(map[string]interface{})
+	for field, value := range map[string]string{
+		fieldCreativeTargetURL: creative.TargetURL,
+		fieldCreativeImageURL:  creative.ImageURL,
+		fieldCreativeMIMEType:  creative.MIMEType,
+	} {
+		if value != "" {
+			fields[field] = value
+		}
+	}
+	if creative.Width != 0 {
+		fields[fieldCreativeWidth] = creative.Width
+	}
+	if creative.Height != 0 {
+		fields[fieldCreativeHeight] = creative.Height
+	}
+	for key, value := range creative.Metadata {
+		fields[fieldCreativeMetadataPrefix+key] = value
+	}
+	return fields
+}
+
+func fieldsToCreative(fields map[string]string) (app.Creative, error) {
+	creative := app.Creative{
+		TargetURL: fields[fieldCreativeTargetURL],
+		ImageURL:  fields[fieldCreativeImageURL],
+		MIMEType:  fields[fieldCreativeMIMEType],
+	}
+	ints := map[string]*int{
+		fieldCreativeWidth:  &creative.Width,
+		fieldCreativeHeight: &creative.Height,
+	}
+	for field, value := range ints {
+		str, ok := fields[field]
+		if !ok {
+			continue
+		}
+		parsed, err := strconv.Atoi(str)
+		if err != nil {
+			return app.Creative{}, fmt.Errorf("field '%s' parse int error: %w", field, err)
+		}
+		*value = parsed
+	}
+	for field, value := range fields {
+		if !strings.HasPrefix(field, fieldCreativeMetadataPrefix) {
+			continue
+		}
+		if creative.Metadata == nil {
+			creative.Metadata = make(map[string]string)
+		}
+		creative.Metadata[strings.TrimPrefix(field, fieldCreativeMetadataPrefix)] = value
+	}
+	return creative, nil
+}
+
+// queueSetCreative queues the replacement of the creative of a banner.
+func (r *Redis) queueSetCreative(ctx context.Context, pipe rediscli.Pipeliner, bannerID string, creative app.Creative) {
+	creativeKey := r.key(makeBannerCreativeKey(bannerID))
+	pipe.Del(ctx, creativeKey)
+	if fields := creativeToFields(creative); len(fields) > 0 {
+		pipe.HSet(ctx, creativeKey, fields)
+	}
+}
+
+// getCreatives returns the creatives of the banners read by a pipeline.
+func (r *Redis) getCreatives(ctx context.Context, bannerIDs []string) ([]app.Creative, error) {
+	cmds := make([]*rediscli.MapStringStringCmd, len(bannerIDs))
+	_, err := r.client.Pipelined(ctx, func(pipe rediscli.Pipeliner) error {
+		for i, bannerID := range bannerIDs {
+			cmds[i] = pipe.HGetAll(ctx, r.key(makeBannerCreativeKey(bannerID)))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("hgetall of banners creatives error: %w", err)
+	}
+	creatives := make([]app.Creative, len(bannerIDs))
+	for i, bannerID := range bannerIDs {
+		if creatives[i], err = fieldsToCreative(cmds[i].Val()); err != nil {
+			return nil, fmt.Errorf("parse of '%s' error: %w", r.key(makeBannerCreativeKey(bannerID)), err)
+		}
+	}
+	return creatives, nil
+}
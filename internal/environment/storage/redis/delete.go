@@ -0,0 +1,354 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/ekhvalov/otus-banners-rotation/internal/app"
+	rediscli "github.com/go-redis/redis/v9"
+)
+
+const (
+	// scriptBatchKeys is a maximum number of the keys of a script run which deletes the counters of all intervals.
+	scriptBatchKeys = 300
+	// maxWatchRetries is a maximum number of the runs of a transaction whose watched keys are changed concurrently.
+	maxWatchRetries = 3
+)
+
+// DeleteBanner deletes a banner. In the DeleteModeCascade mode the banner is detached from all slots, its counters
+// and linear stats are deleted and the totals are decreased by its selects.
+func (r *Redis) DeleteBanner(ctx context.Context, id string) error {
+	slotIDs, err := r.client.HKeys(ctx, r.key(keySlots)).Result()
+	if err != nil {
+		return fmt.Errorf("hkeys of '%s' error: %w", r.key(keySlots), err)
+	}
+	if r.deleteMode == app.DeleteModeRestrict {
+		if err = r.checkBannerNotAttached(ctx, id, slotIDs); err != nil {
+			return err
+		}
+	}
+	if err = r.hDel(ctx, r.key(keyBanners), id); err != nil {
+		return err
+	}
+	slotsSocialGroupIDs, err := r.sMembersOfEach(ctx, slotIDs, makeSlotSocialGroupsKey)
+	if err != nil {
+		return err
+	}
+	slotsStarts, err := r.intervalStartsOfEach(ctx, slotIDs)
+	if err != nil {
+		return err
+	}
+	for i, slotID := range slotIDs {
+		var keys []string
+		keys = appendCounterKeys(keys, r.counterKeys(makeSlotCounterKeys(slotID)))
+		for _, start := range slotsStarts[i] {
+			keys = appendCounterKeys(keys, r.counterKeys(makeSlotIntervalCounterKeys(slotID, start)))
+		}
+		for _, socialGroupID := range slotsSocialGroupIDs[i] {
+			keys = appendCounterKeys(keys, r.counterKeys(makeSlotSocialGroupCounterKeys(slotID, socialGroupID)))
+			for _, start := range slotsStarts[i] {
+				keys = appendCounterKeys(keys, r.counterKeys(makeSlotSocialGroupIntervalCounterKeys(slotID, socialGroupID, start)))
+			}
+		}
+		if err = r.runInBatches(ctx, deleteBannerCountersScript, keys, 3, id); err != nil {
+			return fmt.Errorf("delete counters of banner '%s' in slot '%s' error: %w", id, slotID, err)
+		}
+	}
+	_, err = r.client.TxPipelined(ctx, func(pipe rediscli.Pipeliner) error {
+		pipe.Del(ctx, r.key(makeBannerCreativeKey(id)))
+		pipe.HDel(ctx, r.key(keyBannerFlights), id)
+		for _, slotID := range slotIDs {
+			pipe.ZRem(ctx, r.key(makeSlotBannersKey(slotID)), id)
+			pipe.SRem(ctx, r.key(makeSlotLinearBannersKey(slotID)), id)
+			pipe.Del(ctx, r.key(makeSlotBannerLinearKey(slotID, id)))
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("delete of banner '%s' error: %w", id, err)
+	}
+	return nil
+}
+
+// DeleteSlot deletes a slot. In the DeleteModeCascade mode the attachments, the counters and the linear stats
+// of the slot are deleted. The slot is deleted by a transaction which watches the sets of the slot, so a banner
+// attached concurrently fails the check of the DeleteModeRestrict mode. The interval counters are deleted
+// after the transaction in batches.
+func (r *Redis) DeleteSlot(ctx context.Context, id string) error {
+	slotBannersKey := r.key(makeSlotBannersKey(id))
+	slotSocialGroupsKey := r.key(makeSlotSocialGroupsKey(id))
+	slotLinearBannersKey := r.key(makeSlotLinearBannersKey(id))
+	slotIntervalsKey := r.key(makeSlotIntervalsKey(id))
+	// The index keys are in the other hash slots of a cluster, so they are changed by another transaction there.
+	_, isCluster := r.client.(*rediscli.ClusterClient)
+	var socialGroupIDs []string
+	var starts []time.Time
+	deleteSlot := func(tx *rediscli.Tx) error {
+		if r.deleteMode == app.DeleteModeRestrict {
+			count, err := tx.ZCard(ctx, slotBannersKey).Result()
+			if err != nil {
+				return fmt.Errorf("zcard of '%s' error: %w", slotBannersKey, err)
+			}
+			if count > 0 {
+				return app.NewErrInUse(fmt.Sprintf("slot with id '%s' has attached banners", id))
+			}
+		}
+		var err error
+		if socialGroupIDs, err = tx.SMembers(ctx, slotSocialGroupsKey).Result(); err != nil {
+			return fmt.Errorf("smembers of '%s' error: %w", slotSocialGroupsKey, err)
+		}
+		linearBannerIDs, err := tx.SMembers(ctx, slotLinearBannersKey).Result()
+		if err != nil {
+			return fmt.Errorf("smembers of '%s' error: %w", slotLinearBannersKey, err)
+		}
+		members, err := tx.ZRangeByScore(ctx, slotIntervalsKey, r.makeIntervalsRange()).Result()
+		if err != nil {
+			return fmt.Errorf("zrangebyscore of '%s' error: %w", slotIntervalsKey, err)
+		}
+		if starts, err = parseIntervalStarts(slotIntervalsKey, members); err != nil {
+			return err
+		}
+		slotCounterKeys := r.counterKeys(makeSlotCounterKeys(id))
+		_, err = tx.TxPipelined(ctx, func(pipe rediscli.Pipeliner) error {
+			if !isCluster {
+				r.queueDeleteSlotIndexes(ctx, pipe, id, socialGroupIDs)
+			}
+			pipe.Del(ctx, r.key(makeSlotStrategyKey(id)), r.key(makeSlotFormatKey(id)), slotBannersKey,
+				slotSocialGroupsKey, slotLinearBannersKey, slotIntervalsKey, slotCounterKeys.selects,
+				slotCounterKeys.clicks, slotCounterKeys.total)
+			for _, bannerID := range linearBannerIDs {
+				pipe.Del(ctx, r.key(makeSlotBannerLinearKey(id, bannerID)))
+			}
+			for _, socialGroupID := range socialGroupIDs {
+				slotGroupKeys := r.counterKeys(makeSlotSocialGroupCounterKeys(id, socialGroupID))
+				pipe.Del(ctx, slotGroupKeys.selects, slotGroupKeys.clicks, slotGroupKeys.total)
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("delete of slot '%s' error: %w", id, err)
+		}
+		return nil
+	}
+	err := r.watch(ctx, deleteSlot, slotBannersKey, slotSocialGroupsKey, slotLinearBannersKey, slotIntervalsKey)
+	if err != nil {
+		return err
+	}
+	if isCluster {
+		_, err = r.client.TxPipelined(ctx, func(pipe rediscli.Pipeliner) error {
+			r.queueDeleteSlotIndexes(ctx, pipe, id, socialGroupIDs)
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("delete of slot '%s' indexes error: %w", id, err)
+		}
+	}
+	var keys []string
+	for _, start := range starts {
+		keys = appendCounterKeys(keys, r.counterKeys(makeSlotIntervalCounterKeys(id, start)))
+		for _, socialGroupID := range socialGroupIDs {
+			keys = appendCounterKeys(keys, r.counterKeys(makeSlotSocialGroupIntervalCounterKeys(id, socialGroupID, start)))
+		}
+	}
+	err = forEachBatch(keys, 1, func(batch []string) error {
+		return r.client.Del(ctx, batch...).Err()
+	})
+	if err != nil {
+		return fmt.Errorf("delete of slot '%s' interval counters error: %w", id, err)
+	}
+	return nil
+}
+
+// queueDeleteSlotIndexes queues the deletes of a slot from the slots and from the social group slots.
+func (r *Redis) queueDeleteSlotIndexes(
+	ctx context.Context,
+	pipe rediscli.Pipeliner,
+	id string,
+	socialGroupIDs []string,
+) {
+	pipe.HDel(ctx, r.key(keySlots), id)
+	for _, socialGroupID := range socialGroupIDs {
+		pipe.SRem(ctx, r.key(makeSocialGroupSlotsKey(socialGroupID)), id)
+	}
+}
+
+// DeleteSocialGroup deletes a social group. In the DeleteModeCascade mode the counters of the social group
+// are deleted and subtracted from the pooled counters of the slots.
+func (r *Redis) DeleteSocialGroup(ctx context.Context, id string) error {
+	socialGroupSlotsKey := r.key(makeSocialGroupSlotsKey(id))
+	slotIDs, err := r.client.SMembers(ctx, socialGroupSlotsKey).Result()
+	if err != nil {
+		return fmt.Errorf("smembers of '%s' error: %w", socialGroupSlotsKey, err)
+	}
+	if r.deleteMode == app.DeleteModeRestrict && len(slotIDs) > 0 {
+		return app.NewErrInUse(fmt.Sprintf("socialGroup with id '%s' has registered counters in slot '%s'",
+			id, slotIDs[0]))
+	}
+	if err = r.hDel(ctx, r.key(keySocialGroups), id); err != nil {
+		return err
+	}
+	slotsStarts, err := r.intervalStartsOfEach(ctx, slotIDs)
+	if err != nil {
+		return err
+	}
+	for i, slotID := range slotIDs {
+		var keys []string
+		keys = appendCounterKeys(keys, r.counterKeys(makeSlotSocialGroupCounterKeys(slotID, id)))
+		keys = appendCounterKeys(keys, r.counterKeys(makeSlotCounterKeys(slotID)))
+		for _, start := range slotsStarts[i] {
+			keys = appendCounterKeys(keys, r.counterKeys(makeSlotSocialGroupIntervalCounterKeys(slotID, id, start)))
+			keys = appendCounterKeys(keys, r.counterKeys(makeSlotIntervalCounterKeys(slotID, start)))
+		}
+		if err = r.runInBatches(ctx, subtractCountersScript, keys, 6); err != nil {
+			return fmt.Errorf("subtract counters of social group '%s' in slot '%s' error: %w", id, slotID, err)
+		}
+	}
+	_, err = r.client.TxPipelined(ctx, func(pipe rediscli.Pipeliner) error {
+		pipe.Del(ctx, socialGroupSlotsKey)
+		for _, slotID := range slotIDs {
+			pipe.SRem(ctx, r.key(makeSlotSocialGroupsKey(slotID)), id)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("delete of social group '%s' error: %w", id, err)
+	}
+	return nil
+}
+
+// runInBatches runs the script for the keys which are split into the batches of at most scriptBatchKeys keys,
+// so a run with the counters of all intervals does not block the server for long.
+func (r *Redis) runInBatches(
+	ctx context.Context,
+	script *rediscli.Script,
+	keys []string,
+	setSize int,
+	args ...interface{},
+) error {
+	return forEachBatch(keys, setSize, func(batch []string) error {
+		return script.Run(ctx, r.client, batch, args...).Err()
+	})
+}
+
+// forEachBatch calls fn for the keys which are split into the batches of at most scriptBatchKeys keys.
+// The sets of setSize keys are not split between the batches.
+func forEachBatch(keys []string, setSize int, fn func(batch []string) error) error {
+	batchSize := scriptBatchKeys - scriptBatchKeys%setSize
+	for len(keys) > 0 {
+		batch := keys
+		if len(batch) > batchSize {
+			batch = batch[:batchSize]
+		}
+		if err := fn(batch); err != nil {
+			return err
+		}
+		keys = keys[len(batch):]
+	}
+	return nil
+}
+
+// watch runs fn in a transaction which watches the keys. The transaction is retried at most maxWatchRetries times
+// if the keys are changed concurrently.
+func (r *Redis) watch(ctx context.Context, fn func(tx *rediscli.Tx) error, keys ...string) error {
+	for i := 0; i < maxWatchRetries; i++ {
+		if err := r.client.Watch(ctx, fn, keys...); !errors.Is(err, rediscli.TxFailedErr) {
+			return err
+		}
+	}
+	return fmt.Errorf("transaction watching '%s' error: %w", keys[0], rediscli.TxFailedErr)
+}
+
+// checkBannerNotAttached returns ErrInUse in case of the banner is attached to any of the slots.
+func (r *Redis) checkBannerNotAttached(ctx context.Context, bannerID string, slotIDs []string) error {
+	cmds := make([]*rediscli.FloatCmd, len(slotIDs))
+	err := r.execPipelined(ctx, func(pipe rediscli.Pipeliner) {
+		for i, slotID := range slotIDs {
+			cmds[i] = pipe.ZScore(ctx, r.key(makeSlotBannersKey(slotID)), bannerID)
+		}
+	})
+	if err != nil {
+		return fmt.Errorf("zscore of banner '%s' error: %w", bannerID, err)
+	}
+	for i, cmd := range cmds {
+		if err = cmd.Err(); err == nil {
+			return app.NewErrInUse(fmt.Sprintf("banner with id '%s' is attached to slot '%s'", bannerID, slotIDs[i]))
+		}
+		if !errors.Is(err, rediscli.Nil) {
+			return fmt.Errorf("zscore of '%s' '%s' error: %w", r.key(makeSlotBannersKey(slotIDs[i])), bannerID, err)
+		}
+	}
+	return nil
+}
+
+// sMembersOfEach returns the members of the set of every id.
+func (r *Redis) sMembersOfEach(ctx context.Context, ids []string, makeKey func(id string) string) ([][]string, error) {
+	cmds := make([]*rediscli.StringSliceCmd, len(ids))
+	_, err := r.client.Pipelined(ctx, func(pipe rediscli.Pipeliner) error {
+		for i, id := range ids {
+			cmds[i] = pipe.SMembers(ctx, r.key(makeKey(id)))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("smembers error: %w", err)
+	}
+	members := make([][]string, len(ids))
+	for i, cmd := range cmds {
+		members[i] = cmd.Val()
+	}
+	return members, nil
+}
+
+// appendCounterKeys appends the selects, clicks and total keys, the total key is omitted if it is empty.
+func appendCounterKeys(keys []string, counterKeys counterKeys) []string {
+	keys = append(keys, counterKeys.selects, counterKeys.clicks)
+	if counterKeys.total != "" {
+		keys = append(keys, counterKeys.total)
+	}
+	return keys
+}
+
+// intervalStartsOfEach returns the starts of the stats intervals of every slot whose counters are not expired yet.
+func (r *Redis) intervalStartsOfEach(ctx context.Context, slotIDs []string) ([][]time.Time, error) {
+	intervalsRange := r.makeIntervalsRange()
+	cmds := make([]*rediscli.StringSliceCmd, len(slotIDs))
+	_, err := r.client.Pipelined(ctx, func(pipe rediscli.Pipeliner) error {
+		for i, slotID := range slotIDs {
+			cmds[i] = pipe.ZRangeByScore(ctx, r.key(makeSlotIntervalsKey(slotID)), intervalsRange)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("zrangebyscore of intervals error: %w", err)
+	}
+	starts := make([][]time.Time, len(slotIDs))
+	for i, cmd := range cmds {
+		if starts[i], err = parseIntervalStarts(r.key(makeSlotIntervalsKey(slotIDs[i])), cmd.Val()); err != nil {
+			return nil, err
+		}
+	}
+	return starts, nil
+}
+
+// makeIntervalsRange makes a range of the slot intervals whose counters are not expired yet.
+func (r *Redis) makeIntervalsRange() *rediscli.ZRangeBy {
+	return &rediscli.ZRangeBy{
+		Min: fmt.Sprintf("(%d", r.clock.Now().Add(-intervalsTTL).Unix()),
+		Max: "+inf",
+	}
+}
+
+func parseIntervalStarts(key string, members []string) ([]time.Time, error) {
+	starts := make([]time.Time, len(members))
+	for i, member := range members {
+		start, err := strconv.ParseInt(member, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("zrangebyscore of '%s' '%s' parse int64 error: %w", key, member, err)
+		}
+		starts[i] = time.Unix(start, 0)
+	}
+	return starts, nil
+}
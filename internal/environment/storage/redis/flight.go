@@ -0,0 +1,103 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ekhvalov/otus-banners-rotation/internal/app"
+	rediscli "github.com/go-redis/redis/v9"
+)
+
+// flightSeparator separates the start and the end of a flight value.
+const flightSeparator = ","
+
+// flightToValue returns the value of a flight as "START,END" unix seconds, a zero is unbounded.
+func flightToValue(flight app.Flight) string {
+	return formatFlightTime(flight.Start) + flightSeparator + formatFlightTime(flight.End)
+}
+
+func formatFlightTime(t time.Time) string {
+	if t.IsZero() {
+		return "0"
+	}
+	return strconv.FormatInt(t.Unix(), 10)
+}
+
+func parseFlight(value string) (app.Flight, error) {
+	start, end, ok := strings.Cut(value, flightSeparator)
+	if !ok {
+		return app.Flight{}, fmt.Errorf("flight '%s' is not a START,END", value)
+	}
+	var flight app.Flight
+	var err error
+	if flight.Start, err = parseFlightTime(start); err != nil {
+		return app.Flight{}, fmt.Errorf("flight '%s' start error: %w", value, err)
+	}
+	if flight.End, err = parseFlightTime(end); err != nil {
+		return app.Flight{}, fmt.Errorf("flight '%s' end error: %w", value, err)
+	}
+	return flight, nil
+}
+
+func parseFlightTime(value string) (time.Time, error) {
+	seconds, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("parse int error: %w", err)
+	}
+	if seconds == 0 {
+		return time.Time{}, nil
+	}
+	return time.Unix(seconds, 0), nil
+}
+
+// queueSetFlight queues the replacement of the flight of a banner, the unbounded flight is not kept.
+func (r *Redis) queueSetFlight(ctx context.Context, pipe rediscli.Pipeliner, bannerID string, flight app.Flight) {
+	if flight.IsZero() {
+		pipe.HDel(ctx, r.key(keyBannerFlights), bannerID)
+		return
+	}
+	pipe.HSet(ctx, r.key(keyBannerFlights), bannerID, flightToValue(flight))
+}
+
+// getFlights returns the flights of the banners, the unbounded flight in case of a banner has no flight.
+func (r *Redis) getFlights(ctx context.Context, bannerIDs []string) ([]app.Flight, error) {
+	flights := make([]app.Flight, len(bannerIDs))
+	if len(bannerIDs) == 0 {
+		return flights, nil
+	}
+	values, err := r.client.HMGet(ctx, r.key(keyBannerFlights), bannerIDs...).Result()
+	if err != nil {
+		return nil, fmt.Errorf("hmget of '%s' error: %w", r.key(keyBannerFlights), err)
+	}
+	for i, value := range values {
+		s, ok := value.(string)
+		if !ok {
+			continue
+		}
+		if flights[i], err = parseFlight(s); err != nil {
+			return nil, fmt.Errorf("parse of '%s' '%s' error: %w", r.key(keyBannerFlights), bannerIDs[i], err)
+		}
+	}
+	return flights, nil
+}
+
+func (r *Redis) GetBannerFlights(ctx context.Context, bannerIDs []string) (map[string]app.Flight, error) {
+	flights, err := r.getFlights(ctx, bannerIDs)
+	if err != nil {
+		return nil, err
+	}
+	var result map[string]app.Flight
+	for i, flight := range flights {
+		if flight.IsZero() {
+			continue
+		}
+		if result == nil {
+			result = make(map[string]app.Flight)
+		}
+		result[bannerIDs[i]] = flight
+	}
+	return result, nil
+}
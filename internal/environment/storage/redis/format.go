@@ -0,0 +1,77 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ekhvalov/otus-banners-rotation/internal/app"
+	rediscli "github.com/go-redis/redis/v9"
+)
+
+const (
+	fieldFormatSizes     = "sizes"
+	fieldFormatMIMETypes = "mime_types"
+	// formatListSeparator separates the values of a format field, it is never a part of a size or a media type
+	// without parameters.
+	formatListSeparator = ","
+)
+
+// slotFormatToFields returns the hash fields of the non-empty lists of a slot format.
+func slotFormatToFields(format app.SlotFormat) map[string]interface{} {
+	fields := make(map[string]interface{})
+	if len(format.Sizes) > 0 {
+		sizes := make([]string, len(format.Sizes))
+		for i, size := range format.Sizes {
+			sizes[i] = size.String()
+		}
+		fields[fieldFormatSizes] = strings.Join(sizes, formatListSeparator)
+	}
+	if len(format.MIMETypes) > 0 {
+		fields[fieldFormatMIMETypes] = strings.Join(format.MIMETypes, formatListSeparator)
+	}
+	return fields
+}
+
+func fieldsToSlotFormat(fields map[string]string) (app.SlotFormat, error) {
+	var format app.SlotFormat
+	if sizes := fields[fieldFormatSizes]; sizes != "" {
+		for _, value := range strings.Split(sizes, formatListSeparator) {
+			size, err := parseSize(value)
+			if err != nil {
+				return app.SlotFormat{}, fmt.Errorf("field '%s' error: %w", fieldFormatSizes, err)
+			}
+			format.Sizes = append(format.Sizes, size)
+		}
+	}
+	if mimeTypes := fields[fieldFormatMIMETypes]; mimeTypes != "" {
+		format.MIMETypes = strings.Split(mimeTypes, formatListSeparator)
+	}
+	return format, nil
+}
+
+func parseSize(value string) (app.Size, error) {
+	width, height, ok := strings.Cut(value, "x")
+	if !ok {
+		return app.Size{}, fmt.Errorf("size '%s' is not a WIDTHxHEIGHT", value)
+	}
+	var size app.Size
+	var err error
+	if size.Width, err = strconv.Atoi(width); err != nil {
+		return app.Size{}, fmt.Errorf("size '%s' width parse int error: %w", value, err)
+	}
+	if size.Height, err = strconv.Atoi(height); err != nil {
+		return app.Size{}, fmt.Errorf("size '%s' height parse int error: %w", value, err)
+	}
+	return size, nil
+}
+
+// queueSetSlotFormat queues the replacement of the format of a slot.
+func (r *Redis) queueSetSlotFormat(ctx context.Context, pipe rediscli.Pipeliner, slotID string, format app.SlotFormat) {
+	formatKey := r.key(makeSlotFormatKey(slotID))
+	pipe.Del(ctx, formatKey)
+	if fields := slotFormatToFields(format); len(fields) > 0 {
+		pipe.HSet(ctx, formatKey, fields)
+	}
+}
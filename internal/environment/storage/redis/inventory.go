@@ -0,0 +1,275 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/ekhvalov/otus-banners-rotation/internal/app"
+	rediscli "github.com/go-redis/redis/v9"
+)
+
+func (r *Redis) GetBanner(ctx context.Context, id string) (app.Banner, error) {
+	description, err := r.hGetDescription(ctx, keyBanners, id)
+	if err != nil {
+		if errors.Is(err, rediscli.Nil) {
+			return app.Banner{}, app.NewErrNotFound(fmt.Sprintf("banner with id '%s' is not found", id))
+		}
+		return app.Banner{}, err
+	}
+	return r.makeBanner(ctx, id, description)
+}
+
+func (r *Redis) GetSocialGroup(ctx context.Context, id string) (app.SocialGroup, error) {
+	description, err := r.hGetDescription(ctx, keySocialGroups, id)
+	if err != nil {
+		if errors.Is(err, rediscli.Nil) {
+			return app.SocialGroup{}, app.NewErrNotFound(fmt.Sprintf("socialGroup with id '%s' is not found", id))
+		}
+		return app.SocialGroup{}, err
+	}
+	return app.SocialGroup{ID: id, Description: description}, nil
+}
+
+func (r *Redis) UpdateBanner(ctx context.Context, id, description string) (app.Banner, error) {
+	if err := r.updateDescription(ctx, keyBanners, id, description); err != nil {
+		if errors.Is(err, rediscli.Nil) {
+			return app.Banner{}, app.NewErrNotFound(fmt.Sprintf("banner with id '%s' is not found", id))
+		}
+		return app.Banner{}, err
+	}
+	return r.makeBanner(ctx, id, description)
+}
+
+func (r *Redis) UpdateSlot(ctx context.Context, id, description string) (app.Slot, error) {
+	if err := r.updateDescription(ctx, keySlots, id, description); err != nil {
+		if errors.Is(err, rediscli.Nil) {
+			return app.Slot{}, app.NewErrNotFound(fmt.Sprintf("slot with id '%s' is not found", id))
+		}
+		return app.Slot{}, err
+	}
+	return r.GetSlot(ctx, id)
+}
+
+func (r *Redis) UpdateSocialGroup(ctx context.Context, id, description string) (app.SocialGroup, error) {
+	if err := r.updateDescription(ctx, keySocialGroups, id, description); err != nil {
+		if errors.Is(err, rediscli.Nil) {
+			return app.SocialGroup{}, app.NewErrNotFound(fmt.Sprintf("socialGroup with id '%s' is not found", id))
+		}
+		return app.SocialGroup{}, err
+	}
+	return app.SocialGroup{ID: id, Description: description}, nil
+}
+
+// ListBanners reads the creatives and the flights of the listed banners after the descriptions, so a banner
+// which is deleted concurrently may be listed without them.
+func (r *Redis) ListBanners(ctx context.Context, filter app.ListFilter) ([]app.Banner, error) {
+	ids, descriptions, err := r.listDescriptions(ctx, keyBanners, filter)
+	if err != nil {
+		return nil, err
+	}
+	creatives, err := r.getCreatives(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	flights, err := r.getFlights(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	var banners []app.Banner
+	for i, id := range ids {
+		banners = append(banners,
+			app.Banner{ID: id, Description: descriptions[id], Creative: creatives[i], Flight: flights[i]})
+	}
+	return banners, nil
+}
+
+// ListSlots reads the strategies and the formats of the listed slots by a pipeline, so a slot which is deleted
+// concurrently may be listed without them.
+func (r *Redis) ListSlots(ctx context.Context, filter app.ListFilter) ([]app.Slot, error) {
+	ids, descriptions, err := r.listDescriptions(ctx, keySlots, filter)
+	if err != nil {
+		return nil, err
+	}
+	return r.getSlots(ctx, ids, descriptions)
+}
+
+// getSlots returns the slots with the strategies and the formats read by a pipeline.
+func (r *Redis) getSlots(ctx context.Context, ids []string, descriptions map[string]string) ([]app.Slot, error) {
+	strategyCmds := make([]*rediscli.MapStringStringCmd, len(ids))
+	formatCmds := make([]*rediscli.MapStringStringCmd, len(ids))
+	_, err := r.client.Pipelined(ctx, func(pipe rediscli.Pipeliner) error {
+		for i, id := range ids {
+			strategyCmds[i] = pipe.HGetAll(ctx, r.key(makeSlotStrategyKey(id)))
+			formatCmds[i] = pipe.HGetAll(ctx, r.key(makeSlotFormatKey(id)))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("hgetall of slots strategies and formats error: %w", err)
+	}
+	var slots []app.Slot
+	for i, id := range ids {
+		strategy, err := fieldsToStrategyParams(strategyCmds[i].Val())
+		if err != nil {
+			return nil, fmt.Errorf("parse of '%s' error: %w", r.key(makeSlotStrategyKey(id)), err)
+		}
+		format, err := fieldsToSlotFormat(formatCmds[i].Val())
+		if err != nil {
+			return nil, fmt.Errorf("parse of '%s' error: %w", r.key(makeSlotFormatKey(id)), err)
+		}
+		slots = append(slots, app.Slot{ID: id, Description: descriptions[id], Strategy: strategy, Format: format})
+	}
+	return slots, nil
+}
+
+func (r *Redis) ListSocialGroups(ctx context.Context, filter app.ListFilter) ([]app.SocialGroup, error) {
+	ids, descriptions, err := r.listDescriptions(ctx, keySocialGroups, filter)
+	if err != nil {
+		return nil, err
+	}
+	var socialGroups []app.SocialGroup
+	for _, id := range ids {
+		socialGroups = append(socialGroups, app.SocialGroup{ID: id, Description: descriptions[id]})
+	}
+	return socialGroups, nil
+}
+
+func (r *Redis) ListSlotBanners(ctx context.Context, slotID string, filter app.ListFilter) ([]app.Attachment, error) {
+	if err := r.hasSlot(ctx, slotID); err != nil {
+		return nil, err
+	}
+	slotBannersKey := r.key(makeSlotBannersKey(slotID))
+	banners, err := r.client.ZRangeWithScores(ctx, slotBannersKey, 0, -1).Result()
+	if err != nil {
+		return nil, fmt.Errorf("zrange of '%s' error: %w", slotBannersKey, err)
+	}
+	var attachments []app.Attachment
+	for _, z := range banners {
+		if attachment := makeAttachment(slotID, z); attachment.BannerID > filter.AfterID {
+			attachments = append(attachments, attachment)
+		}
+	}
+	sort.Slice(attachments, func(i, j int) bool {
+		return attachments[i].BannerID < attachments[j].BannerID
+	})
+	return limitAttachments(attachments, filter.Limit), nil
+}
+
+// ListBannerSlots reads the scores of the banner in the slots by a pipeline, so a slot which is deleted concurrently
+// is not listed.
+func (r *Redis) ListBannerSlots(ctx context.Context, bannerID string, filter app.ListFilter) ([]app.Attachment, error) {
+	if err := r.hasBanner(ctx, bannerID); err != nil {
+		return nil, err
+	}
+	slotIDs, err := r.client.HKeys(ctx, r.key(keySlots)).Result()
+	if err != nil {
+		return nil, fmt.Errorf("hkeys of '%s' error: %w", r.key(keySlots), err)
+	}
+	sort.Strings(slotIDs)
+	cmds := make([]*rediscli.FloatCmd, len(slotIDs))
+	err = r.execPipelined(ctx, func(pipe rediscli.Pipeliner) {
+		for i, slotID := range slotIDs {
+			if slotID > filter.AfterID {
+				cmds[i] = pipe.ZScore(ctx, r.key(makeSlotBannersKey(slotID)), bannerID)
+			}
+		}
+	})
+	if err != nil {
+		return nil, fmt.Errorf("zscore of banner '%s' error: %w", bannerID, err)
+	}
+	var attachments []app.Attachment
+	for i, cmd := range cmds {
+		if cmd == nil {
+			continue
+		}
+		score, err := cmd.Result()
+		if errors.Is(err, rediscli.Nil) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("zscore of '%s' '%s' error: %w", r.key(makeSlotBannersKey(slotIDs[i])), bannerID, err)
+		}
+		attachments = append(attachments, makeAttachment(slotIDs[i], rediscli.Z{Score: score, Member: bannerID}))
+	}
+	return limitAttachments(attachments, filter.Limit), nil
+}
+
+// makeAttachment makes the attachment of a member of the slot banners. The score of the member is the unix time
+// of the attachment, the attachments made before the time was stored have the +Inf score and the zero time.
+func makeAttachment(slotID string, z rediscli.Z) app.Attachment {
+	attachment := app.Attachment{SlotID: slotID, BannerID: fmt.Sprint(z.Member)}
+	if !math.IsInf(z.Score, 0) && z.Score > 0 {
+		attachment.AttachedAt = time.Unix(int64(z.Score), 0)
+	}
+	return attachment
+}
+
+func limitAttachments(attachments []app.Attachment, limit int) []app.Attachment {
+	if limit > 0 && len(attachments) > limit {
+		return attachments[:limit]
+	}
+	return attachments
+}
+
+// makeBanner returns a banner with its creative.
+func (r *Redis) makeBanner(ctx context.Context, id, description string) (app.Banner, error) {
+	creatives, err := r.getCreatives(ctx, []string{id})
+	if err != nil {
+		return app.Banner{}, err
+	}
+	flights, err := r.getFlights(ctx, []string{id})
+	if err != nil {
+		return app.Banner{}, err
+	}
+	return app.Banner{ID: id, Description: description, Creative: creatives[0], Flight: flights[0]}, nil
+}
+
+// hGetDescription returns the description of an entity. Returns redis.Nil in case of the entity is not found.
+func (r *Redis) hGetDescription(ctx context.Context, name, id string) (string, error) {
+	description, err := r.client.HGet(ctx, r.key(name), id).Result()
+	if err != nil && !errors.Is(err, rediscli.Nil) {
+		return "", fmt.Errorf("hget of '%s' '%s' error: %w", r.key(name), id, err)
+	}
+	return description, err
+}
+
+// updateDescription sets the description of an entity by the updateDescriptionScript.
+// Returns redis.Nil in case of the entity is not found.
+func (r *Redis) updateDescription(ctx context.Context, name, id, description string) error {
+	updated, err := updateDescriptionScript.Run(ctx, r.client, []string{r.key(name)}, id, description).Int()
+	if err != nil {
+		return fmt.Errorf("update description of '%s' '%s' error: %w", r.key(name), id, err)
+	}
+	if updated == 0 {
+		return rediscli.Nil
+	}
+	return nil
+}
+
+// listDescriptions returns the sorted ids of the entities selected by the filter and the descriptions
+// of the entities. The descriptions are kept in a hash, so all of them are read.
+func (r *Redis) listDescriptions(
+	ctx context.Context,
+	name string,
+	filter app.ListFilter,
+) ([]string, map[string]string, error) {
+	descriptions, err := r.client.HGetAll(ctx, r.key(name)).Result()
+	if err != nil {
+		return nil, nil, fmt.Errorf("hgetall of '%s' error: %w", r.key(name), err)
+	}
+	var ids []string
+	for id, description := range descriptions {
+		if filter.Match(id, description) {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+	if filter.Limit > 0 && len(ids) > filter.Limit {
+		ids = ids[:filter.Limit]
+	}
+	return ids, descriptions, nil
+}
@@ -0,0 +1,105 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	rediscli "github.com/go-redis/redis/v9"
+)
+
+// keyNamePatterns match the names of all keys of the storage.
+var keyNamePatterns = []string{
+	keyBanners, keySlots, keySocialGroups, keyBannerFlights, "banner:*", "slot:*", "social_group:*",
+}
+
+// RenameKeys moves the keys of the storage from the namespace with the prefix into the namespace
+// of the storage. The keys without the hash tags of the slot and social group ids are renamed to the tagged ones,
+// so the prefix can be the storage one. The keys of the storage are never overwritten, the renaming stops
+// on the first existing one. It should be run while the storage is not used.
+// Returns the number of the renamed keys.
+func (r *Redis) RenameKeys(ctx context.Context, fromPrefix string) (int, error) {
+	var keys []string
+	for _, pattern := range keyNamePatterns {
+		patternKeys, err := r.scanKeys(ctx, escapeGlob(fromPrefix)+pattern)
+		if err != nil {
+			return 0, err
+		}
+		keys = append(keys, patternKeys...)
+	}
+	renamed := 0
+	seen := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		newKey := r.key(tagKeyName(strings.TrimPrefix(key, fromPrefix)))
+		// A key can be returned by the scan more than once.
+		if seen[key] || newKey == key {
+			continue
+		}
+		seen[key] = true
+		ok, err := r.client.RenameNX(ctx, key, newKey).Result()
+		if err != nil {
+			return renamed, fmt.Errorf("renamenx of '%s' to '%s' error: %w", key, newKey, err)
+		}
+		if !ok {
+			return renamed, fmt.Errorf("renamenx of '%s' error: key '%s' already exists", key, newKey)
+		}
+		renamed++
+	}
+	return renamed, nil
+}
+
+// scanKeys returns the keys matching the pattern, all master nodes of a cluster are scanned.
+func (r *Redis) scanKeys(ctx context.Context, match string) ([]string, error) {
+	var mu sync.Mutex
+	var keys []string
+	scan := func(ctx context.Context, client *rediscli.Client) error {
+		iter := client.Scan(ctx, 0, match, 0).Iterator()
+		for iter.Next(ctx) {
+			mu.Lock()
+			keys = append(keys, iter.Val())
+			mu.Unlock()
+		}
+		return iter.Err()
+	}
+	var err error
+	switch client := r.client.(type) {
+	case *rediscli.ClusterClient:
+		err = client.ForEachMaster(ctx, scan)
+	case *rediscli.Client:
+		err = scan(ctx, client)
+	default:
+		err = fmt.Errorf("unexpected client type %T", client)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("scan of '%s' error: %w", match, err)
+	}
+	return keys, nil
+}
+
+// tagKeyName adds the hash tag to the id of a slot or social group key name which has no tag.
+func tagKeyName(name string) string {
+	for _, kind := range []string{"slot:", "social_group:"} {
+		if !strings.HasPrefix(name, kind) || strings.HasPrefix(name, kind+"{") {
+			continue
+		}
+		parts := strings.SplitN(strings.TrimPrefix(name, kind), ":", 2)
+		if len(parts) != 2 {
+			return name
+		}
+		return fmt.Sprintf("%s{%s}:%s", kind, parts[0], parts[1])
+	}
+	return name
+}
+
+// escapeGlob escapes the special characters of the glob-style patterns.
+func escapeGlob(s string) string {
+	var b strings.Builder
+	for _, c := range s {
+		if strings.ContainsRune(`*?[]\`, c) {
+			b.WriteByte('\\')
+		}
+		b.WriteRune(c)
+	}
+	return b.String()
+}
@@ -0,0 +1,142 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ekhvalov/otus-banners-rotation/internal/app"
+	rediscli "github.com/go-redis/redis/v9"
+)
+
+const (
+	fieldLinearAPrefix = "a"
+	fieldLinearBPrefix = "b"
+)
+
+func (r *Redis) GetLinearStats(ctx context.Context, slotID string, dimension int) ([]app.LinearStats, error) {
+	if err := r.hasSlot(ctx, slotID); err != nil {
+		return nil, err
+	}
+	bannerIDs, err := r.getAttachedBannerIDs(ctx, slotID)
+	if err != nil {
+		return nil, err
+	}
+	cmds := make([]*rediscli.MapStringStringCmd, len(bannerIDs))
+	_, err = r.client.Pipelined(ctx, func(pipe rediscli.Pipeliner) error {
+		for i, bannerID := range bannerIDs {
+			cmds[i] = pipe.HGetAll(ctx, r.key(makeSlotBannerLinearKey(slotID, bannerID)))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("linear stats of slot '%s' error: %w", slotID, err)
+	}
+	stats := make([]app.LinearStats, len(bannerIDs))
+	for i, bannerID := range bannerIDs {
+		stats[i], err = fieldsToLinearStats(bannerID, dimension, cmds[i].Val())
+		if err != nil {
+			return nil, fmt.Errorf("parse of '%s' error: %w", r.key(makeSlotBannerLinearKey(slotID, bannerID)), err)
+		}
+	}
+	return stats, nil
+}
+
+func (r *Redis) RegisterLinearSelect(ctx context.Context, slotID, bannerID string, vector []float64) error {
+	if err := r.hasBannerAttachedToSlot(ctx, slotID, bannerID); err != nil {
+		return err
+	}
+	linearKey := r.key(makeSlotBannerLinearKey(slotID, bannerID))
+	_, err := r.client.TxPipelined(ctx, func(pipe rediscli.Pipeliner) error {
+		pipe.SAdd(ctx, r.key(makeSlotLinearBannersKey(slotID)), bannerID)
+		for i, xi := range vector {
+			if xi == 0 {
+				continue
+			}
+			for j, xj := range vector {
+				if xj == 0 {
+					continue
+				}
+				pipe.HIncrByFloat(ctx, linearKey, makeLinearAField(i, j), xi*xj)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("hincrbyfloat of '%s' error: %w", linearKey, err)
+	}
+	return nil
+}
+
+func (r *Redis) RegisterLinearClick(ctx context.Context, slotID, bannerID string, vector []float64) error {
+	if err := r.hasBannerAttachedToSlot(ctx, slotID, bannerID); err != nil {
+		return err
+	}
+	linearKey := r.key(makeSlotBannerLinearKey(slotID, bannerID))
+	_, err := r.client.TxPipelined(ctx, func(pipe rediscli.Pipeliner) error {
+		pipe.SAdd(ctx, r.key(makeSlotLinearBannersKey(slotID)), bannerID)
+		for i, xi := range vector {
+			if xi != 0 {
+				pipe.HIncrByFloat(ctx, linearKey, makeLinearBField(i), xi)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("hincrbyfloat of '%s' error: %w", linearKey, err)
+	}
+	return nil
+}
+
+// fieldsToLinearStats parses the sparse hash fields. Fields out of the dimension are ignored.
+func fieldsToLinearStats(bannerID string, dimension int, fields map[string]string) (app.LinearStats, error) {
+	stats := app.LinearStats{
+		BannerID: bannerID,
+		A:        make([]float64, dimension*dimension),
+		B:        make([]float64, dimension),
+	}
+	for field, str := range fields {
+		value, err := strconv.ParseFloat(str, 64)
+		if err != nil {
+			return app.LinearStats{}, fmt.Errorf("field '%s' parse float64 error: %w", field, err)
+		}
+		parts := strings.Split(field, ":")
+		indexes := make([]int, len(parts)-1)
+		for k, part := range parts[1:] {
+			if indexes[k], err = strconv.Atoi(part); err != nil {
+				return app.LinearStats{}, fmt.Errorf("field '%s' parse index error: %w", field, err)
+			}
+		}
+		switch {
+		case parts[0] == fieldLinearAPrefix && len(indexes) == 2:
+			if indexes[0] < dimension && indexes[1] < dimension {
+				stats.A[indexes[0]*dimension+indexes[1]] = value
+			}
+		case parts[0] == fieldLinearBPrefix && len(indexes) == 1:
+			if indexes[0] < dimension {
+				stats.B[indexes[0]] = value
+			}
+		default:
+			return app.LinearStats{}, fmt.Errorf("unexpected field '%s'", field)
+		}
+	}
+	return stats, nil
+}
+
+func makeLinearAField(i, j int) string {
+	return fmt.Sprintf("%s:%d:%d", fieldLinearAPrefix, i, j)
+}
+
+func makeLinearBField(i int) string {
+	return fmt.Sprintf("%s:%d", fieldLinearBPrefix, i)
+}
+
+func makeSlotBannerLinearKey(slotID, bannerID string) string {
+	return fmt.Sprintf("slot:{%s}:banner:%s:linear", slotID, bannerID)
+}
+
+// makeSlotLinearBannersKey makes a key of the banners which have the linear stats in a slot.
+func makeSlotLinearBannersKey(slotID string) string {
+	return fmt.Sprintf("slot:{%s}:linear_banners", slotID)
+}
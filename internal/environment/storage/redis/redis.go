@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"math"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/ekhvalov/otus-banners-rotation/internal/app"
 	rediscli "github.com/go-redis/redis/v9"
@@ -14,60 +16,111 @@ const (
 	keyBanners      = "banners"
 	keySlots        = "slots"
 	keySocialGroups = "social_groups"
+	// keyBannerFlights contains the flights of the banners, a banner with the unbounded flight has no field.
+	keyBannerFlights = "banner_flights"
+
+	// intervalTTL is a lifetime of the counters of a stats interval.
+	intervalTTL = app.MaxStatsHistory + app.StatsInterval
+	// intervalsTTL is a lifetime of an interval start in the slot intervals, the counters of the interval
+	// are expired by then as they are registered till the end of the interval.
+	intervalsTTL = intervalTTL + app.StatsInterval
 )
 
-func NewRedis(config Config, idGenerator IDGenerator) *Redis {
-	cli := rediscli.NewClient(&rediscli.Options{
-		Addr:     config.GetAddress(),
-		Username: config.GetUsername(),
-		Password: config.GetPassword(),
-		DB:       config.GetDatabase(),
-	})
-	return &Redis{cfg: config, client: cli, idGenerator: idGenerator}
+// NewRedis creates a storage on the redis client of the config.
+// The clock is used for the attach times and the stats intervals.
+func NewRedis(config Config, idGenerator IDGenerator, deleteMode app.DeleteMode, clock app.Clock) (*Redis, error) {
+	keyPrefix := config.GetKeyPrefix()
+	// A brace of the prefix would change the hash tags of the keys.
+	if strings.ContainsAny(keyPrefix, "{}") {
+		return nil, fmt.Errorf("key prefix '%s' must not contain braces", keyPrefix)
+	}
+	cli, err := newClient(config)
+	if err != nil {
+		return nil, fmt.Errorf("create client error: %w", err)
+	}
+	return &Redis{
+		cfg:         config,
+		client:      cli,
+		idGenerator: idGenerator,
+		deleteMode:  deleteMode,
+		clock:       clock,
+		keyPrefix:   keyPrefix,
+	}, nil
 }
 
 type Redis struct {
 	cfg         Config
-	client      *rediscli.Client
+	client      rediscli.UniversalClient
 	idGenerator IDGenerator
+	deleteMode  app.DeleteMode
+	clock       app.Clock
+	keyPrefix   string
 }
 
-func (r *Redis) CreateBanner(ctx context.Context, description string) (id string, err error) {
+// Close closes the client.
+func (r *Redis) Close() error {
+	return r.client.Close()
+}
+
+func (r *Redis) CreateBanner(
+	ctx context.Context, description string, creative app.Creative, flight app.Flight,
+) (id string, err error) {
 	id = r.idGenerator.GenerateID()
-	if err = r.hSet(ctx, keyBanners, id, description); err != nil {
-		return "", err
+	_, err = r.client.TxPipelined(ctx, func(pipe rediscli.Pipeliner) error {
+		pipe.HSet(ctx, r.key(keyBanners), id, description)
+		r.queueSetCreative(ctx, pipe, id, creative)
+		r.queueSetFlight(ctx, pipe, id, flight)
+		return nil
+	})
+	if err != nil {
+		return "", fmt.Errorf("hset of '%s' '%s' error: %w", r.key(keyBanners), id, err)
 	}
 	return id, nil
 }
 
-func (r *Redis) DeleteBanner(ctx context.Context, id string) error {
-	return r.hDel(ctx, keyBanners, id)
-}
-
-func (r *Redis) CreateSlot(ctx context.Context, description string) (id string, err error) {
+func (r *Redis) CreateSlot(
+	ctx context.Context,
+	description string,
+	strategy app.StrategyParams,
+	format app.SlotFormat,
+) (id string, err error) {
 	id = r.idGenerator.GenerateID()
-	if err = r.hSet(ctx, keySlots, id, description); err != nil {
-		return "", err
+	strategyKey := r.key(makeSlotStrategyKey(id))
+	_, err = r.client.TxPipelined(ctx, func(pipe rediscli.Pipeliner) error {
+		pipe.HSet(ctx, r.key(keySlots), id, description)
+		pipe.HSet(ctx, strategyKey, strategyParamsToFields(strategy))
+		r.queueSetSlotFormat(ctx, pipe, id, format)
+		return nil
+	})
+	if err != nil {
+		return "", fmt.Errorf("hset of '%s' '%s' error: %w", r.key(keySlots), id, err)
 	}
 	return id, nil
 }
 
-func (r *Redis) DeleteSlot(ctx context.Context, id string) error {
-	return r.hDel(ctx, keySlots, id)
+func (r *Redis) GetSlot(ctx context.Context, id string) (app.Slot, error) {
+	description, err := r.client.HGet(ctx, r.key(keySlots), id).Result()
+	if err != nil {
+		if errors.Is(err, rediscli.Nil) {
+			return app.Slot{}, app.NewErrNotFound(fmt.Sprintf("slot with id '%s' is not found", id))
+		}
+		return app.Slot{}, fmt.Errorf("hget of '%s' '%s' error: %w", r.key(keySlots), id, err)
+	}
+	slots, err := r.getSlots(ctx, []string{id}, map[string]string{id: description})
+	if err != nil {
+		return app.Slot{}, err
+	}
+	return slots[0], nil
 }
 
 func (r *Redis) CreateSocialGroup(ctx context.Context, description string) (id string, err error) {
 	id = r.idGenerator.GenerateID()
-	if err = r.hSet(ctx, keySocialGroups, id, description); err != nil {
+	if err = r.hSet(ctx, r.key(keySocialGroups), id, description); err != nil {
 		return "", err
 	}
 	return id, nil
 }
 
-func (r *Redis) DeleteSocialGroup(ctx context.Context, id string) error {
-	return r.hDel(ctx, keySocialGroups, id)
-}
-
 func (r *Redis) AttachBanner(ctx context.Context, slotID, bannerID string) error {
 	if err := r.hasBanner(ctx, bannerID); err != nil {
 		return err
@@ -75,123 +128,181 @@ func (r *Redis) AttachBanner(ctx context.Context, slotID, bannerID string) error
 	if err := r.hasSlot(ctx, slotID); err != nil {
 		return err
 	}
-	return r.zAdd(ctx, makeSlotBannersKey(slotID), bannerID, math.Inf(1))
+	return r.zAddNX(ctx, r.key(makeSlotBannersKey(slotID)), bannerID, float64(r.clock.Now().Unix()))
 }
 
 func (r *Redis) DetachBanner(ctx context.Context, slotID, bannerID string) error {
 	if err := r.hasBannerAttachedToSlot(ctx, slotID, bannerID); err != nil {
 		return err
 	}
-	return r.zRem(ctx, makeSlotBannersKey(slotID), bannerID)
+	return r.zRem(ctx, r.key(makeSlotBannersKey(slotID)), bannerID)
 }
 
-func (r *Redis) SelectBanner(ctx context.Context, slotID, socialGroupID string) (bannerID string, err error) {
-	if err = r.hasSlot(ctx, slotID); err != nil {
-		return "", err
-	}
-	if err = r.hasSocialGroup(ctx, socialGroupID); err != nil {
-		return "", err
-	}
-	scoresKey := makeSlotSocialGroupScoresKey(slotID, socialGroupID)
-	keyCount, err := r.client.Exists(ctx, scoresKey).Result()
+func (r *Redis) GetSlotStats(ctx context.Context, slotID, socialGroupID string) (app.SlotStats, error) {
+	bannerIDs, err := r.getSlotBannerIDs(ctx, slotID, socialGroupID)
 	if err != nil {
-		return "", fmt.Errorf("exists of '%s' error: %w", scoresKey, err)
-	}
-	if keyCount == 0 {
-		slotBannersKey := makeSlotBannersKey(slotID)
-		err = r.client.Copy(ctx, slotBannersKey, scoresKey, r.cfg.GetDatabase(), false).Err()
-		if err != nil {
-			return "", fmt.Errorf("copy of '%s' to '%s' error: %w", slotBannersKey, scoresKey, err)
-		}
-	}
-	bannerIDs, err := r.client.ZRevRange(ctx, scoresKey, 0, 0).Result()
+		return app.SlotStats{}, err
+	}
+	slotGroupKeys := r.counterKeys(makeSlotSocialGroupCounterKeys(slotID, socialGroupID))
+	var slotGroupCmds, slotCmds counterCmds
+	var groupSlotsCmd *rediscli.StringSliceCmd
+	err = r.execPipelined(ctx, func(pipe rediscli.Pipeliner) {
+		slotGroupCmds = queueGetCounters(ctx, pipe, slotGroupKeys, bannerIDs)
+		slotCmds = queueGetCounters(ctx, pipe, r.counterKeys(makeSlotCounterKeys(slotID)), bannerIDs)
+		groupSlotsCmd = pipe.SMembers(ctx, r.key(makeSocialGroupSlotsKey(socialGroupID)))
+	})
 	if err != nil {
-		return "", fmt.Errorf("zrevrange of '%s' error: %w", scoresKey, err)
-	}
-	if len(bannerIDs) == 0 {
-		return "", app.ErrNoBannersFound
+		return app.SlotStats{}, fmt.Errorf("stats of slot '%s' social group '%s' error: %w", slotID, socialGroupID, err)
 	}
-	bannerID = bannerIDs[0]
-	selectsKey := makeSlotSocialGroupSelectsKey(slotID, socialGroupID)
-	selects, err := r.client.HIncrBy(ctx, selectsKey, bannerID, 1).Result()
+	slotGroup, err := slotGroupCmds.result(bannerIDs)
 	if err != nil {
-		return "", fmt.Errorf("hincrby of '%s' error: %w", selectsKey, err)
+		return app.SlotStats{}, err
 	}
-	clicksKey := makeSlotSocialGroupClicksKey(slotID, socialGroupID)
-	clicks, err := r.hGetInt64OrDefault(ctx, clicksKey, bannerID, 0)
+	slot, err := slotCmds.result(bannerIDs)
 	if err != nil {
-		return "", err
+		return app.SlotStats{}, err
 	}
-	totalSelectsKey := makeSlotSocialGroupSelectsTotalKey(slotID, socialGroupID)
-	totalSelects, err := r.client.Incr(ctx, totalSelectsKey).Result()
+	other, err := r.getOtherSlotsCounters(ctx, slotID, socialGroupID, groupSlotsCmd.Val(), bannerIDs)
 	if err != nil {
-		return "", fmt.Errorf("incrby of '%s' error: %w", totalSelectsKey, err)
+		return app.SlotStats{}, err
 	}
-	score := calculateBannerScore(float64(selects), float64(clicks), float64(totalSelects))
-	if err = r.zAdd(ctx, scoresKey, bannerID, score); err != nil {
-		return "", fmt.Errorf("zincrby of '%s' error: %w", scoresKey, err)
+	stats := makeSlotStats(bannerIDs, slotGroup, slot)
+	for i := range stats.Banners {
+		stats.Banners[i].OtherSelects = float64(other.selects[i])
+		stats.Banners[i].OtherClicks = float64(other.clicks[i])
 	}
-	return bannerID, nil
+	return stats, nil
 }
 
-func (r *Redis) ClickBanner(ctx context.Context, slotID, bannerID, socialGroupID string) error {
-	if err := r.hasBannerAttachedToSlot(ctx, slotID, bannerID); err != nil {
-		return err
+// getOtherSlotsCounters sums the counters of the banners for the social group in the slots except the slot.
+// The counters of every slot are in its own hash slot of a cluster, so they are summed on read and are registered
+// by the script of the slot atomically.
+func (r *Redis) getOtherSlotsCounters(
+	ctx context.Context,
+	slotID, socialGroupID string,
+	groupSlotIDs, bannerIDs []string,
+) (counters, error) {
+	other := counters{selects: make([]int64, len(bannerIDs)), clicks: make([]int64, len(bannerIDs))}
+	var cmds []counterCmds
+	err := r.execPipelined(ctx, func(pipe rediscli.Pipeliner) {
+		for _, otherSlotID := range groupSlotIDs {
+			if otherSlotID == slotID {
+				continue
+			}
+			keys := r.counterKeys(makeSlotSocialGroupCounterKeys(otherSlotID, socialGroupID))
+			keys.total = ""
+			cmds = append(cmds, queueGetCounters(ctx, pipe, keys, bannerIDs))
+		}
+	})
+	if err != nil {
+		return counters{}, fmt.Errorf("counters of social group '%s' in other slots error: %w", socialGroupID, err)
 	}
-	if err := r.hasSocialGroup(ctx, socialGroupID); err != nil {
-		return err
+	for _, cmd := range cmds {
+		result, err := cmd.result(bannerIDs)
+		if err != nil {
+			return counters{}, err
+		}
+		for i := range bannerIDs {
+			other.selects[i] += result.selects[i]
+			other.clicks[i] += result.clicks[i]
+		}
 	}
-	totalSelectsKey := makeSlotSocialGroupSelectsTotalKey(slotID, socialGroupID)
-	totalSelects, err := r.getInt64OrDefault(ctx, totalSelectsKey, 1)
+	return other, nil
+}
+
+func (r *Redis) GetSlotStatsHistory(
+	ctx context.Context,
+	slotID, socialGroupID string,
+	since time.Time,
+) ([]app.IntervalStats, error) {
+	now := r.clock.Now()
+	since, err := app.StatsHistorySince(since, now)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	selectsKey := makeSlotSocialGroupSelectsKey(slotID, socialGroupID)
-	selects, err := r.hGetInt64OrDefault(ctx, selectsKey, bannerID, 1)
+	bannerIDs, err := r.getSlotBannerIDs(ctx, slotID, socialGroupID)
 	if err != nil {
-		return err
-	}
-	clicksKey := makeSlotSocialGroupClicksKey(slotID, socialGroupID)
-	clicks, err := r.client.HIncrBy(ctx, clicksKey, bannerID, 1).Result()
+		return nil, err
+	}
+	type intervalCmds struct {
+		start     time.Time
+		slotGroup counterCmds
+		slot      counterCmds
+	}
+	var intervals []intervalCmds
+	err = r.execPipelined(ctx, func(pipe rediscli.Pipeliner) {
+		end := now.Truncate(app.StatsInterval)
+		for start := since.Truncate(app.StatsInterval); !start.After(end); start = start.Add(app.StatsInterval) {
+			slotGroupKeys := r.counterKeys(makeSlotSocialGroupIntervalCounterKeys(slotID, socialGroupID, start))
+			intervals = append(intervals, intervalCmds{
+				start:     start,
+				slotGroup: queueGetCounters(ctx, pipe, slotGroupKeys, bannerIDs),
+				slot:      queueGetCounters(ctx, pipe, r.counterKeys(makeSlotIntervalCounterKeys(slotID, start)), bannerIDs),
+			})
+		}
+	})
 	if err != nil {
-		return fmt.Errorf("hincrby of '%s' '%s' error: %w", clicksKey, bannerID, err)
+		return nil, fmt.Errorf("stats history of slot '%s' social group '%s' error: %w", slotID, socialGroupID, err)
 	}
-	scoresKey := makeSlotSocialGroupScoresKey(slotID, socialGroupID)
-	score := calculateBannerScore(float64(selects), float64(clicks), float64(totalSelects))
-	if err = r.zAdd(ctx, scoresKey, bannerID, score); err != nil {
-		return fmt.Errorf("zadd of '%s' error: %w", scoresKey, err)
+	history := make([]app.IntervalStats, len(intervals))
+	for i, interval := range intervals {
+		slotGroup, err := interval.slotGroup.result(bannerIDs)
+		if err != nil {
+			return nil, err
+		}
+		slot, err := interval.slot.result(bannerIDs)
+		if err != nil {
+			return nil, err
+		}
+		history[i] = app.IntervalStats{Start: interval.start, Stats: makeSlotStats(bannerIDs, slotGroup, slot)}
 	}
-	return nil
+	return history, nil
 }
 
-func (r *Redis) hGetInt64OrDefault(ctx context.Context, key, field string, defaultValue int64) (int64, error) {
-	cmd := r.client.HGet(ctx, key, field)
-	if cmd.Err() != nil {
-		if errors.Is(cmd.Err(), rediscli.Nil) {
-			return defaultValue, nil
-		}
-		return 0, fmt.Errorf("hget of '%s' '%s' error: %w", key, field, cmd.Err())
+// RegisterSelect increments the selects counters of a banner in the slot atomically by the registerScript.
+func (r *Redis) RegisterSelect(ctx context.Context, slotID, bannerID, socialGroupID string) error {
+	start := r.clock.Now().Truncate(app.StatsInterval)
+	var updates counterUpdates
+	updates.addSelect(r.counterKeys(makeSlotSocialGroupCounterKeys(slotID, socialGroupID)), 0)
+	updates.addSelect(r.counterKeys(makeSlotCounterKeys(slotID)), 0)
+	updates.addSelect(r.counterKeys(makeSlotSocialGroupIntervalCounterKeys(slotID, socialGroupID, start)), intervalTTL)
+	updates.addSelect(r.counterKeys(makeSlotIntervalCounterKeys(slotID, start)), intervalTTL)
+	return r.register(ctx, slotID, bannerID, socialGroupID, start, updates)
+}
+
+// RegisterClick increments the clicks counters of a banner in the slot atomically by the registerScript.
+func (r *Redis) RegisterClick(ctx context.Context, slotID, bannerID, socialGroupID string) error {
+	start := r.clock.Now().Truncate(app.StatsInterval)
+	var updates counterUpdates
+	updates.addClick(r.counterKeys(makeSlotSocialGroupCounterKeys(slotID, socialGroupID)), 0)
+	updates.addClick(r.counterKeys(makeSlotCounterKeys(slotID)), 0)
+	updates.addClick(r.counterKeys(makeSlotSocialGroupIntervalCounterKeys(slotID, socialGroupID, start)), intervalTTL)
+	updates.addClick(r.counterKeys(makeSlotIntervalCounterKeys(slotID, start)), intervalTTL)
+	return r.register(ctx, slotID, bannerID, socialGroupID, start, updates)
+}
+
+// getSlotBannerIDs returns ids of the banners attached to a slot checking the slot and social group exist.
+func (r *Redis) getSlotBannerIDs(ctx context.Context, slotID, socialGroupID string) ([]string, error) {
+	if err := r.hasSlot(ctx, slotID); err != nil {
+		return nil, err
 	}
-	value, err := cmd.Int64()
-	if err != nil {
-		return 0, fmt.Errorf("hget of '%s' '%s' parse int64 error: %w", key, field, err)
+	if err := r.hasSocialGroup(ctx, socialGroupID); err != nil {
+		return nil, err
 	}
-	return value, nil
+	return r.getAttachedBannerIDs(ctx, slotID)
 }
 
-func (r *Redis) getInt64OrDefault(ctx context.Context, key string, defaultValue int64) (int64, error) {
-	cmd := r.client.Get(ctx, key)
-	if cmd.Err() != nil {
-		if errors.Is(cmd.Err(), rediscli.Nil) {
-			return defaultValue, nil
-		}
-		return 0, fmt.Errorf("get of '%s' error: %w", key, cmd.Err())
-	}
-	value, err := cmd.Int64()
+// getAttachedBannerIDs returns ids of the banners attached to a slot.
+func (r *Redis) getAttachedBannerIDs(ctx context.Context, slotID string) ([]string, error) {
+	slotBannersKey := r.key(makeSlotBannersKey(slotID))
+	bannerIDs, err := r.client.ZRange(ctx, slotBannersKey, 0, -1).Result()
 	if err != nil {
-		return 0, fmt.Errorf("get of '%s' parse int error: %w", key, err)
+		return nil, fmt.Errorf("zrange of '%s' error: %w", slotBannersKey, err)
 	}
-	return value, nil
+	if len(bannerIDs) == 0 {
+		return nil, app.ErrNoBannersFound
+	}
+	return bannerIDs, nil
 }
 
 func (r *Redis) hSet(ctx context.Context, key, field, value string) error {
@@ -202,9 +313,9 @@ func (r *Redis) hSet(ctx context.Context, key, field, value string) error {
 }
 
 func (r *Redis) hasBanner(ctx context.Context, bannerID string) error {
-	ok, err := r.client.HExists(ctx, keyBanners, bannerID).Result()
+	ok, err := r.client.HExists(ctx, r.key(keyBanners), bannerID).Result()
 	if err != nil {
-		return fmt.Errorf("hexists of '%s' '%s' error: %w", keyBanners, bannerID, err)
+		return fmt.Errorf("hexists of '%s' '%s' error: %w", r.key(keyBanners), bannerID, err)
 	}
 	if !ok {
 		return app.NewErrNotFound(fmt.Sprintf("banner with id '%s' is not found", bannerID))
@@ -213,9 +324,9 @@ func (r *Redis) hasBanner(ctx context.Context, bannerID string) error {
 }
 
 func (r *Redis) hasSlot(ctx context.Context, slotID string) error {
-	ok, err := r.client.HExists(ctx, keySlots, slotID).Result()
+	ok, err := r.client.HExists(ctx, r.key(keySlots), slotID).Result()
 	if err != nil {
-		return fmt.Errorf("hexists of '%s' '%s' error: %w", keySlots, slotID, err)
+		return fmt.Errorf("hexists of '%s' '%s' error: %w", r.key(keySlots), slotID, err)
 	}
 	if !ok {
 		return app.NewErrNotFound(fmt.Sprintf("slot with id '%s' is not found", slotID))
@@ -224,9 +335,9 @@ func (r *Redis) hasSlot(ctx context.Context, slotID string) error {
 }
 
 func (r *Redis) hasSocialGroup(ctx context.Context, socialGroupID string) error {
-	ok, err := r.client.HExists(ctx, keySocialGroups, socialGroupID).Result()
+	ok, err := r.client.HExists(ctx, r.key(keySocialGroups), socialGroupID).Result()
 	if err != nil {
-		return fmt.Errorf("hexists of '%s' '%s' error: %w", keySocialGroups, socialGroupID, err)
+		return fmt.Errorf("hexists of '%s' '%s' error: %w", r.key(keySocialGroups), socialGroupID, err)
 	}
 	if !ok {
 		return app.NewErrNotFound(fmt.Sprintf("socialGroup with id '%s' is not found", socialGroupID))
@@ -241,7 +352,7 @@ func (r *Redis) hasBannerAttachedToSlot(ctx context.Context, slotID, bannerID st
 	if err := r.hasBanner(ctx, bannerID); err != nil {
 		return err
 	}
-	if cmd := r.client.ZScore(ctx, makeSlotBannersKey(slotID), bannerID); errors.Is(cmd.Err(), rediscli.Nil) {
+	if cmd := r.client.ZScore(ctx, r.key(makeSlotBannersKey(slotID)), bannerID); errors.Is(cmd.Err(), rediscli.Nil) {
 		return app.NewErrBannerNotAttached(slotID, bannerID)
 	}
 	return nil
@@ -254,9 +365,10 @@ func (r *Redis) hDel(ctx context.Context, key, field string) error {
 	return nil
 }
 
-func (r *Redis) zAdd(ctx context.Context, key, field string, score float64) error {
+// zAddNX adds the field to the sorted set, the score of an existing field is not changed.
+func (r *Redis) zAddNX(ctx context.Context, key, field string, score float64) error {
 	z := rediscli.Z{Score: score, Member: field}
-	if err := r.client.ZAdd(ctx, key, z).Err(); err != nil {
+	if err := r.client.ZAddNX(ctx, key, z).Err(); err != nil {
 		return fmt.Errorf("zadd of '%s' '%s' error: %w", key, field, err)
 	}
 	return nil
@@ -269,8 +381,107 @@ func (r *Redis) zRem(ctx context.Context, key, field string) error {
 	return nil
 }
 
+func parseInt64s(key string, fields []string, values []interface{}) ([]int64, error) {
+	result := make([]int64, len(values))
+	for i, value := range values {
+		if value == nil {
+			continue
+		}
+		str, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("hmget of '%s' '%s' unexpected value type %T", key, fields[i], value)
+		}
+		var err error
+		if result[i], err = strconv.ParseInt(str, 10, 64); err != nil {
+			return nil, fmt.Errorf("hmget of '%s' '%s' parse int64 error: %w", key, fields[i], err)
+		}
+	}
+	return result, nil
+}
+
+// makeSlotStats makes the stats of the banners from the counters of a slot for a social group
+// and the pooled counters of a slot for all social groups.
+func makeSlotStats(bannerIDs []string, slotGroup, slot counters) app.SlotStats {
+	stats := app.SlotStats{
+		Banners:            make([]app.BannerStats, len(bannerIDs)),
+		TotalSelects:       float64(slotGroup.total),
+		PooledTotalSelects: float64(slot.total),
+	}
+	for i, bannerID := range bannerIDs {
+		stats.Banners[i] = app.BannerStats{
+			BannerID:      bannerID,
+			Selects:       float64(slotGroup.selects[i]),
+			Clicks:        float64(slotGroup.clicks[i]),
+			PooledSelects: float64(slot.selects[i]),
+			PooledClicks:  float64(slot.clicks[i]),
+		}
+	}
+	return stats
+}
+
+// key prefixes a key name with the namespace of the storage.
+func (r *Redis) key(name string) string {
+	return r.keyPrefix + name
+}
+
+// counterKeys prefixes the counter key names with the namespace of the storage.
+func (r *Redis) counterKeys(keys counterKeys) counterKeys {
+	keys.selects = r.key(keys.selects)
+	keys.clicks = r.key(keys.clicks)
+	if keys.total != "" {
+		keys.total = r.key(keys.total)
+	}
+	return keys
+}
+
+// The keys of a slot and of a social group contain the hash tags of their ids, so all keys of a slot are in the same
+// hash slot of a cluster and can be used by a script.
+
 func makeSlotBannersKey(slotID string) string {
-	return fmt.Sprintf("slot:%s:banners", slotID)
+	return fmt.Sprintf("slot:{%s}:banners", slotID)
+}
+
+// makeSlotSocialGroupsKey makes a key of the social groups which have the counters in a slot.
+func makeSlotSocialGroupsKey(slotID string) string {
+	return fmt.Sprintf("slot:{%s}:social_groups", slotID)
+}
+
+// makeSlotIntervalsKey makes a key of the starts of the stats intervals which have the counters in a slot.
+// The interval counters registered before the key was introduced are not in it and are left to expire.
+func makeSlotIntervalsKey(slotID string) string {
+	return fmt.Sprintf("slot:{%s}:intervals", slotID)
+}
+
+// makeSocialGroupSlotsKey makes a key of the slots which have the counters for a social group.
+func makeSocialGroupSlotsKey(socialGroupID string) string {
+	return fmt.Sprintf("social_group:{%s}:slots", socialGroupID)
+}
+
+// makeBannerCreativeKey makes a key of the creative of a banner.
+func makeBannerCreativeKey(bannerID string) string {
+	return fmt.Sprintf("banner:{%s}:creative", bannerID)
+}
+
+func makeSlotStrategyKey(slotID string) string {
+	return fmt.Sprintf("slot:{%s}:strategy", slotID)
+}
+
+func makeSlotFormatKey(slotID string) string {
+	return fmt.Sprintf("slot:{%s}:format", slotID)
+}
+
+// makeSlotSelectsKey makes a key of the banners selects in a slot for all social groups.
+func makeSlotSelectsKey(slotID string) string {
+	return fmt.Sprintf("slot:{%s}:selects", slotID)
+}
+
+func makeSlotSelectsTotalKey(slotID string) string {
+	return fmt.Sprintf("slot:{%s}:selects_total", slotID)
+}
+
+// makeSlotClicksKey makes a key of the banners clicks in a slot for all social groups.
+func makeSlotClicksKey(slotID string) string {
+	return fmt.Sprintf("slot:{%s}:clicks", slotID)
 }
 
 func makeSlotSocialGroupSelectsKey(slotID, socialGroupID string) string {
@@ -285,15 +496,27 @@ func makeSlotSocialGroupClicksKey(slotID, socialGroupID string) string {
 	return makeSlotSocialGroupKey(slotID, socialGroupID, "clicks")
 }
 
-func makeSlotSocialGroupScoresKey(slotID, socialGroupID string) string {
-	return makeSlotSocialGroupKey(slotID, socialGroupID, "scores")
+func makeSlotSocialGroupKey(slotID, socialGroupID, suffix string) string {
+	return fmt.Sprintf("slot:{%s}:social_group:%s:%s", slotID, socialGroupID, suffix)
 }
 
-func makeSlotSocialGroupKey(slotID, socialGroupID, suffix string) string {
-	return fmt.Sprintf("slot:%s:social_group:%s:%s", slotID, socialGroupID, suffix)
+func makeSlotSocialGroupIntervalSelectsKey(slotID, socialGroupID string, start time.Time) string {
+	return makeSlotSocialGroupIntervalKey(slotID, socialGroupID, "selects", start)
+}
+
+func makeSlotSocialGroupIntervalSelectsTotalKey(slotID, socialGroupID string, start time.Time) string {
+	return makeSlotSocialGroupIntervalKey(slotID, socialGroupID, "selects_total", start)
+}
+
+func makeSlotSocialGroupIntervalClicksKey(slotID, socialGroupID string, start time.Time) string {
+	return makeSlotSocialGroupIntervalKey(slotID, socialGroupID, "clicks", start)
+}
+
+func makeSlotSocialGroupIntervalKey(slotID, socialGroupID, suffix string, start time.Time) string {
+	return makeIntervalKey(makeSlotSocialGroupKey(slotID, socialGroupID, suffix), start)
 }
 
-func calculateBannerScore(selects, clicks, totalSelects float64) float64 {
-	bannerRatio := clicks / selects
-	return bannerRatio + math.Sqrt((2.0*math.Log(totalSelects))/selects)
+// makeIntervalKey makes a key of the counters registered during a stats interval.
+func makeIntervalKey(key string, start time.Time) string {
+	return fmt.Sprintf("%s:%d", key, start.Unix())
 }
@@ -4,21 +4,23 @@ package redis
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/ekhvalov/otus-banners-rotation/internal/app"
 	"math"
-	"math/rand"
 	"os"
-	"sort"
+	"strconv"
 	"strings"
-	"sync"
 	"testing"
 	"time"
 
+	"github.com/ekhvalov/otus-banners-rotation/internal/environment/idgenerator"
 	"github.com/ekhvalov/otus-banners-rotation/internal/environment/storage/redis/mock"
+	"github.com/ekhvalov/otus-banners-rotation/internal/environment/storage/storagetest"
 	rediscli "github.com/go-redis/redis/v9"
 	"github.com/golang/mock/gomock"
 	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -28,12 +30,146 @@ const (
 	defaultRedisUsername = ""
 	defaultRedisPassword = ""
 	defaultRedisDatabase = "10"
+
+	testKeyPrefix = "test:"
 )
 
 func TestRedisStorage(t *testing.T) {
 	suite.Run(t, new(redisSuite))
 }
 
+func TestRedisStorageConformance(t *testing.T) {
+	cfg := makeConfig()
+	client := rediscli.NewClient(&rediscli.Options{
+		Addr:     cfg.GetAddress(),
+		Username: cfg.GetUsername(),
+		Password: cfg.GetPassword(),
+		DB:       cfg.GetDatabase(),
+	})
+	defer client.Close()
+	suite.Run(t, storagetest.NewSuite(func(t *testing.T, deleteMode app.DeleteMode) app.Storage {
+		require.NoError(t, client.FlushDB(context.Background()).Err())
+		storage, err := NewRedis(cfg, idgenerator.NewUUID(), deleteMode, app.NewSystemClock())
+		require.NoError(t, err)
+		return storage
+	}))
+}
+
+func TestRedisStorageConformance_KeyPrefix(t *testing.T) {
+	cfg := makeConfig()
+	cfg.v.Set("redis.key_prefix", testKeyPrefix)
+	client := rediscli.NewClient(&rediscli.Options{
+		Addr:     cfg.GetAddress(),
+		Username: cfg.GetUsername(),
+		Password: cfg.GetPassword(),
+		DB:       cfg.GetDatabase(),
+	})
+	defer client.Close()
+	suite.Run(t, storagetest.NewSuite(func(t *testing.T, deleteMode app.DeleteMode) app.Storage {
+		require.NoError(t, client.FlushDB(context.Background()).Err())
+		storage, err := NewRedis(cfg, idgenerator.NewUUID(), deleteMode, app.NewSystemClock())
+		require.NoError(t, err)
+		return storage
+	}))
+}
+
+func TestNewClient(t *testing.T) {
+	tests := map[string]struct {
+		settings map[string]interface{}
+		check    func(t *testing.T, client rediscli.UniversalClient)
+		err      string
+	}{
+		"single": {
+			settings: map[string]interface{}{"redis.host": "localhost", "redis.port": 6379, "redis.pool_size": 5},
+			check: func(t *testing.T, client rediscli.UniversalClient) {
+				t.Helper()
+				require.IsType(t, &rediscli.Client{}, client)
+				require.Equal(t, "localhost:6379", client.(*rediscli.Client).Options().Addr)
+				require.Equal(t, 5, client.(*rediscli.Client).Options().PoolSize)
+			},
+		},
+		"sentinel": {
+			settings: map[string]interface{}{
+				"redis.mode":                 ModeSentinel,
+				"redis.addresses":            []string{"sentinel-1:26379", "sentinel-2:26379"},
+				"redis.sentinel.master_name": "master",
+			},
+			check: func(t *testing.T, client rediscli.UniversalClient) {
+				t.Helper()
+				require.IsType(t, &rediscli.Client{}, client)
+			},
+		},
+		"sentinel without master name": {
+			settings: map[string]interface{}{"redis.mode": ModeSentinel},
+			err:      "sentinel master name is not set",
+		},
+		"cluster": {
+			settings: map[string]interface{}{
+				"redis.mode":            ModeCluster,
+				"redis.addresses":       []string{"node-1:6379", "node-2:6379"},
+				"redis.tls.enabled":     true,
+				"redis.tls.server_name": "redis.local",
+			},
+			check: func(t *testing.T, client rediscli.UniversalClient) {
+				t.Helper()
+				require.IsType(t, &rediscli.ClusterClient{}, client)
+				options := client.(*rediscli.ClusterClient).Options()
+				require.Equal(t, []string{"node-1:6379", "node-2:6379"}, options.Addrs)
+				require.Equal(t, "redis.local", options.TLSConfig.ServerName)
+			},
+		},
+		"cluster with database": {
+			settings: map[string]interface{}{"redis.mode": ModeCluster, "redis.database": 1},
+			err:      "cluster supports database 0 only, got 1",
+		},
+		"cluster tls without server name": {
+			settings: map[string]interface{}{"redis.mode": ModeCluster, "redis.tls.enabled": true},
+			err:      "tls server name is not set",
+		},
+		"tls missing ca file": {
+			settings: map[string]interface{}{
+				"redis.host":        "localhost",
+				"redis.port":        6379,
+				"redis.tls.enabled": true,
+				"redis.tls.ca_file": "missing.pem",
+			},
+			err: "read of tls ca file 'missing.pem' error",
+		},
+		"unknown mode": {
+			settings: map[string]interface{}{"redis.mode": "unknown"},
+			err:      "unknown redis mode 'unknown'",
+		},
+	}
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			v := viper.New()
+			for key, value := range tt.settings {
+				v.Set(key, value)
+			}
+
+			client, err := newClient(NewConfig(v))
+
+			if tt.err != "" {
+				require.ErrorContains(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			defer client.Close()
+			tt.check(t, client)
+		})
+	}
+}
+
+func TestNewRedis_Error_KeyPrefixBraces(t *testing.T) {
+	cfg := makeConfig()
+	cfg.v.Set("redis.key_prefix", "{test}:")
+
+	_, err := NewRedis(cfg, idgenerator.NewUUID(), app.DeleteModeCascade, app.NewSystemClock())
+
+	require.ErrorContains(t, err, "must not contain braces")
+}
+
 type redisSuite struct {
 	suite.Suite
 	ctx     context.Context
@@ -70,53 +206,105 @@ func (s *redisSuite) Test_CreateBanner() {
 	description := "Banner description"
 	r := Redis{cfg: s.cfg, client: s.client, idGenerator: s.createIDGeneratorMock(bannerID)}
 
-	gotBannerID, err := r.CreateBanner(s.ctx, description)
+	creative := app.Creative{Width: 300, Height: 250, MIMEType: "image/png", Metadata: map[string]string{"alt": "Sale"}}
+
+	flight := app.Flight{Start: time.Unix(1700000000, 0)}
+
+	gotBannerID, err := r.CreateBanner(s.ctx, description, creative, flight)
 
 	s.Require().NoError(err)
 	s.Require().Equal(bannerID, gotBannerID)
 	s.Require().Equal(s.hGet(keyBanners, bannerID), description)
-}
-
-func (s *redisSuite) Test_DeleteBanner() {
-	bannerID := "100500"
-	s.hSet(keyBanners, bannerID, "description")
-	r := NewRedis(s.cfg, s.createIDGeneratorMock(""))
-
-	err := r.DeleteBanner(s.ctx, bannerID)
-
+	fields, err := s.client.HGetAll(s.ctx, makeBannerCreativeKey(bannerID)).Result()
 	s.Require().NoError(err)
-	err = s.client.HGet(s.ctx, keyBanners, bannerID).Err()
-	s.Require().ErrorIs(rediscli.Nil, err)
+	s.Require().Equal(map[string]string{
+		fieldCreativeWidth:                  "300",
+		fieldCreativeHeight:                 "250",
+		fieldCreativeMIMEType:               "image/png",
+		fieldCreativeMetadataPrefix + "alt": "Sale",
+	}, fields)
+	s.Require().Equal("1700000000,0", s.hGet(keyBannerFlights, bannerID))
 }
 
 func (s *redisSuite) Test_CreateSlot() {
 	slotID := "100500"
 	description := "slot description"
-	r := NewRedis(s.cfg, s.createIDGeneratorMock(slotID))
+	epsilon := 0.25
+	params := app.StrategyParams{Name: "epsilon_greedy", Epsilon: &epsilon}
+	format := app.SlotFormat{
+		Sizes:     []app.Size{{Width: 300, Height: 250}, {Width: 728, Height: 90}},
+		MIMETypes: []string{"image/png", "image/*"},
+	}
+	r := s.newRedis(s.cfg, s.createIDGeneratorMock(slotID))
 
-	gotSlotID, err := r.CreateSlot(s.ctx, description)
+	gotSlotID, err := r.CreateSlot(s.ctx, description, params, format)
 
 	s.Require().NoError(err)
 	s.Require().Equal(slotID, gotSlotID)
 	s.Require().Equal(s.hGet(keySlots, slotID), description)
+	s.Require().Equal("epsilon_greedy", s.hGet(makeSlotStrategyKey(slotID), fieldStrategyName))
+	s.Require().Equal(0.25, s.hGetFloat64(makeSlotStrategyKey(slotID), fieldStrategyEpsilon))
+	s.Require().Equal(strategyVersion, s.hGetInt(makeSlotStrategyKey(slotID), fieldStrategyVersion))
+	s.Require().False(s.client.HExists(s.ctx, makeSlotStrategyKey(slotID), fieldStrategyUCBExploration).Val(),
+		"the unset ucb exploration must not be stored")
+	s.Require().Equal("300x250,728x90", s.hGet(makeSlotFormatKey(slotID), fieldFormatSizes))
+	s.Require().Equal("image/png,image/*", s.hGet(makeSlotFormatKey(slotID), fieldFormatMIMETypes))
+}
+
+func (s *redisSuite) Test_GetSlot_LegacyStrategy() {
+	slotID := "100500"
+	s.seedSlot(slotID)
+	s.hSet(makeSlotStrategyKey(slotID), fieldStrategyName, "epsilon_greedy")
+	s.hSet(makeSlotStrategyKey(slotID), fieldStrategyUCBExploration, "0")
+	s.hSet(makeSlotStrategyKey(slotID), fieldStrategyEpsilon, "0.2")
+	r := s.newRedis(s.cfg, idgenerator.NewUUID())
+
+	slot, err := r.GetSlot(s.ctx, slotID)
+
+	s.Require().NoError(err)
+	epsilon := 0.2
+	s.Require().Equal(app.StrategyParams{Name: "epsilon_greedy", Epsilon: &epsilon}, slot.Strategy,
+		"the zeros of the strategy without a version must mean the defaults")
+}
+
+func (s *redisSuite) Test_GetSlot_StrategyVersion1() {
+	slotID := "100500"
+	s.seedSlot(slotID)
+	s.hSet(makeSlotStrategyKey(slotID), fieldStrategyVersion, "1")
+	s.hSet(makeSlotStrategyKey(slotID), fieldStrategyName, "ucb1")
+	s.hSet(makeSlotStrategyKey(slotID), fieldStrategyUCBExploration, "0")
+	s.hSet(makeSlotStrategyKey(slotID), fieldStrategyPriorSelects, "0")
+	s.hSet(makeSlotStrategyKey(slotID), fieldStrategyPoolingStrength, "5")
+	r := s.newRedis(s.cfg, idgenerator.NewUUID())
+
+	slot, err := r.GetSlot(s.ctx, slotID)
+
+	s.Require().NoError(err)
+	exploration, strength := 0.0, 5.0
+	s.Require().Equal(app.StrategyParams{Name: "ucb1", UCBExploration: &exploration, PoolingStrength: &strength},
+		slot.Strategy, "the zero prior and pooling params of the version 1 strategy must mean the defaults")
 }
 
 func (s *redisSuite) Test_DeleteSlot() {
 	slotID := "100500"
 	s.hSet(keySlots, slotID, "description")
-	r := NewRedis(s.cfg, s.createIDGeneratorMock(""))
+	s.hSet(makeSlotStrategyKey(slotID), fieldStrategyName, "ucb1")
+	r := s.newRedis(s.cfg, s.createIDGeneratorMock(""))
 
 	err := r.DeleteSlot(s.ctx, slotID)
 
 	s.Require().NoError(err)
 	err = s.client.HGet(s.ctx, keySlots, slotID).Err()
 	s.Require().ErrorIs(rediscli.Nil, err)
+	keysCount, err := s.client.Exists(s.ctx, makeSlotStrategyKey(slotID)).Result()
+	s.Require().NoError(err)
+	s.Require().Equal(int64(0), keysCount)
 }
 
 func (s *redisSuite) Test_CreateSocialGroup() {
 	socialGroupID := "100500"
 	description := "socialGroup description"
-	r := NewRedis(s.cfg, s.createIDGeneratorMock(socialGroupID))
+	r := s.newRedis(s.cfg, s.createIDGeneratorMock(socialGroupID))
 
 	gotSocialGroupID, err := r.CreateSocialGroup(s.ctx, description)
 
@@ -125,118 +313,22 @@ func (s *redisSuite) Test_CreateSocialGroup() {
 	s.Require().Equal(s.hGet(keySocialGroups, socialGroupID), description)
 }
 
-func (s *redisSuite) Test_DeleteSocialGroup() {
-	socialGroupID := "100500"
-	s.seedSocialGroup(socialGroupID)
-	r := NewRedis(s.cfg, s.createIDGeneratorMock(""))
-
-	err := r.DeleteSocialGroup(s.ctx, socialGroupID)
-
-	s.Require().NoError(err)
-	err = s.client.HGet(s.ctx, keySocialGroups, socialGroupID).Err()
-	s.Require().ErrorIs(rediscli.Nil, err)
-}
-
 func (s *redisSuite) Test_AttachBanner() {
 	bannerID := "100500"
 	s.seedBanner(bannerID)
 	slotID := "100600"
 	s.seedSlot(slotID)
-	r := NewRedis(s.cfg, s.createIDGeneratorMock(""))
+	r := s.newRedis(s.cfg, s.createIDGeneratorMock(""))
 
 	err := r.AttachBanner(s.ctx, slotID, bannerID)
 
 	s.Require().NoError(err)
 	bannerScore, err := s.client.ZScore(s.ctx, makeSlotBannersKey(slotID), bannerID).Result()
 	s.Require().NoError(err)
-	s.Require().True(math.IsInf(bannerScore, 1))
-}
-
-func (s *redisSuite) Test_AttachBanner_Error_BannerNotFound() {
-	bannerID := "100500"
-	slotID := "100600"
-	s.seedSlot(slotID)
-	r := NewRedis(s.cfg, s.createIDGeneratorMock(""))
-
-	err := r.AttachBanner(s.ctx, slotID, bannerID)
-
-	s.Require().Error(err)
-	var errNotFound *app.ErrNotFound
-	s.Require().ErrorAs(err, &errNotFound)
-}
-
-func (s *redisSuite) Test_AttachBanner_Error_SlotNotFound() {
-	bannerID := "100500"
-	s.seedBanner(bannerID)
-	slotID := "100600"
-	r := NewRedis(s.cfg, s.createIDGeneratorMock(""))
-
-	err := r.AttachBanner(s.ctx, slotID, bannerID)
-
-	s.Require().Error(err)
-	var errNotFound *app.ErrNotFound
-	s.Require().ErrorAs(err, &errNotFound)
-}
-
-func (s *redisSuite) Test_DetachBanner() {
-	bannerID := "100500"
-	s.seedBanner(bannerID)
-	slotID := "100600"
-	s.seedSlot(slotID)
-	s.attachBanner(slotID, bannerID)
-	r := NewRedis(s.cfg, s.createIDGeneratorMock(""))
-
-	err := r.DetachBanner(s.ctx, slotID, bannerID)
-
-	s.Require().NoError(err)
-	err = s.client.ZScore(s.ctx, makeSlotBannersKey(slotID), bannerID).Err()
-	s.Require().ErrorIs(rediscli.Nil, err)
+	s.Require().InDelta(float64(time.Now().Unix()), bannerScore, 2)
 }
 
-func (s *redisSuite) Test_DetachBanner_Error_SlotNotFound() {
-	bannerID := "100500"
-	s.seedBanner(bannerID)
-	slotID := "100600"
-	s.attachBanner(slotID, bannerID)
-	r := NewRedis(s.cfg, s.createIDGeneratorMock(""))
-
-	err := r.DetachBanner(s.ctx, slotID, bannerID)
-
-	s.Require().Error(err)
-	var errNotFound *app.ErrNotFound
-	s.Require().ErrorAs(err, &errNotFound)
-}
-
-func (s *redisSuite) Test_DetachBanner_Error_BannerNotFound() {
-	bannerID := "100500"
-	//s.seedBanner(bannerID)
-	slotID := "100600"
-	s.seedSlot(slotID)
-	s.attachBanner(slotID, bannerID)
-	r := NewRedis(s.cfg, s.createIDGeneratorMock(""))
-
-	err := r.DetachBanner(s.ctx, slotID, bannerID)
-
-	s.Require().Error(err)
-	var errNotFound *app.ErrNotFound
-	s.Require().ErrorAs(err, &errNotFound)
-}
-
-func (s *redisSuite) Test_DetachBanner_Error_BannerNotAttached() {
-	bannerID := "100500"
-	s.seedBanner(bannerID)
-	slotID := "100600"
-	s.seedSlot(slotID)
-	r := NewRedis(s.cfg, s.createIDGeneratorMock(""))
-
-	err := r.DetachBanner(s.ctx, slotID, bannerID)
-
-	s.Require().Error(err)
-	var errBannerNotAttached *app.ErrBannerNotAttached
-	s.Require().ErrorAs(err, &errBannerNotAttached)
-}
-
-func (s *redisSuite) Test_SelectBanner() {
+func (s *redisSuite) Test_GetSlotStats() {
 	slotID := "100600"
 	s.seedSlot(slotID)
 	bannerIDs := []string{"100501", "100502", "100503"}
@@ -246,253 +338,211 @@ func (s *redisSuite) Test_SelectBanner() {
 	}
 	socialGroupID := "100700"
 	s.seedSocialGroup(socialGroupID)
-	r := NewRedis(s.cfg, s.createIDGeneratorMock(""))
-
-	selectedBannerIDs := make([]string, len(bannerIDs))
-	for i := 0; i < len(selectedBannerIDs); i++ {
-		bannerID, err := r.SelectBanner(s.ctx, slotID, socialGroupID)
-		s.Require().NoError(err)
-		selectedBannerIDs[i] = bannerID
-	}
-
-	s.Require().ElementsMatch(selectedBannerIDs, bannerIDs)
-	totalSelects := s.getInt(makeSlotSocialGroupSelectsTotalKey(slotID, socialGroupID))
-	s.Require().Equal(len(selectedBannerIDs), totalSelects)
-	for _, bannerID := range selectedBannerIDs {
-		selects := s.hGetInt(makeSlotSocialGroupSelectsKey(slotID, socialGroupID), bannerID)
-		s.Require().Equal(1, selects)
-		score := s.zScore(makeSlotSocialGroupScoresKey(slotID, socialGroupID), bannerID)
-		s.Require().Less(score, math.Inf(1))
-		s.Require().GreaterOrEqual(score, 0.0)
-	}
-}
-
-func (s *redisSuite) Test_SelectBanner_Error_SlotNotFound() {
-	slotID := "100600"
-	socialGroupID := "100700"
-	s.seedSocialGroup(socialGroupID)
-	r := NewRedis(s.cfg, s.createIDGeneratorMock(""))
+	selectsKey := makeSlotSocialGroupSelectsKey(slotID, socialGroupID)
+	s.hSet(selectsKey, "100501", "10")
+	s.hSet(selectsKey, "100502", "5")
+	clicksKey := makeSlotSocialGroupClicksKey(slotID, socialGroupID)
+	s.hSet(clicksKey, "100501", "3")
+	err := s.client.Set(s.ctx, makeSlotSocialGroupSelectsTotalKey(slotID, socialGroupID), 15, 0).Err()
+	s.Require().NoError(err)
+	otherSlotID := "100601"
+	s.hSet(makeSlotSocialGroupSelectsKey(otherSlotID, socialGroupID), "100501", "20")
+	s.hSet(makeSlotSocialGroupClicksKey(otherSlotID, socialGroupID), "100501", "2")
+	s.Require().NoError(s.client.SAdd(s.ctx, makeSocialGroupSlotsKey(socialGroupID), slotID, otherSlotID).Err())
+	s.hSet(makeSlotSelectsKey(slotID), "100501", "40")
+	s.hSet(makeSlotClicksKey(slotID), "100501", "7")
+	err = s.client.Set(s.ctx, makeSlotSelectsTotalKey(slotID), 40, 0).Err()
+	s.Require().NoError(err)
+	r := s.newRedis(s.cfg, s.createIDGeneratorMock(""))
 
-	bannerID, err := r.SelectBanner(s.ctx, slotID, socialGroupID)
-	s.Require().Error(err)
-	var errNotFound *app.ErrNotFound
-	s.Require().ErrorAs(err, &errNotFound)
-	s.Require().Empty(bannerID)
-}
+	stats, err := r.GetSlotStats(s.ctx, slotID, socialGroupID)
 
-func (s *redisSuite) Test_SelectBanner_Error_SocialGroupNotFound() {
+	s.Require().NoError(err)
+	s.Require().Equal(float64(15), stats.TotalSelects)
+	s.Require().Equal(float64(40), stats.PooledTotalSelects)
+	s.Require().ElementsMatch([]app.BannerStats{
+		{
+			BannerID:      "100501",
+			Selects:       10,
+			Clicks:        3,
+			OtherSelects:  20,
+			OtherClicks:   2,
+			PooledSelects: 40,
+			PooledClicks:  7,
+		},
+		{BannerID: "100502", Selects: 5, Clicks: 0},
+		{BannerID: "100503", Selects: 0, Clicks: 0},
+	}, stats.Banners)
+}
+
+func (s *redisSuite) Test_GetSlotStatsHistory() {
 	slotID := "100600"
 	s.seedSlot(slotID)
+	bannerIDs := []string{"100501", "100502"}
+	for _, id := range bannerIDs {
+		s.seedBanner(id)
+		s.attachBanner(slotID, id)
+	}
 	socialGroupID := "100700"
-	r := NewRedis(s.cfg, s.createIDGeneratorMock(""))
+	s.seedSocialGroup(socialGroupID)
+	previous := time.Now().Truncate(app.StatsInterval).Add(-app.StatsInterval)
+	s.hSet(makeSlotSocialGroupIntervalSelectsKey(slotID, socialGroupID, previous), "100501", "4")
+	s.hSet(makeSlotSocialGroupIntervalClicksKey(slotID, socialGroupID, previous), "100501", "1")
+	err := s.client.Set(s.ctx, makeSlotSocialGroupIntervalSelectsTotalKey(slotID, socialGroupID, previous), 4, 0).Err()
+	s.Require().NoError(err)
+	r := s.newRedis(s.cfg, s.createIDGeneratorMock(""))
+	s.Require().NoError(r.RegisterSelect(s.ctx, slotID, "100502", socialGroupID))
+	s.Require().NoError(r.RegisterClick(s.ctx, slotID, "100502", socialGroupID))
 
-	bannerID, err := r.SelectBanner(s.ctx, slotID, socialGroupID)
-	s.Require().Error(err)
-	var errNotFound *app.ErrNotFound
-	s.Require().ErrorAs(err, &errNotFound)
-	s.Require().Empty(bannerID)
-}
+	history, err := r.GetSlotStatsHistory(s.ctx, slotID, socialGroupID, previous)
 
-func (s *redisSuite) Test_SelectBanner_Error_NoBannersFound() {
+	s.Require().NoError(err)
+	s.Require().Len(history, 2)
+	s.Require().Equal(previous, history[0].Start)
+	s.Require().Equal(float64(4), history[0].Stats.TotalSelects)
+	s.Require().ElementsMatch([]app.BannerStats{
+		{BannerID: "100501", Selects: 4, Clicks: 1},
+		{BannerID: "100502", Selects: 0, Clicks: 0},
+	}, history[0].Stats.Banners)
+	s.Require().Equal(float64(1), history[1].Stats.TotalSelects)
+	s.Require().Equal(float64(1), history[1].Stats.PooledTotalSelects)
+	s.Require().ElementsMatch([]app.BannerStats{
+		{BannerID: "100501", Selects: 0, Clicks: 0},
+		{BannerID: "100502", Selects: 1, Clicks: 1, PooledSelects: 1, PooledClicks: 1},
+	}, history[1].Stats.Banners)
+}
+
+func (s *redisSuite) Test_RegisterSelect() {
 	slotID := "100600"
 	s.seedSlot(slotID)
 	bannerID := "100500"
 	s.seedBanner(bannerID)
+	s.attachBanner(slotID, bannerID)
 	socialGroupID := "100700"
 	s.seedSocialGroup(socialGroupID)
-	r := NewRedis(s.cfg, s.createIDGeneratorMock(""))
+	r := s.newRedis(s.cfg, s.createIDGeneratorMock(""))
 
-	bannerID, err := r.SelectBanner(s.ctx, slotID, socialGroupID)
-	s.Require().Error(err)
-	s.Require().ErrorIs(err, app.ErrNoBannersFound)
-	s.Require().Empty(bannerID)
+	for i := 0; i < 3; i++ {
+		err := r.RegisterSelect(s.ctx, slotID, bannerID, socialGroupID)
+		s.Require().NoError(err)
+	}
+
+	selects := s.hGetInt(makeSlotSocialGroupSelectsKey(slotID, socialGroupID), bannerID)
+	s.Require().Equal(3, selects, "selects are invalid")
+	totalSelects := s.getInt(makeSlotSocialGroupSelectsTotalKey(slotID, socialGroupID))
+	s.Require().Equal(3, totalSelects, "total selects are invalid")
+	s.Require().True(s.client.SIsMember(s.ctx, makeSocialGroupSlotsKey(socialGroupID), slotID).Val(),
+		"slot must be in the social group slots")
+	slotSelects := s.hGetInt(makeSlotSelectsKey(slotID), bannerID)
+	s.Require().Equal(3, slotSelects, "slot selects are invalid")
+	s.Require().Equal(3, s.getInt(makeSlotSelectsTotalKey(slotID)), "slot total selects are invalid")
+	start := time.Now().Truncate(app.StatsInterval)
+	intervalSelectsKey := makeSlotSocialGroupIntervalSelectsKey(slotID, socialGroupID, start)
+	s.Require().Equal(3, s.hGetInt(intervalSelectsKey, bannerID), "interval selects are invalid")
+	s.Require().Greater(s.client.TTL(s.ctx, intervalSelectsKey).Val(), app.MaxStatsHistory)
 }
 
-func (s *redisSuite) Test_ClickBanner() {
-	slotID := "100600"
-	s.seedSlot(slotID)
-	bannerID := "100500"
-	s.seedBanner(bannerID)
-	s.attachBanner(slotID, bannerID)
-	socialGroupID := "100700"
-	s.seedSocialGroup(socialGroupID)
-	selectsKey := makeSlotSocialGroupSelectsKey(slotID, socialGroupID)
-	err := s.client.HIncrBy(s.ctx, selectsKey, bannerID, 1).Err()
-	s.Require().NoError(err)
-	clicksKey := makeSlotSocialGroupClicksKey(slotID, socialGroupID)
-	err = s.client.HIncrBy(s.ctx, clicksKey, bannerID, 0).Err()
-	s.Require().NoError(err)
-	totalSelectsKey := makeSlotSocialGroupSelectsTotalKey(slotID, socialGroupID)
-	err = s.client.IncrBy(s.ctx, totalSelectsKey, 1).Err()
-	s.Require().NoError(err)
-	scoresKey := makeSlotSocialGroupScoresKey(slotID, socialGroupID)
-	err = s.client.ZIncrBy(s.ctx, scoresKey, 0.0, bannerID).Err()
-	s.Require().NoError(err)
+func (s *redisSuite) Test_DeleteSlot_IntervalCounters() {
+	r := s.newRedis(s.cfg, idgenerator.NewUUID())
+	slotID, socialGroupID := s.seedStats(r)
+	start := time.Now().Truncate(app.StatsInterval)
+	s.Require().NotZero(s.client.Exists(s.ctx, makeSlotSocialGroupIntervalSelectsKey(slotID, socialGroupID, start)).Val())
 
-	r := NewRedis(s.cfg, s.createIDGeneratorMock(""))
-	err = r.ClickBanner(s.ctx, slotID, bannerID, socialGroupID)
+	s.Require().NoError(r.DeleteSlot(s.ctx, slotID))
 
-	s.Require().NoError(err)
-	clicks := s.hGetInt(clicksKey, bannerID)
-	s.Require().Equal(1, clicks, "clicks are invalid")
-	score := s.zScore(scoresKey, bannerID)
-	s.Require().Equal(calculateBannerScore(1, 1, 1), score, "score is invalid")
+	s.Require().Empty(s.scanKeys("slot:*"), "all keys of the slot must be deleted")
+	s.Require().False(s.client.SIsMember(s.ctx, makeSocialGroupSlotsKey(socialGroupID), slotID).Val())
 }
 
-func (s *redisSuite) Test_ClickBanner_Error_BannerNotFound() {
+func (s *redisSuite) Test_RegisterSelect_Intervals() {
 	slotID := "100600"
 	s.seedSlot(slotID)
 	bannerID := "100500"
+	s.seedBanner(bannerID)
+	s.attachBanner(slotID, bannerID)
 	socialGroupID := "100700"
 	s.seedSocialGroup(socialGroupID)
-	r := NewRedis(s.cfg, s.createIDGeneratorMock(""))
+	intervalsKey := makeSlotIntervalsKey(slotID)
+	expired := time.Now().Add(-intervalsTTL - app.StatsInterval).Truncate(app.StatsInterval).Unix()
+	s.Require().NoError(s.client.ZAdd(s.ctx, intervalsKey, rediscli.Z{Score: float64(expired), Member: expired}).Err())
+	r := s.newRedis(s.cfg, s.createIDGeneratorMock(""))
 
-	err := r.ClickBanner(s.ctx, slotID, bannerID, socialGroupID)
+	s.Require().NoError(r.RegisterSelect(s.ctx, slotID, bannerID, socialGroupID))
 
-	s.Require().Error(err)
-	var errNotFound *app.ErrNotFound
-	s.Require().ErrorAs(err, &errNotFound)
+	start := time.Now().Truncate(app.StatsInterval).Unix()
+	s.Require().Equal([]string{strconv.FormatInt(start, 10)}, s.client.ZRange(s.ctx, intervalsKey, 0, -1).Val(),
+		"the interval must be added and the expired one must be removed")
+	s.Require().Greater(s.client.TTL(s.ctx, intervalsKey).Val(), intervalTTL)
 }
 
-func (s *redisSuite) Test_ClickBanner_Error_BannerNotAttached() {
+func (s *redisSuite) Test_RegisterSelect_Error() {
 	slotID := "100600"
 	s.seedSlot(slotID)
 	bannerID := "100500"
 	s.seedBanner(bannerID)
 	socialGroupID := "100700"
 	s.seedSocialGroup(socialGroupID)
-	r := NewRedis(s.cfg, s.createIDGeneratorMock(""))
+	r := s.newRedis(s.cfg, s.createIDGeneratorMock(""))
 
-	err := r.ClickBanner(s.ctx, slotID, bannerID, socialGroupID)
+	err := r.RegisterSelect(s.ctx, slotID, bannerID, socialGroupID)
 
-	s.Require().Error(err)
 	var errBannerNotAttached *app.ErrBannerNotAttached
 	s.Require().ErrorAs(err, &errBannerNotAttached)
-}
+	s.Require().False(s.client.SIsMember(s.ctx, makeSocialGroupSlotsKey(socialGroupID), slotID).Val(),
+		"slot must not be in the social group slots")
 
-func (s *redisSuite) Test_ClickBanner_Error_SlotNotFound() {
-	slotID := "100600"
-	bannerID := "100500"
-	s.seedBanner(bannerID)
-	socialGroupID := "100700"
-	s.seedSocialGroup(socialGroupID)
-	r := NewRedis(s.cfg, s.createIDGeneratorMock(""))
-
-	err := r.ClickBanner(s.ctx, slotID, bannerID, socialGroupID)
+	s.Require().NoError(s.client.Set(s.ctx, makeSlotBannersKey(slotID), "value", 0).Err())
+	err = r.RegisterSelect(s.ctx, slotID, bannerID, socialGroupID)
 
 	s.Require().Error(err)
-	var errNotFound *app.ErrNotFound
-	s.Require().ErrorAs(err, &errNotFound)
+	s.Require().False(errors.As(err, &errBannerNotAttached), "a zscore error must not be ErrBannerNotAttached")
 }
 
-func (s *redisSuite) Test_ClickBanner_Error_SocialGroupNotFound() {
+func (s *redisSuite) Test_RegisterClick() {
 	slotID := "100600"
 	s.seedSlot(slotID)
 	bannerID := "100500"
 	s.seedBanner(bannerID)
 	s.attachBanner(slotID, bannerID)
 	socialGroupID := "100700"
-	r := NewRedis(s.cfg, s.createIDGeneratorMock(""))
+	s.seedSocialGroup(socialGroupID)
+	selectsKey := makeSlotSocialGroupSelectsKey(slotID, socialGroupID)
+	err := s.client.HIncrBy(s.ctx, selectsKey, bannerID, 1).Err()
+	s.Require().NoError(err)
+	totalSelectsKey := makeSlotSocialGroupSelectsTotalKey(slotID, socialGroupID)
+	err = s.client.IncrBy(s.ctx, totalSelectsKey, 1).Err()
+	s.Require().NoError(err)
 
-	err := r.ClickBanner(s.ctx, slotID, bannerID, socialGroupID)
+	r := s.newRedis(s.cfg, s.createIDGeneratorMock(""))
+	err = r.RegisterClick(s.ctx, slotID, bannerID, socialGroupID)
 
-	s.Require().Error(err)
-	var errNotFound *app.ErrNotFound
-	s.Require().ErrorAs(err, &errNotFound)
+	s.Require().NoError(err)
+	clicks := s.hGetInt(makeSlotSocialGroupClicksKey(slotID, socialGroupID), bannerID)
+	s.Require().Equal(1, clicks, "clicks are invalid")
+	selects := s.hGetInt(selectsKey, bannerID)
+	s.Require().Equal(1, selects, "selects are invalid")
 }
 
-func (s *redisSuite) Test_SelectClickBanner_Concurrent() {
+func (s *redisSuite) Test_RegisterSelectClick_ScriptsFlushed() {
 	slotID := "100600"
 	s.seedSlot(slotID)
+	bannerID := "100500"
+	s.seedBanner(bannerID)
+	s.attachBanner(slotID, bannerID)
 	socialGroupID := "100700"
 	s.seedSocialGroup(socialGroupID)
-	bannersCount := 100
-	bannersClicksRatio := make(map[string]float64, bannersCount)
-	bannersClicks := make(map[string]int)
-	bannersSelects := make(map[string]int)
-	for i := 0; i < bannersCount; i++ {
-		bannerID := fmt.Sprintf("banner-%d", i)
-		s.seedBanner(bannerID)
-		s.attachBanner(slotID, bannerID)
-		bannersClicksRatio[bannerID] = 0.15
-		bannersClicks[bannerID] = 0
-		bannersSelects[bannerID] = 0
-	}
-	mostPopularBannerID := "banner-0"
-	bannersClicksRatio[mostPopularBannerID] = 0.7
-	subPopularBannerID := "banner-1"
-	bannersClicksRatio[subPopularBannerID] = 0.5
-
-	workersCount := 5
-	selectsPerWorker := 1000
-
-	bannersClicksCh := make(chan string, workersCount*selectsPerWorker)
-	bannersSelectsCh := make(chan string, workersCount*selectsPerWorker)
-	wg := sync.WaitGroup{}
-	r := NewRedis(s.cfg, NewUUIDGenerator())
-	randomSeed := time.Now().UnixNano()
-	fmt.Println("random seed: ", randomSeed)
-	rand.Seed(randomSeed)
-
-	for i := 0; i < workersCount; i++ {
-		wg.Add(1)
-		go func() {
-			for j := 0; j < selectsPerWorker; j++ {
-				bannerID, err := r.SelectBanner(s.ctx, slotID, socialGroupID)
-				s.Require().NoError(err)
-				bannersSelectsCh <- bannerID
-				if rand.Float64() < bannersClicksRatio[bannerID] {
-					err := r.ClickBanner(s.ctx, slotID, bannerID, socialGroupID)
-					s.Require().NoError(err)
-					bannersClicksCh <- bannerID
-				}
-			}
-			wg.Done()
-		}()
-	}
+	r := s.newRedis(s.cfg, s.createIDGeneratorMock(""))
+	s.Require().NoError(r.LoadScripts(s.ctx))
+	s.Require().NoError(s.client.ScriptFlush(s.ctx).Err())
 
-	wg.Wait()
-	close(bannersClicksCh)
-	close(bannersSelectsCh)
-
-	for bannerId := range bannersClicksCh {
-		bannersClicks[bannerId]++
-	}
-	for bannerId := range bannersSelectsCh {
-		bannersSelects[bannerId]++
-	}
-
-	selectsTotal := s.getInt(makeSlotSocialGroupSelectsTotalKey(slotID, socialGroupID))
-	s.Require().Equal(workersCount*selectsPerWorker, selectsTotal, "total selects mismatched")
-	clicksKey := makeSlotSocialGroupClicksKey(slotID, socialGroupID)
-	selectsKey := makeSlotSocialGroupSelectsKey(slotID, socialGroupID)
-	scoresKey := makeSlotSocialGroupScoresKey(slotID, socialGroupID)
-	selectsRatios := make([]float64, 0)
-	for bannerID := range bannersClicksRatio {
-		expectedClicks := bannersClicks[bannerID]
-		actualClicks := s.hGetIntOrDefault(clicksKey, bannerID, 0)
-		s.Require().Equal(expectedClicks, actualClicks, fmt.Sprintf("clicks mismatched (%s)", bannerID))
-
-		expectedSelects := bannersSelects[bannerID]
-		actualSelects := s.hGetInt(selectsKey, bannerID)
-		s.Require().GreaterOrEqual(actualSelects, 1) // Every banner has selected at least once
-		s.Require().Equal(expectedSelects, actualSelects, fmt.Sprintf("selects mismatched (%s)", bannerID))
-		selectsRatios = append(selectsRatios, float64(actualSelects)/float64(selectsTotal))
-
-		actualScore := s.zScore(scoresKey, bannerID)
-		s.Require().NotEqual(math.Inf(1), actualScore)
-		s.Require().GreaterOrEqual(actualScore, 0.0)
-	}
-	sort.Float64s(selectsRatios)
-	medianRatio := selectsRatios[bannersCount/2]
-	mostPopularBannerRatio := float64(bannersSelects[mostPopularBannerID]) / float64(selectsTotal)
-	s.Require().Equal(selectsRatios[bannersCount-1], mostPopularBannerRatio)
-	s.Require().GreaterOrEqual(mostPopularBannerRatio, medianRatio*15.0)
-
-	subPopularBannerRatio := float64(bannersSelects[subPopularBannerID]) / float64(selectsTotal)
-	s.Require().Equal(selectsRatios[bannersCount-2], subPopularBannerRatio)
-	s.Require().GreaterOrEqual(subPopularBannerRatio, medianRatio*2.0)
+	s.Require().NoError(r.RegisterSelect(s.ctx, slotID, bannerID, socialGroupID))
+	s.Require().NoError(r.RegisterClick(s.ctx, slotID, bannerID, socialGroupID))
 
+	s.Require().Equal(1, s.hGetInt(makeSlotSocialGroupSelectsKey(slotID, socialGroupID), bannerID))
+	s.Require().Equal(1, s.hGetInt(makeSlotSocialGroupClicksKey(slotID, socialGroupID), bannerID))
+	start := time.Now().Truncate(app.StatsInterval)
+	intervalClicksKey := makeSlotSocialGroupIntervalClicksKey(slotID, socialGroupID, start)
+	s.Require().Greater(s.client.TTL(s.ctx, intervalClicksKey).Val(), app.MaxStatsHistory)
 }
 
 func (s *redisSuite) flushDB() {
@@ -547,17 +597,6 @@ func (s *redisSuite) hGetInt(key, field string) int {
 	s.Require().NoError(err)
 	return value
 }
-func (s *redisSuite) hGetIntOrDefault(key, field string, defaultValue int) int {
-	cmd := s.client.HGet(s.ctx, key, field)
-	if cmd.Err() != nil && cmd.Err() == rediscli.Nil {
-		return defaultValue
-	}
-	s.Require().NoError(cmd.Err())
-	value, err := cmd.Int()
-	s.Require().NoError(err)
-	return value
-}
-
 func (s *redisSuite) hGetFloat64(key, field string) float64 {
 	cmd := s.client.HGet(s.ctx, key, field)
 	s.Require().NoError(cmd.Err())
@@ -574,6 +613,109 @@ func (s *redisSuite) getInt(key string) int {
 	return value
 }
 
+func (s *redisSuite) Test_KeyPrefix() {
+	r := s.newRedis(s.makePrefixedConfig(), idgenerator.NewUUID())
+
+	s.seedStats(r)
+
+	keys := s.scanKeys("*")
+	s.Require().NotEmpty(keys)
+	for _, key := range keys {
+		s.Require().True(strings.HasPrefix(key, testKeyPrefix), "key '%s' is not prefixed", key)
+	}
+}
+
+func (s *redisSuite) Test_RenameKeys() {
+	unprefixed := s.newRedis(s.cfg, idgenerator.NewUUID())
+	slotID, socialGroupID := s.seedStats(unprefixed)
+	expected, err := unprefixed.GetSlotStats(s.ctx, slotID, socialGroupID)
+	s.Require().NoError(err)
+	keysCount := len(s.scanKeys("*"))
+	r := s.newRedis(s.makePrefixedConfig(), idgenerator.NewUUID())
+
+	renamed, err := r.RenameKeys(s.ctx, "")
+
+	s.Require().NoError(err)
+	s.Require().Equal(keysCount, renamed)
+	s.Require().Len(s.scanKeys(testKeyPrefix+"*"), keysCount)
+	stats, err := r.GetSlotStats(s.ctx, slotID, socialGroupID)
+	s.Require().NoError(err)
+	s.Require().Equal(expected, stats)
+	renamed, err = r.RenameKeys(s.ctx, "")
+	s.Require().NoError(err)
+	s.Require().Zero(renamed, "the renaming must be idempotent")
+}
+
+func (s *redisSuite) Test_RenameKeys_Error_KeyExists() {
+	s.hSet(keyBanners, "100500", "unprefixed")
+	s.hSet(testKeyPrefix+keyBanners, "100501", "prefixed")
+	r := s.newRedis(s.makePrefixedConfig(), idgenerator.NewUUID())
+
+	_, err := r.RenameKeys(s.ctx, "")
+
+	s.Require().Error(err)
+	s.Require().Equal("prefixed", s.hGet(testKeyPrefix+keyBanners, "100501"), "an existing key must not be overwritten")
+}
+
+func (s *redisSuite) Test_RenameKeys_HashTags() {
+	s.hSet(keySlots, "100500", "slot")
+	s.hSet("slot:100500:strategy", fieldStrategyName, "ucb1")
+	s.hSet("slot:100500:social_group:100501:selects", "100502", "3")
+	s.Require().NoError(s.client.SAdd(s.ctx, "social_group:100501:slots", "100500").Err())
+	r := s.newRedis(s.cfg, idgenerator.NewUUID())
+
+	renamed, err := r.RenameKeys(s.ctx, "")
+
+	s.Require().NoError(err)
+	s.Require().Equal(3, renamed)
+	s.Require().ElementsMatch([]string{
+		keySlots,
+		makeSlotStrategyKey("100500"),
+		makeSlotSocialGroupSelectsKey("100500", "100501"),
+		makeSocialGroupSlotsKey("100501"),
+	}, s.scanKeys("*"))
+	slot, err := r.GetSlot(s.ctx, "100500")
+	s.Require().NoError(err)
+	s.Require().Equal("ucb1", slot.Strategy.Name)
+}
+
+// seedStats creates a slot with an attached banner and registers a select, a click and a linear select.
+func (s *redisSuite) seedStats(r *Redis) (slotID, socialGroupID string) {
+	slotID, err := r.CreateSlot(s.ctx, "slot", app.StrategyParams{Name: "ucb1"}, app.SlotFormat{})
+	s.Require().NoError(err)
+	bannerID, err := r.CreateBanner(s.ctx, "banner", app.Creative{Width: 300, Height: 250}, app.Flight{})
+	s.Require().NoError(err)
+	socialGroupID, err = r.CreateSocialGroup(s.ctx, "social group")
+	s.Require().NoError(err)
+	s.Require().NoError(r.AttachBanner(s.ctx, slotID, bannerID))
+	s.Require().NoError(r.RegisterSelect(s.ctx, slotID, bannerID, socialGroupID))
+	s.Require().NoError(r.RegisterClick(s.ctx, slotID, bannerID, socialGroupID))
+	s.Require().NoError(r.RegisterLinearSelect(s.ctx, slotID, bannerID, []float64{1}))
+	return slotID, socialGroupID
+}
+
+func (s *redisSuite) newRedis(cfg Config, idGenerator IDGenerator) *Redis {
+	r, err := NewRedis(cfg, idGenerator, app.DeleteModeCascade, app.NewSystemClock())
+	s.Require().NoError(err)
+	return r
+}
+
+func (s *redisSuite) makePrefixedConfig() Config {
+	cfg := makeConfig()
+	cfg.v.Set("redis.key_prefix", testKeyPrefix)
+	return cfg
+}
+
+func (s *redisSuite) scanKeys(match string) []string {
+	var keys []string
+	iter := s.client.Scan(s.ctx, 0, match, 0).Iterator()
+	for iter.Next(s.ctx) {
+		keys = append(keys, iter.Val())
+	}
+	s.Require().NoError(iter.Err())
+	return keys
+}
+
 func (s *redisSuite) zScore(key, field string) float64 {
 	score, err := s.client.ZScore(s.ctx, key, field).Result()
 	s.Require().NoError(err)
@@ -0,0 +1,217 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ekhvalov/otus-banners-rotation/internal/app"
+	rediscli "github.com/go-redis/redis/v9"
+)
+
+// registerScript checks the banner attachment and increments the counters of a banner in a slot atomically.
+// The social group is added to the slot social groups and the slot is added to the social group slots, the sets
+// are used to find the counters of a deleted slot or social group. The social group slots key is passed only
+// if it is in the same hash slot as the keys of the slot, that is if the storage is not a cluster.
+// The interval start is added to the slot intervals and the expired intervals are removed from them.
+//
+// KEYS: slot banners, slot social groups, slot intervals, counters..., [social group slots].
+// ARGV: banner id, social group id, slot id, interval start and intervals ttl in seconds, then a command
+// and a ttl in seconds (0 for no ttl) of every counter.
+// Returns a registerStatus.
+var registerScript = rediscli.NewScript(`
+if not redis.call('ZSCORE', KEYS[1], ARGV[1]) then
+	return 3
+end
+redis.call('SADD', KEYS[2], ARGV[2])
+local start, intervalsTTL = tonumber(ARGV[4]), tonumber(ARGV[5])
+redis.call('ZADD', KEYS[3], start, ARGV[4])
+redis.call('ZREMRANGEBYSCORE', KEYS[3], '-inf', '(' .. (start - intervalsTTL))
+redis.call('EXPIRE', KEYS[3], intervalsTTL)
+local counters = (#ARGV - 5) / 2
+for i = 1, counters do
+	local key, command, ttl = KEYS[i + 3], ARGV[2 * i + 4], tonumber(ARGV[2 * i + 5])
+	if command == 'hincrby' then
+		redis.call('HINCRBY', key, ARGV[1], 1)
+	else
+		redis.call('INCR', key)
+	end
+	if ttl > 0 then
+		redis.call('EXPIRE', key, ttl)
+	end
+end
+if #KEYS > counters + 3 then
+	redis.call('SADD', KEYS[#KEYS], ARGV[3])
+end
+return 0
+`)
+
+// deleteBannerCountersScript deletes the counters of a banner and subtracts its selects from the totals.
+//
+// KEYS: the selects, clicks and total keys of every counter set.
+// ARGV: banner id.
+var deleteBannerCountersScript = rediscli.NewScript(`
+for i = 1, #KEYS, 3 do
+	local selects = redis.call('HGET', KEYS[i], ARGV[1])
+	if selects then
+		redis.call('DECRBY', KEYS[i + 2], selects)
+	end
+	redis.call('HDEL', KEYS[i], ARGV[1])
+	redis.call('HDEL', KEYS[i + 1], ARGV[1])
+end
+return 0
+`)
+
+// subtractCountersScript subtracts the source counters from the target counters and deletes the source counters.
+// The emptied target counters of the banners are removed.
+//
+// KEYS: the source selects, clicks and total keys and the target selects, clicks and total keys of every
+// counter set.
+var subtractCountersScript = rediscli.NewScript(`
+for i = 1, #KEYS, 6 do
+	for k = 0, 1 do
+		local fields = redis.call('HGETALL', KEYS[i + k])
+		for j = 1, #fields, 2 do
+			if redis.call('HINCRBY', KEYS[i + 3 + k], fields[j], -tonumber(fields[j + 1])) <= 0 then
+				redis.call('HDEL', KEYS[i + 3 + k], fields[j])
+			end
+		end
+	end
+	local total = tonumber(redis.call('GET', KEYS[i + 2]) or '0')
+	if total > 0 and redis.call('EXISTS', KEYS[i + 5]) == 1 then
+		redis.call('DECRBY', KEYS[i + 5], total)
+	end
+	redis.call('DEL', KEYS[i], KEYS[i + 1], KEYS[i + 2])
+end
+return 0
+`)
+
+// updateDescriptionScript sets the description of a banner, slot or social group in case of it exists.
+//
+// KEYS: the descriptions of the entities.
+// ARGV: entity id, description.
+// Returns 1 in case of the description is set or 0 in case of the entity is not found.
+var updateDescriptionScript = rediscli.NewScript(`
+if redis.call('HEXISTS', KEYS[1], ARGV[1]) == 0 then
+	return 0
+end
+redis.call('HSET', KEYS[1], ARGV[1], ARGV[2])
+return 1
+`)
+
+const (
+	registerStatusOK                = 0
+	registerStatusBannerNotAttached = 3
+)
+
+const (
+	counterCommandHIncrBy = "hincrby"
+	counterCommandIncr    = "incr"
+)
+
+// LoadScripts loads the Lua scripts into the script cache. The scripts are loaded on their first run
+// if they are not loaded or are flushed, so an error is not fatal.
+func (r *Redis) LoadScripts(ctx context.Context) error {
+	scripts := []*rediscli.Script{
+		registerScript, deleteBannerCountersScript, subtractCountersScript, updateDescriptionScript,
+	}
+	for _, script := range scripts {
+		if err := script.Load(ctx, r.client).Err(); err != nil {
+			return fmt.Errorf("script load error: %w", err)
+		}
+	}
+	return nil
+}
+
+// counterUpdates are the increments of the counters of a banner executed by the registerScript.
+type counterUpdates struct {
+	keys []string
+	args []interface{}
+}
+
+func (u *counterUpdates) add(key, command string, ttl time.Duration) {
+	u.keys = append(u.keys, key)
+	u.args = append(u.args, command, int64(ttl.Seconds()))
+}
+
+// addSelect adds the increments of the selects counters of a banner.
+func (u *counterUpdates) addSelect(keys counterKeys, ttl time.Duration) {
+	u.add(keys.selects, counterCommandHIncrBy, ttl)
+	if keys.total != "" {
+		u.add(keys.total, counterCommandIncr, ttl)
+	}
+}
+
+// addClick adds the increment of the clicks counter of a banner.
+func (u *counterUpdates) addClick(keys counterKeys, ttl time.Duration) {
+	u.add(keys.clicks, counterCommandHIncrBy, ttl)
+}
+
+// register checks the slot, banner and social group exist and runs the registerScript with the counters of the slot.
+// The attachment check and the increments are atomic, the existence checks are made by one pipeline before.
+// The social group index of the slots is in another hash slot of a cluster, so in a cluster it is updated before
+// the script and contains every slot which has the counters of the social group. EVALSHA is used and the script
+// is loaded by EVAL if it is flushed.
+func (r *Redis) register(
+	ctx context.Context,
+	slotID, bannerID, socialGroupID string,
+	start time.Time,
+	updates counterUpdates,
+) error {
+	var slotExists, bannerExists, socialGroupExists *rediscli.BoolCmd
+	var attached *rediscli.FloatCmd
+	err := r.execPipelined(ctx, func(pipe rediscli.Pipeliner) {
+		slotExists = pipe.HExists(ctx, r.key(keySlots), slotID)
+		bannerExists = pipe.HExists(ctx, r.key(keyBanners), bannerID)
+		socialGroupExists = pipe.HExists(ctx, r.key(keySocialGroups), socialGroupID)
+		attached = pipe.ZScore(ctx, r.key(makeSlotBannersKey(slotID)), bannerID)
+	})
+	if err != nil {
+		return fmt.Errorf("hexists of slot '%s' banner '%s' social group '%s' error: %w",
+			slotID, bannerID, socialGroupID, err)
+	}
+	if !slotExists.Val() {
+		return app.NewErrNotFound(fmt.Sprintf("slot with id '%s' is not found", slotID))
+	}
+	if !bannerExists.Val() {
+		return app.NewErrNotFound(fmt.Sprintf("banner with id '%s' is not found", bannerID))
+	}
+	if err = attached.Err(); err != nil {
+		if errors.Is(err, rediscli.Nil) {
+			return app.NewErrBannerNotAttached(slotID, bannerID)
+		}
+		return fmt.Errorf("zscore of '%s' '%s' error: %w", r.key(makeSlotBannersKey(slotID)), bannerID, err)
+	}
+	if !socialGroupExists.Val() {
+		return app.NewErrNotFound(fmt.Sprintf("socialGroup with id '%s' is not found", socialGroupID))
+	}
+	keys := append([]string{
+		r.key(makeSlotBannersKey(slotID)),
+		r.key(makeSlotSocialGroupsKey(slotID)),
+		r.key(makeSlotIntervalsKey(slotID)),
+	}, updates.keys...)
+	socialGroupSlotsKey := r.key(makeSocialGroupSlotsKey(socialGroupID))
+	if _, isCluster := r.client.(*rediscli.ClusterClient); isCluster {
+		if err = r.client.SAdd(ctx, socialGroupSlotsKey, slotID).Err(); err != nil {
+			return fmt.Errorf("sadd of '%s' '%s' error: %w", socialGroupSlotsKey, slotID, err)
+		}
+	} else {
+		keys = append(keys, socialGroupSlotsKey)
+	}
+	args := append([]interface{}{
+		bannerID, socialGroupID, slotID, start.Unix(), int64(intervalsTTL.Seconds()),
+	}, updates.args...)
+	status, err := registerScript.Run(ctx, r.client, keys, args...).Int()
+	if err != nil {
+		return fmt.Errorf("register script of slot '%s' banner '%s' error: %w", slotID, bannerID, err)
+	}
+	switch status {
+	case registerStatusOK:
+		return nil
+	case registerStatusBannerNotAttached:
+		return app.NewErrBannerNotAttached(slotID, bannerID)
+	default:
+		return fmt.Errorf("register script of slot '%s' banner '%s' unexpected status %d", slotID, bannerID, status)
+	}
+}
@@ -0,0 +1,317 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ekhvalov/otus-banners-rotation/internal/app"
+	rediscli "github.com/go-redis/redis/v9"
+)
+
+// ExportSnapshot reads the state of the storage by several pipelines, so the snapshot of a storage
+// which is changed concurrently may be inconsistent.
+func (r *Redis) ExportSnapshot(ctx context.Context) (app.Snapshot, error) {
+	var bannersCmd, slotsCmd, socialGroupsCmd *rediscli.MapStringStringCmd
+	_, err := r.client.Pipelined(ctx, func(pipe rediscli.Pipeliner) error {
+		bannersCmd = pipe.HGetAll(ctx, r.key(keyBanners))
+		slotsCmd = pipe.HGetAll(ctx, r.key(keySlots))
+		socialGroupsCmd = pipe.HGetAll(ctx, r.key(keySocialGroups))
+		return nil
+	})
+	if err != nil {
+		return app.Snapshot{}, fmt.Errorf("hgetall of entities error: %w", err)
+	}
+	var snapshot app.Snapshot
+	var bannerIDs []string
+	for id := range bannersCmd.Val() {
+		bannerIDs = append(bannerIDs, id)
+	}
+	creatives, err := r.getCreatives(ctx, bannerIDs)
+	if err != nil {
+		return app.Snapshot{}, err
+	}
+	flights, err := r.getFlights(ctx, bannerIDs)
+	if err != nil {
+		return app.Snapshot{}, err
+	}
+	for i, id := range bannerIDs {
+		snapshot.Banners = append(snapshot.Banners,
+			app.Banner{ID: id, Description: bannersCmd.Val()[id], Creative: creatives[i], Flight: flights[i]})
+	}
+	for id, description := range socialGroupsCmd.Val() {
+		snapshot.SocialGroups = append(snapshot.SocialGroups, app.SocialGroup{ID: id, Description: description})
+	}
+	for id, description := range slotsCmd.Val() {
+		if err = r.exportSlot(ctx, &snapshot, app.Slot{ID: id, Description: description}); err != nil {
+			return app.Snapshot{}, err
+		}
+	}
+	snapshot.Sort()
+	return snapshot, nil
+}
+
+// ImportSnapshot writes the snapshot by pipelines which are not transactional, the keys of a snapshot are
+// in the different hash slots of a cluster. The interval counters expire at the same time as the registered ones.
+func (r *Redis) ImportSnapshot(ctx context.Context, snapshot app.Snapshot) error {
+	now := r.clock.Now()
+	_, err := r.client.Pipelined(ctx, func(pipe rediscli.Pipeliner) error {
+		for _, banner := range snapshot.Banners {
+			pipe.HSet(ctx, r.key(keyBanners), banner.ID, banner.Description)
+			r.queueSetCreative(ctx, pipe, banner.ID, banner.Creative)
+			r.queueSetFlight(ctx, pipe, banner.ID, banner.Flight)
+		}
+		for _, slot := range snapshot.Slots {
+			pipe.HSet(ctx, r.key(keySlots), slot.ID, slot.Description)
+			// The strategy fields are replaced as a whole, so the unset ones of an existing slot do not remain.
+			pipe.Del(ctx, r.key(makeSlotStrategyKey(slot.ID)))
+			pipe.HSet(ctx, r.key(makeSlotStrategyKey(slot.ID)), strategyParamsToFields(slot.Strategy))
+			r.queueSetSlotFormat(ctx, pipe, slot.ID, slot.Format)
+		}
+		for _, socialGroup := range snapshot.SocialGroups {
+			pipe.HSet(ctx, r.key(keySocialGroups), socialGroup.ID, socialGroup.Description)
+		}
+		for _, a := range snapshot.Attachments {
+			attachedAt := a.AttachedAt
+			if attachedAt.IsZero() {
+				attachedAt = now
+			}
+			z := rediscli.Z{Score: float64(attachedAt.Unix()), Member: a.BannerID}
+			pipe.ZAddNX(ctx, r.key(makeSlotBannersKey(a.SlotID)), z)
+		}
+		for _, c := range snapshot.Counters {
+			r.queueImportCounter(ctx, pipe, c, now)
+		}
+		for _, model := range snapshot.LinearModels {
+			linearKey := r.key(makeSlotBannerLinearKey(model.SlotID, model.BannerID))
+			pipe.SAdd(ctx, r.key(makeSlotLinearBannersKey(model.SlotID)), model.BannerID)
+			for _, element := range model.A {
+				pipe.HIncrByFloat(ctx, linearKey, makeLinearAField(element.I, element.J), element.Value)
+			}
+			for _, element := range model.B {
+				pipe.HIncrByFloat(ctx, linearKey, makeLinearBField(element.I), element.Value)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("import of snapshot error: %w", err)
+	}
+	return nil
+}
+
+// exportSlot adds a slot, its attachments, counters and linear models to the snapshot.
+func (r *Redis) exportSlot(ctx context.Context, snapshot *app.Snapshot, slot app.Slot) error {
+	var strategyCmd, formatCmd *rediscli.MapStringStringCmd
+	var bannersCmd *rediscli.ZSliceCmd
+	var socialGroupsCmd, linearBannersCmd, intervalsCmd *rediscli.StringSliceCmd
+	_, err := r.client.Pipelined(ctx, func(pipe rediscli.Pipeliner) error {
+		strategyCmd = pipe.HGetAll(ctx, r.key(makeSlotStrategyKey(slot.ID)))
+		formatCmd = pipe.HGetAll(ctx, r.key(makeSlotFormatKey(slot.ID)))
+		bannersCmd = pipe.ZRangeWithScores(ctx, r.key(makeSlotBannersKey(slot.ID)), 0, -1)
+		socialGroupsCmd = pipe.SMembers(ctx, r.key(makeSlotSocialGroupsKey(slot.ID)))
+		linearBannersCmd = pipe.SMembers(ctx, r.key(makeSlotLinearBannersKey(slot.ID)))
+		intervalsCmd = pipe.ZRangeByScore(ctx, r.key(makeSlotIntervalsKey(slot.ID)), r.makeIntervalsRange())
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("export of slot '%s' error: %w", slot.ID, err)
+	}
+	if slot.Strategy, err = fieldsToStrategyParams(strategyCmd.Val()); err != nil {
+		return fmt.Errorf("parse of '%s' error: %w", r.key(makeSlotStrategyKey(slot.ID)), err)
+	}
+	if slot.Format, err = fieldsToSlotFormat(formatCmd.Val()); err != nil {
+		return fmt.Errorf("parse of '%s' error: %w", r.key(makeSlotFormatKey(slot.ID)), err)
+	}
+	snapshot.Slots = append(snapshot.Slots, slot)
+	for _, z := range bannersCmd.Val() {
+		snapshot.Attachments = append(snapshot.Attachments, makeAttachment(slot.ID, z))
+	}
+	starts, err := parseIntervalStarts(r.key(makeSlotIntervalsKey(slot.ID)), intervalsCmd.Val())
+	if err != nil {
+		return err
+	}
+	for _, socialGroupID := range socialGroupsCmd.Val() {
+		counters, err := r.exportCounters(ctx, slot.ID, socialGroupID, starts)
+		if err != nil {
+			return err
+		}
+		snapshot.Counters = append(snapshot.Counters, counters...)
+	}
+	models, err := r.exportLinearModels(ctx, slot.ID, linearBannersCmd.Val())
+	if err != nil {
+		return err
+	}
+	snapshot.LinearModels = append(snapshot.LinearModels, models...)
+	return nil
+}
+
+// exportCounters returns the non-zero counters of a slot for a social group which are not expired.
+// The interval counters are read for the starts of the slot intervals.
+func (r *Redis) exportCounters(
+	ctx context.Context,
+	slotID, socialGroupID string,
+	starts []time.Time,
+) ([]app.Counter, error) {
+	type counterSetCmds struct {
+		start   time.Time
+		selects *rediscli.MapStringStringCmd
+		clicks  *rediscli.MapStringStringCmd
+	}
+	expired := r.clock.Now().Add(-intervalTTL)
+	var sets []counterSetCmds
+	_, err := r.client.Pipelined(ctx, func(pipe rediscli.Pipeliner) error {
+		keys := r.counterKeys(makeSlotSocialGroupCounterKeys(slotID, socialGroupID))
+		sets = append(sets, counterSetCmds{selects: pipe.HGetAll(ctx, keys.selects), clicks: pipe.HGetAll(ctx, keys.clicks)})
+		for _, start := range starts {
+			if start.Before(expired) {
+				continue
+			}
+			keys = r.counterKeys(makeSlotSocialGroupIntervalCounterKeys(slotID, socialGroupID, start))
+			sets = append(sets, counterSetCmds{
+				start:   start,
+				selects: pipe.HGetAll(ctx, keys.selects),
+				clicks:  pipe.HGetAll(ctx, keys.clicks),
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("counters of slot '%s' social group '%s' error: %w", slotID, socialGroupID, err)
+	}
+	var exported []app.Counter
+	for _, set := range sets {
+		selects, err := parseInt64Fields(set.selects)
+		if err != nil {
+			return nil, err
+		}
+		clicks, err := parseInt64Fields(set.clicks)
+		if err != nil {
+			return nil, err
+		}
+		for bannerID := range clicks {
+			if _, ok := selects[bannerID]; !ok {
+				selects[bannerID] = 0
+			}
+		}
+		for bannerID := range selects {
+			if selects[bannerID] == 0 && clicks[bannerID] == 0 {
+				continue
+			}
+			exported = append(exported, app.Counter{
+				SlotID:        slotID,
+				SocialGroupID: socialGroupID,
+				BannerID:      bannerID,
+				Start:         set.start,
+				Selects:       selects[bannerID],
+				Clicks:        clicks[bannerID],
+			})
+		}
+	}
+	return exported, nil
+}
+
+// queueImportCounter queues the increments of the counters of a slot for a social group, of the pooled counters
+// and of the totals. The expired interval counter is skipped.
+func (r *Redis) queueImportCounter(ctx context.Context, pipe rediscli.Pipeliner, c app.Counter, now time.Time) {
+	var sets []counterKeys
+	var ttl time.Duration
+	if c.Start.IsZero() {
+		sets = []counterKeys{
+			makeSlotSocialGroupCounterKeys(c.SlotID, c.SocialGroupID),
+			makeSlotCounterKeys(c.SlotID),
+		}
+	} else {
+		if ttl = c.Start.Add(intervalTTL).Sub(now); ttl <= 0 {
+			return
+		}
+		start := time.Unix(c.Start.Unix(), 0)
+		sets = []counterKeys{
+			makeSlotSocialGroupIntervalCounterKeys(c.SlotID, c.SocialGroupID, start),
+			makeSlotIntervalCounterKeys(c.SlotID, start),
+		}
+	}
+	for _, set := range sets {
+		keys := r.counterKeys(set)
+		pipe.HIncrBy(ctx, keys.selects, c.BannerID, c.Selects)
+		pipe.HIncrBy(ctx, keys.clicks, c.BannerID, c.Clicks)
+		if keys.total != "" {
+			pipe.IncrBy(ctx, keys.total, c.Selects)
+		}
+		if ttl > 0 {
+			for _, key := range appendCounterKeys(nil, keys) {
+				pipe.Expire(ctx, key, ttl)
+			}
+		}
+	}
+	if ttl > 0 {
+		slotIntervalsKey := r.key(makeSlotIntervalsKey(c.SlotID))
+		pipe.ZAdd(ctx, slotIntervalsKey, rediscli.Z{Score: float64(c.Start.Unix()), Member: c.Start.Unix()})
+		pipe.Expire(ctx, slotIntervalsKey, intervalsTTL)
+	}
+	pipe.SAdd(ctx, r.key(makeSlotSocialGroupsKey(c.SlotID)), c.SocialGroupID)
+	pipe.SAdd(ctx, r.key(makeSocialGroupSlotsKey(c.SocialGroupID)), c.SlotID)
+}
+
+func (r *Redis) exportLinearModels(ctx context.Context, slotID string, bannerIDs []string) ([]app.LinearModel, error) {
+	cmds := make([]*rediscli.MapStringStringCmd, len(bannerIDs))
+	_, err := r.client.Pipelined(ctx, func(pipe rediscli.Pipeliner) error {
+		for i, bannerID := range bannerIDs {
+			cmds[i] = pipe.HGetAll(ctx, r.key(makeSlotBannerLinearKey(slotID, bannerID)))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("linear stats of slot '%s' error: %w", slotID, err)
+	}
+	models := make([]app.LinearModel, len(bannerIDs))
+	for i, bannerID := range bannerIDs {
+		models[i], err = fieldsToLinearModel(slotID, bannerID, cmds[i].Val())
+		if err != nil {
+			return nil, fmt.Errorf("parse of '%s' error: %w", r.key(makeSlotBannerLinearKey(slotID, bannerID)), err)
+		}
+	}
+	return models, nil
+}
+
+func fieldsToLinearModel(slotID, bannerID string, fields map[string]string) (app.LinearModel, error) {
+	model := app.LinearModel{SlotID: slotID, BannerID: bannerID}
+	for field, str := range fields {
+		element := app.LinearElement{}
+		var err error
+		if element.Value, err = strconv.ParseFloat(str, 64); err != nil {
+			return app.LinearModel{}, fmt.Errorf("field '%s' parse float64 error: %w", field, err)
+		}
+		parts := strings.Split(field, ":")
+		indexes := make([]int, len(parts)-1)
+		for k, part := range parts[1:] {
+			if indexes[k], err = strconv.Atoi(part); err != nil {
+				return app.LinearModel{}, fmt.Errorf("field '%s' parse index error: %w", field, err)
+			}
+		}
+		switch {
+		case parts[0] == fieldLinearAPrefix && len(indexes) == 2:
+			element.I, element.J = indexes[0], indexes[1]
+			model.A = append(model.A, element)
+		case parts[0] == fieldLinearBPrefix && len(indexes) == 1:
+			element.I = indexes[0]
+			model.B = append(model.B, element)
+		default:
+			return app.LinearModel{}, fmt.Errorf("unexpected field '%s'", field)
+		}
+	}
+	return model, nil
+}
+
+func parseInt64Fields(cmd *rediscli.MapStringStringCmd) (map[string]int64, error) {
+	result := make(map[string]int64, len(cmd.Val()))
+	for field, value := range cmd.Val() {
+		var err error
+		if result[field], err = strconv.ParseInt(value, 10, 64); err != nil {
+			return nil, fmt.Errorf("hgetall of '%s' '%s' parse int64 error: %w", cmd.Args()[1], field, err)
+		}
+	}
+	return result, nil
+}
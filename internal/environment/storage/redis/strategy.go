@@ -0,0 +1,126 @@
+package redis
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/ekhvalov/otus-banners-rotation/internal/app"
+)
+
+const (
+	// fieldStrategyVersion is absent in the strategies which store all unset optional params as zeros.
+	fieldStrategyVersion           = "version"
+	fieldStrategyName              = "name"
+	fieldStrategyUCBExploration    = "ucb_exploration"
+	fieldStrategyEpsilon           = "epsilon"
+	fieldStrategyEpsilonDecay      = "epsilon_decay"
+	fieldStrategyAlpha             = "alpha"
+	fieldStrategyBeta              = "beta"
+	fieldStrategyWindow            = "window"
+	fieldStrategyDiscount          = "discount"
+	fieldStrategyPrior             = "prior"
+	fieldStrategyPriorSelects      = "prior_selects"
+	fieldStrategyPriorClicks       = "prior_clicks"
+	fieldStrategyPooling           = "pooling"
+	fieldStrategyPoolingStrength   = "pooling_strength"
+	fieldStrategyPoolingMinSelects = "pooling_min_selects"
+
+	// strategyVersion is a version of the strategies which store the unset optional params as absent.
+	strategyVersion = 2
+	// optionalExplorationVersion is the first version which stores the unset ucb exploration and epsilon as absent.
+	optionalExplorationVersion = 1
+)
+
+func strategyParamsToFields(params app.StrategyParams) map[string]interface{} {
+	fields := map[string]interface{}{
+		fieldStrategyVersion:      strategyVersion,
+		fieldStrategyName:         params.Name,
+		fieldStrategyEpsilonDecay: params.EpsilonDecay,
+		fieldStrategyAlpha:        params.Alpha,
+		fieldStrategyBeta:         params.Beta,
+		fieldStrategyWindow:       params.Window.String(),
+		fieldStrategyDiscount:     params.Discount,
+		fieldStrategyPrior:        params.Prior,
+		fieldStrategyPooling:      params.Pooling,
+	}
+	optionalFloats := map[string]*float64{
+		fieldStrategyUCBExploration:    params.UCBExploration,
+		fieldStrategyEpsilon:           params.Epsilon,
+		fieldStrategyPriorSelects:      params.PriorSelects,
+		fieldStrategyPriorClicks:       params.PriorClicks,
+		fieldStrategyPoolingStrength:   params.PoolingStrength,
+		fieldStrategyPoolingMinSelects: params.PoolingMinSelects,
+	}
+	for field, value := range optionalFloats {
+		if value != nil {
+			fields[field] = *value
+		}
+	}
+	return fields
+}
+
+func fieldsToStrategyParams(fields map[string]string) (app.StrategyParams, error) {
+	params := app.StrategyParams{
+		Name:    fields[fieldStrategyName],
+		Prior:   fields[fieldStrategyPrior],
+		Pooling: fields[fieldStrategyPooling],
+	}
+	var version int
+	if str, ok := fields[fieldStrategyVersion]; ok {
+		var err error
+		if version, err = strconv.Atoi(str); err != nil {
+			return app.StrategyParams{}, fmt.Errorf("field '%s' parse int error: %w", fieldStrategyVersion, err)
+		}
+	}
+	// The optional params are stored as absent since the version, the older strategies store the unset ones as zeros.
+	optionalFloats := map[string]struct {
+		value   **float64
+		version int
+	}{
+		fieldStrategyUCBExploration:    {value: &params.UCBExploration, version: optionalExplorationVersion},
+		fieldStrategyEpsilon:           {value: &params.Epsilon, version: optionalExplorationVersion},
+		fieldStrategyPriorSelects:      {value: &params.PriorSelects, version: strategyVersion},
+		fieldStrategyPriorClicks:       {value: &params.PriorClicks, version: strategyVersion},
+		fieldStrategyPoolingStrength:   {value: &params.PoolingStrength, version: strategyVersion},
+		fieldStrategyPoolingMinSelects: {value: &params.PoolingMinSelects, version: strategyVersion},
+	}
+	for field, optional := range optionalFloats {
+		str, ok := fields[field]
+		if !ok {
+			continue
+		}
+		parsed, err := strconv.ParseFloat(str, 64)
+		if err != nil {
+			return app.StrategyParams{}, fmt.Errorf("field '%s' parse float64 error: %w", field, err)
+		}
+		if version >= optional.version || parsed != 0 {
+			*optional.value = &parsed
+		}
+	}
+	floats := map[string]*float64{
+		fieldStrategyEpsilonDecay: &params.EpsilonDecay,
+		fieldStrategyAlpha:        &params.Alpha,
+		fieldStrategyBeta:         &params.Beta,
+		fieldStrategyDiscount:     &params.Discount,
+	}
+	for field, value := range floats {
+		str, ok := fields[field]
+		if !ok {
+			continue
+		}
+		parsed, err := strconv.ParseFloat(str, 64)
+		if err != nil {
+			return app.StrategyParams{}, fmt.Errorf("field '%s' parse float64 error: %w", field, err)
+		}
+		*value = parsed
+	}
+	if str, ok := fields[fieldStrategyWindow]; ok {
+		window, err := time.ParseDuration(str)
+		if err != nil {
+			return app.StrategyParams{}, fmt.Errorf("field '%s' parse duration error: %w", fieldStrategyWindow, err)
+		}
+		params.Window = window
+	}
+	return params, nil
+}
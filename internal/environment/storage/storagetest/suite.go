@@ -0,0 +1,951 @@
+// Package storagetest provides the conformance test suite of the app.Storage implementations.
+package storagetest
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ekhvalov/otus-banners-rotation/internal/app"
+	"github.com/ekhvalov/otus-banners-rotation/internal/app/strategy"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/suite"
+)
+
+// NewSuite creates the conformance suite. The newStorage function is called before every test
+// and must return an empty storage with the delete mode, the tests use the app.DeleteModeCascade mode
+// unless they check the other one.
+func NewSuite(newStorage func(t *testing.T, deleteMode app.DeleteMode) app.Storage) *Suite {
+	return &Suite{newStorage: newStorage}
+}
+
+// Suite checks the contract of app.Storage. Run it with suite.Run.
+type Suite struct {
+	suite.Suite
+	newStorage func(t *testing.T, deleteMode app.DeleteMode) app.Storage
+	ctx        context.Context
+	storage    app.Storage
+}
+
+func (s *Suite) SetupTest() {
+	s.ctx = context.Background()
+	s.storage = s.newStorage(s.T(), app.DeleteModeCascade)
+}
+
+func (s *Suite) Test_CreateBanner() {
+	firstID, err := s.storage.CreateBanner(s.ctx, "banner", app.Creative{}, app.Flight{})
+	s.Require().NoError(err)
+	secondID, err := s.storage.CreateBanner(s.ctx, "banner", app.Creative{}, app.Flight{})
+	s.Require().NoError(err)
+
+	s.Require().NotEmpty(firstID)
+	s.Require().NotEqual(firstID, secondID)
+	s.Require().NoError(s.storage.AttachBanner(s.ctx, s.seedSlot(), firstID))
+}
+
+func (s *Suite) Test_CreateBanner_Creative() {
+	creative := app.Creative{
+		TargetURL: "https://example.com/landing",
+		ImageURL:  "https://cdn.example.com/banner.png",
+		Width:     300,
+		Height:    250,
+		MIMEType:  "image/png",
+		Metadata:  map[string]string{"campaign": "autumn", "alt": "Autumn sale"},
+	}
+	bannerID, err := s.storage.CreateBanner(s.ctx, "banner", creative, app.Flight{})
+	s.Require().NoError(err)
+	want := app.Banner{ID: bannerID, Description: "banner", Creative: creative}
+
+	banner, err := s.storage.GetBanner(s.ctx, bannerID)
+	s.Require().NoError(err)
+	s.Require().Equal(want, banner)
+	banners, err := s.storage.ListBanners(s.ctx, app.ListFilter{})
+	s.Require().NoError(err)
+	s.Require().Equal([]app.Banner{want}, banners)
+	banner, err = s.storage.UpdateBanner(s.ctx, bannerID, "new banner")
+	s.Require().NoError(err)
+	want.Description = "new banner"
+	s.Require().Equal(want, banner, "the update of the description must keep the creative")
+
+	s.Require().NoError(s.storage.DeleteBanner(s.ctx, bannerID))
+	_, err = s.storage.GetBanner(s.ctx, bannerID)
+	var errNotFound *app.ErrNotFound
+	s.Require().ErrorAs(err, &errNotFound)
+}
+
+func (s *Suite) Test_CreateBanner_Flight() {
+	now := time.Now()
+	flight := app.Flight{Start: now.Add(-time.Hour), End: now.Add(time.Hour)}
+	bannerID, err := s.storage.CreateBanner(s.ctx, "banner", app.Creative{}, flight)
+	s.Require().NoError(err)
+	startedID, err := s.storage.CreateBanner(s.ctx, "banner", app.Creative{}, app.Flight{Start: now})
+	s.Require().NoError(err)
+	unboundedID := s.seedBanner()
+	// The storages keep the times with a precision of a second.
+	want := app.Flight{Start: time.Unix(flight.Start.Unix(), 0), End: time.Unix(flight.End.Unix(), 0)}
+
+	banner, err := s.storage.GetBanner(s.ctx, bannerID)
+	s.Require().NoError(err)
+	s.Require().Equal(app.Banner{ID: bannerID, Description: "banner", Flight: want}, banner)
+	banners, err := s.storage.ListBanners(s.ctx, app.ListFilter{})
+	s.Require().NoError(err)
+	s.Require().Len(banners, 3)
+	s.Require().Contains(banners, app.Banner{ID: bannerID, Description: "banner", Flight: want})
+	s.Require().Contains(banners, app.Banner{ID: unboundedID, Description: "banner"})
+	banner, err = s.storage.UpdateBanner(s.ctx, bannerID, "new banner")
+	s.Require().NoError(err)
+	s.Require().Equal(want, banner.Flight, "the update of the description must keep the flight")
+	flights, err := s.storage.GetBannerFlights(s.ctx, []string{bannerID, startedID, unboundedID, "unknown"})
+	s.Require().NoError(err)
+	s.Require().Equal(map[string]app.Flight{
+		bannerID:  want,
+		startedID: {Start: time.Unix(now.Unix(), 0)},
+	}, flights)
+
+	s.Require().NoError(s.storage.DeleteBanner(s.ctx, bannerID))
+	flights, err = s.storage.GetBannerFlights(s.ctx, []string{bannerID})
+	s.Require().NoError(err)
+	s.Require().Empty(flights)
+}
+
+func (s *Suite) Test_DeleteBanner() {
+	bannerID := s.seedBanner()
+
+	s.Require().NoError(s.storage.DeleteBanner(s.ctx, bannerID))
+
+	var errNotFound *app.ErrNotFound
+	s.Require().ErrorAs(s.storage.AttachBanner(s.ctx, s.seedSlot(), bannerID), &errNotFound)
+	s.Require().NoError(s.storage.DeleteBanner(s.ctx, bannerID), "deletion of a missing banner must be silent")
+}
+
+func (s *Suite) Test_GetSlot() {
+	// The explicit zeros of the optional params differ from the unset ones which mean the rotator defaults.
+	strategy := app.StrategyParams{
+		Name:              "thompson_sampling",
+		UCBExploration:    float64Ptr(0),
+		Epsilon:           float64Ptr(0.1),
+		EpsilonDecay:      0.01,
+		Alpha:             2,
+		Beta:              3,
+		Window:            time.Hour,
+		Discount:          0.9,
+		Prior:             "pseudo_counts",
+		PriorSelects:      float64Ptr(10),
+		PriorClicks:       float64Ptr(0),
+		Pooling:           "hierarchical",
+		PoolingStrength:   float64Ptr(5),
+		PoolingMinSelects: float64Ptr(20),
+	}
+	slotID, err := s.storage.CreateSlot(s.ctx, "slot", strategy, app.SlotFormat{})
+	s.Require().NoError(err)
+
+	slot, err := s.storage.GetSlot(s.ctx, slotID)
+
+	s.Require().NoError(err)
+	s.Require().Equal(app.Slot{ID: slotID, Description: "slot", Strategy: strategy}, slot)
+}
+
+func (s *Suite) Test_CreateSlot_Format() {
+	format := app.SlotFormat{
+		Sizes:     []app.Size{{Width: 300, Height: 250}, {Width: 728, Height: 90}},
+		MIMETypes: []string{"image/png", "image/gif"},
+	}
+	slotID, err := s.storage.CreateSlot(s.ctx, "slot", app.StrategyParams{}, format)
+	s.Require().NoError(err)
+	want := app.Slot{ID: slotID, Description: "slot", Format: format}
+
+	slot, err := s.storage.GetSlot(s.ctx, slotID)
+	s.Require().NoError(err)
+	s.Require().Equal(want, slot)
+	slots, err := s.storage.ListSlots(s.ctx, app.ListFilter{})
+	s.Require().NoError(err)
+	s.Require().Equal([]app.Slot{want}, slots)
+	slot, err = s.storage.UpdateSlot(s.ctx, slotID, "new slot")
+	s.Require().NoError(err)
+	want.Description = "new slot"
+	s.Require().Equal(want, slot, "the update of the description must keep the format")
+
+	s.Require().NoError(s.storage.DeleteSlot(s.ctx, slotID))
+	_, err = s.storage.GetSlot(s.ctx, slotID)
+	var errNotFound *app.ErrNotFound
+	s.Require().ErrorAs(err, &errNotFound)
+}
+
+func (s *Suite) Test_GetSlot_WithoutStrategy() {
+	slotID := s.seedSlot()
+
+	slot, err := s.storage.GetSlot(s.ctx, slotID)
+
+	s.Require().NoError(err)
+	s.Require().Equal(app.Slot{ID: slotID, Description: "slot"}, slot)
+}
+
+func (s *Suite) Test_GetSlot_Error_NotFound() {
+	_, err := s.storage.GetSlot(s.ctx, "unknown")
+
+	var errNotFound *app.ErrNotFound
+	s.Require().ErrorAs(err, &errNotFound)
+}
+
+func (s *Suite) Test_GetBanner_GetSocialGroup() {
+	bannerID := s.seedBanner()
+	socialGroupID := s.seedSocialGroup()
+
+	banner, err := s.storage.GetBanner(s.ctx, bannerID)
+	s.Require().NoError(err)
+	s.Require().Equal(app.Banner{ID: bannerID, Description: "banner"}, banner)
+	socialGroup, err := s.storage.GetSocialGroup(s.ctx, socialGroupID)
+	s.Require().NoError(err)
+	s.Require().Equal(app.SocialGroup{ID: socialGroupID, Description: "social group"}, socialGroup)
+
+	var errNotFound *app.ErrNotFound
+	_, err = s.storage.GetBanner(s.ctx, socialGroupID)
+	s.Require().ErrorAs(err, &errNotFound)
+	_, err = s.storage.GetSocialGroup(s.ctx, bannerID)
+	s.Require().ErrorAs(err, &errNotFound)
+}
+
+func (s *Suite) Test_UpdateX() {
+	bannerID := s.seedBanner()
+	socialGroupID := s.seedSocialGroup()
+	strategy := app.StrategyParams{Name: "ucb1", Window: time.Hour}
+	slotID, err := s.storage.CreateSlot(s.ctx, "slot", strategy, app.SlotFormat{})
+	s.Require().NoError(err)
+
+	banner, err := s.storage.UpdateBanner(s.ctx, bannerID, "new banner")
+	s.Require().NoError(err)
+	s.Require().Equal(app.Banner{ID: bannerID, Description: "new banner"}, banner)
+	slot, err := s.storage.UpdateSlot(s.ctx, slotID, "new slot")
+	s.Require().NoError(err)
+	s.Require().Equal(app.Slot{ID: slotID, Description: "new slot", Strategy: strategy}, slot)
+	socialGroup, err := s.storage.UpdateSocialGroup(s.ctx, socialGroupID, "new social group")
+	s.Require().NoError(err)
+	s.Require().Equal(app.SocialGroup{ID: socialGroupID, Description: "new social group"}, socialGroup)
+
+	banner, err = s.storage.GetBanner(s.ctx, bannerID)
+	s.Require().NoError(err)
+	s.Require().Equal("new banner", banner.Description)
+	slot, err = s.storage.GetSlot(s.ctx, slotID)
+	s.Require().NoError(err)
+	s.Require().Equal(app.Slot{ID: slotID, Description: "new slot", Strategy: strategy}, slot)
+	socialGroup, err = s.storage.GetSocialGroup(s.ctx, socialGroupID)
+	s.Require().NoError(err)
+	s.Require().Equal("new social group", socialGroup.Description)
+}
+
+func (s *Suite) Test_UpdateX_Error_NotFound() {
+	var errNotFound *app.ErrNotFound
+	_, err := s.storage.UpdateBanner(s.ctx, "unknown", "banner")
+	s.Require().ErrorAs(err, &errNotFound)
+	_, err = s.storage.UpdateSlot(s.ctx, "unknown", "slot")
+	s.Require().ErrorAs(err, &errNotFound)
+	_, err = s.storage.UpdateSocialGroup(s.ctx, "unknown", "social group")
+	s.Require().ErrorAs(err, &errNotFound)
+
+	_, err = s.storage.GetBanner(s.ctx, "unknown")
+	s.Require().ErrorAs(err, &errNotFound, "update of a missing banner must not create it")
+}
+
+func (s *Suite) Test_ListX() {
+	descriptions := []string{"red banner", "green banner", "red button", "blue banner"}
+	var bannerIDs, slotIDs, socialGroupIDs []string
+	for _, description := range descriptions {
+		bannerID, err := s.storage.CreateBanner(s.ctx, description, app.Creative{}, app.Flight{})
+		s.Require().NoError(err)
+		bannerIDs = append(bannerIDs, bannerID)
+		slotID, err := s.storage.CreateSlot(s.ctx, description, app.StrategyParams{Name: "ucb1"}, app.SlotFormat{})
+		s.Require().NoError(err)
+		slotIDs = append(slotIDs, slotID)
+		socialGroupID, err := s.storage.CreateSocialGroup(s.ctx, description)
+		s.Require().NoError(err)
+		socialGroupIDs = append(socialGroupIDs, socialGroupID)
+	}
+	sort.Strings(bannerIDs)
+	sort.Strings(slotIDs)
+	sort.Strings(socialGroupIDs)
+	tests := map[string]struct {
+		filter app.ListFilter
+		// afterSecond sets the AfterID of the filter to the second id.
+		afterSecond  bool
+		wantIndexes  []int
+		wantContains string
+	}{
+		"all":       {filter: app.ListFilter{}, wantIndexes: []int{0, 1, 2, 3}},
+		"limit":     {filter: app.ListFilter{Limit: 2}, wantIndexes: []int{0, 1}},
+		"after id":  {filter: app.ListFilter{Limit: 2}, afterSecond: true, wantIndexes: []int{2, 3}},
+		"too large": {filter: app.ListFilter{Limit: 10}, wantIndexes: []int{0, 1, 2, 3}},
+		"contains":  {filter: app.ListFilter{DescriptionContains: "red"}, wantContains: "red"},
+		"missing":   {filter: app.ListFilter{DescriptionContains: "yellow"}},
+	}
+	for name, tt := range tests {
+		s.Run(name, func() {
+			bannersFilter, slotsFilter, socialGroupsFilter := tt.filter, tt.filter, tt.filter
+			if tt.afterSecond {
+				bannersFilter.AfterID, slotsFilter.AfterID, socialGroupsFilter.AfterID =
+					bannerIDs[1], slotIDs[1], socialGroupIDs[1]
+			}
+			banners, err := s.storage.ListBanners(s.ctx, bannersFilter)
+			s.Require().NoError(err)
+			slots, err := s.storage.ListSlots(s.ctx, slotsFilter)
+			s.Require().NoError(err)
+			socialGroups, err := s.storage.ListSocialGroups(s.ctx, socialGroupsFilter)
+			s.Require().NoError(err)
+
+			var gotBannerIDs, gotSlotIDs, gotSocialGroupIDs []string
+			for _, banner := range banners {
+				gotBannerIDs = append(gotBannerIDs, banner.ID)
+				s.Require().Contains(banner.Description, tt.wantContains)
+			}
+			for _, slot := range slots {
+				gotSlotIDs = append(gotSlotIDs, slot.ID)
+				s.Require().Contains(slot.Description, tt.wantContains)
+				s.Require().Equal(app.StrategyParams{Name: "ucb1"}, slot.Strategy)
+			}
+			for _, socialGroup := range socialGroups {
+				gotSocialGroupIDs = append(gotSocialGroupIDs, socialGroup.ID)
+				s.Require().Contains(socialGroup.Description, tt.wantContains)
+			}
+			if tt.wantContains != "" {
+				s.Require().Len(gotBannerIDs, 2)
+				s.Require().True(sort.StringsAreSorted(gotBannerIDs))
+				s.Require().Len(gotSlotIDs, 2)
+				s.Require().Len(gotSocialGroupIDs, 2)
+				return
+			}
+			s.Require().Equal(pickIDs(bannerIDs, tt.wantIndexes), gotBannerIDs)
+			s.Require().Equal(pickIDs(slotIDs, tt.wantIndexes), gotSlotIDs)
+			s.Require().Equal(pickIDs(socialGroupIDs, tt.wantIndexes), gotSocialGroupIDs)
+		})
+	}
+}
+
+func (s *Suite) Test_ListSlotBanners_ListBannerSlots() {
+	slotIDs := []string{s.seedSlot(), s.seedSlot(), s.seedSlot()}
+	sort.Strings(slotIDs)
+	bannerIDs := []string{s.seedBanner(), s.seedBanner(), s.seedBanner()}
+	sort.Strings(bannerIDs)
+	attachedAfter := time.Now().Add(-time.Second)
+	for _, bannerID := range bannerIDs {
+		s.Require().NoError(s.storage.AttachBanner(s.ctx, slotIDs[0], bannerID))
+	}
+	for _, slotID := range slotIDs[1:] {
+		s.Require().NoError(s.storage.AttachBanner(s.ctx, slotID, bannerIDs[0]))
+	}
+	s.Require().NoError(s.storage.DetachBanner(s.ctx, slotIDs[1], bannerIDs[0]))
+	attachedBefore := time.Now().Add(time.Second)
+
+	slotBanners, err := s.storage.ListSlotBanners(s.ctx, slotIDs[0], app.ListFilter{})
+	s.Require().NoError(err)
+	s.Require().Len(slotBanners, 3)
+	for i, attachment := range slotBanners {
+		s.Require().Equal(slotIDs[0], attachment.SlotID)
+		s.Require().Equal(bannerIDs[i], attachment.BannerID)
+		s.Require().True(attachment.AttachedAt.After(attachedAfter) && attachment.AttachedAt.Before(attachedBefore),
+			"attached at %s", attachment.AttachedAt)
+	}
+	page, err := s.storage.ListSlotBanners(s.ctx, slotIDs[0], app.ListFilter{AfterID: bannerIDs[0], Limit: 1})
+	s.Require().NoError(err)
+	s.Require().Equal(slotBanners[1:2], page)
+
+	bannerSlots, err := s.storage.ListBannerSlots(s.ctx, bannerIDs[0], app.ListFilter{})
+	s.Require().NoError(err)
+	s.Require().Len(bannerSlots, 2, "the detached slot must not be listed")
+	s.Require().Equal(slotBanners[0], bannerSlots[0])
+	s.Require().Equal(slotIDs[2], bannerSlots[1].SlotID)
+	page, err = s.storage.ListBannerSlots(s.ctx, bannerIDs[0], app.ListFilter{AfterID: slotIDs[0], Limit: 1})
+	s.Require().NoError(err)
+	s.Require().Equal(bannerSlots[1:], page)
+
+	bannerSlots, err = s.storage.ListBannerSlots(s.ctx, bannerIDs[1], app.ListFilter{AfterID: slotIDs[0]})
+	s.Require().NoError(err)
+	s.Require().Empty(bannerSlots)
+}
+
+func (s *Suite) Test_ListSlotBanners_ListBannerSlots_Error_NotFound() {
+	var errNotFound *app.ErrNotFound
+	_, err := s.storage.ListSlotBanners(s.ctx, "unknown", app.ListFilter{})
+	s.Require().ErrorAs(err, &errNotFound)
+	_, err = s.storage.ListBannerSlots(s.ctx, "unknown", app.ListFilter{})
+	s.Require().ErrorAs(err, &errNotFound)
+}
+
+func (s *Suite) Test_DeleteSlot() {
+	slotID := s.seedSlot()
+
+	s.Require().NoError(s.storage.DeleteSlot(s.ctx, slotID))
+
+	_, err := s.storage.GetSlot(s.ctx, slotID)
+	var errNotFound *app.ErrNotFound
+	s.Require().ErrorAs(err, &errNotFound)
+	s.Require().NoError(s.storage.DeleteSlot(s.ctx, slotID), "deletion of a missing slot must be silent")
+}
+
+func (s *Suite) Test_DeleteSocialGroup() {
+	slotID := s.seedSlot()
+	bannerID := s.seedBanner()
+	s.Require().NoError(s.storage.AttachBanner(s.ctx, slotID, bannerID))
+	socialGroupID := s.seedSocialGroup()
+
+	s.Require().NoError(s.storage.DeleteSocialGroup(s.ctx, socialGroupID))
+
+	var errNotFound *app.ErrNotFound
+	s.Require().ErrorAs(s.storage.RegisterSelect(s.ctx, slotID, bannerID, socialGroupID), &errNotFound)
+	s.Require().NoError(s.storage.DeleteSocialGroup(s.ctx, socialGroupID), "deletion of a missing group must be silent")
+}
+
+func (s *Suite) Test_DeleteBanner_Cascade() {
+	slotID := s.seedSlot()
+	otherSlotID := s.seedSlot()
+	bannerIDs := []string{s.seedBanner(), s.seedBanner()}
+	for _, bannerID := range bannerIDs {
+		s.Require().NoError(s.storage.AttachBanner(s.ctx, slotID, bannerID))
+	}
+	s.Require().NoError(s.storage.AttachBanner(s.ctx, otherSlotID, bannerIDs[1]))
+	socialGroupID := s.seedSocialGroup()
+	s.register(slotID, bannerIDs[0], socialGroupID, 2, 1)
+	s.register(slotID, bannerIDs[1], socialGroupID, 3, 1)
+	s.register(otherSlotID, bannerIDs[1], socialGroupID, 1, 1)
+	s.Require().NoError(s.storage.RegisterLinearSelect(s.ctx, slotID, bannerIDs[1], []float64{1}))
+
+	s.Require().NoError(s.storage.DeleteBanner(s.ctx, bannerIDs[1]))
+
+	expected := app.SlotStats{
+		Banners: []app.BannerStats{
+			{BannerID: bannerIDs[0], Selects: 2, Clicks: 1, PooledSelects: 2, PooledClicks: 1},
+		},
+		TotalSelects:       2,
+		PooledTotalSelects: 2,
+	}
+	stats, err := s.storage.GetSlotStats(s.ctx, slotID, socialGroupID)
+	s.Require().NoError(err)
+	s.Require().Equal(expected, stats, "the selects of a deleted banner must be removed from the totals")
+	history, err := s.storage.GetSlotStatsHistory(s.ctx, slotID, socialGroupID, time.Now())
+	s.Require().NoError(err)
+	s.Require().Len(history, 1)
+	s.Require().Equal(expected, history[0].Stats)
+	linearStats, err := s.storage.GetLinearStats(s.ctx, slotID, 1)
+	s.Require().NoError(err)
+	s.Require().Equal([]app.LinearStats{{BannerID: bannerIDs[0], A: []float64{0}, B: []float64{0}}}, linearStats)
+	_, err = s.storage.GetSlotStats(s.ctx, otherSlotID, socialGroupID)
+	s.Require().ErrorIs(err, app.ErrNoBannersFound, "a deleted banner must be detached from all slots")
+}
+
+func (s *Suite) Test_DeleteSlot_Cascade() {
+	slotID := s.seedSlot()
+	otherSlotID := s.seedSlot()
+	bannerID := s.seedBanner()
+	s.Require().NoError(s.storage.AttachBanner(s.ctx, slotID, bannerID))
+	s.Require().NoError(s.storage.AttachBanner(s.ctx, otherSlotID, bannerID))
+	socialGroupID := s.seedSocialGroup()
+	s.register(slotID, bannerID, socialGroupID, 3, 2)
+	s.register(otherSlotID, bannerID, socialGroupID, 1, 1)
+
+	s.Require().NoError(s.storage.DeleteSlot(s.ctx, slotID))
+
+	stats, err := s.storage.GetSlotStats(s.ctx, otherSlotID, socialGroupID)
+	s.Require().NoError(err)
+	s.Require().Equal(app.SlotStats{
+		Banners:            []app.BannerStats{{BannerID: bannerID, Selects: 1, Clicks: 1, PooledSelects: 1, PooledClicks: 1}},
+		TotalSelects:       1,
+		PooledTotalSelects: 1,
+	}, stats, "the counters of a deleted slot must be removed from the other selects and clicks")
+	var errNotFound *app.ErrNotFound
+	_, err = s.storage.GetLinearStats(s.ctx, slotID, 1)
+	s.Require().ErrorAs(err, &errNotFound)
+}
+
+func (s *Suite) Test_DeleteSocialGroup_Cascade() {
+	slotID := s.seedSlot()
+	bannerID := s.seedBanner()
+	s.Require().NoError(s.storage.AttachBanner(s.ctx, slotID, bannerID))
+	socialGroupID := s.seedSocialGroup()
+	otherSocialGroupID := s.seedSocialGroup()
+	s.register(slotID, bannerID, socialGroupID, 3, 1)
+	s.register(slotID, bannerID, otherSocialGroupID, 2, 2)
+
+	s.Require().NoError(s.storage.DeleteSocialGroup(s.ctx, socialGroupID))
+
+	expected := app.SlotStats{
+		Banners:            []app.BannerStats{{BannerID: bannerID, Selects: 2, Clicks: 2, PooledSelects: 2, PooledClicks: 2}},
+		TotalSelects:       2,
+		PooledTotalSelects: 2,
+	}
+	stats, err := s.storage.GetSlotStats(s.ctx, slotID, otherSocialGroupID)
+	s.Require().NoError(err)
+	s.Require().Equal(expected, stats, "the counters of a deleted group must be removed from the pooled counters")
+	history, err := s.storage.GetSlotStatsHistory(s.ctx, slotID, otherSocialGroupID, time.Now())
+	s.Require().NoError(err)
+	s.Require().Len(history, 1)
+	s.Require().Equal(expected, history[0].Stats)
+}
+
+func (s *Suite) Test_Delete_Restrict() {
+	s.storage = s.newStorage(s.T(), app.DeleteModeRestrict)
+	slotID := s.seedSlot()
+	bannerID := s.seedBanner()
+	s.Require().NoError(s.storage.AttachBanner(s.ctx, slotID, bannerID))
+	socialGroupID := s.seedSocialGroup()
+	s.register(slotID, bannerID, socialGroupID, 1, 0)
+	unusedSocialGroupID := s.seedSocialGroup()
+
+	var errInUse *app.ErrInUse
+	s.Require().ErrorAs(s.storage.DeleteSlot(s.ctx, slotID), &errInUse)
+	s.Require().ErrorAs(s.storage.DeleteBanner(s.ctx, bannerID), &errInUse)
+	s.Require().ErrorAs(s.storage.DeleteSocialGroup(s.ctx, socialGroupID), &errInUse)
+	s.Require().NoError(s.storage.DeleteSocialGroup(s.ctx, unusedSocialGroupID))
+	_, err := s.storage.GetSlotStats(s.ctx, slotID, socialGroupID)
+	s.Require().NoError(err, "a rejected deletion must keep the entities")
+
+	s.Require().NoError(s.storage.DetachBanner(s.ctx, slotID, bannerID))
+	s.Require().NoError(s.storage.DeleteBanner(s.ctx, bannerID))
+	s.Require().NoError(s.storage.DeleteSlot(s.ctx, slotID))
+	s.Require().NoError(s.storage.DeleteSocialGroup(s.ctx, socialGroupID))
+}
+
+func (s *Suite) Test_AttachBanner() {
+	slotID := s.seedSlot()
+	bannerID := s.seedBanner()
+	socialGroupID := s.seedSocialGroup()
+
+	s.Require().NoError(s.storage.AttachBanner(s.ctx, slotID, bannerID))
+	s.Require().NoError(s.storage.AttachBanner(s.ctx, slotID, bannerID), "attachment must be idempotent")
+
+	stats, err := s.storage.GetSlotStats(s.ctx, slotID, socialGroupID)
+	s.Require().NoError(err)
+	s.Require().Equal([]app.BannerStats{{BannerID: bannerID}}, stats.Banners)
+}
+
+func (s *Suite) Test_AttachBanner_Error_NotFound() {
+	slotID := s.seedSlot()
+	bannerID := s.seedBanner()
+
+	var errNotFound *app.ErrNotFound
+	s.Require().ErrorAs(s.storage.AttachBanner(s.ctx, slotID, "unknown"), &errNotFound)
+	s.Require().ErrorAs(s.storage.AttachBanner(s.ctx, "unknown", bannerID), &errNotFound)
+}
+
+func (s *Suite) Test_DetachBanner() {
+	slotID := s.seedSlot()
+	bannerID := s.seedBanner()
+	socialGroupID := s.seedSocialGroup()
+	s.Require().NoError(s.storage.AttachBanner(s.ctx, slotID, bannerID))
+
+	s.Require().NoError(s.storage.DetachBanner(s.ctx, slotID, bannerID))
+
+	_, err := s.storage.GetSlotStats(s.ctx, slotID, socialGroupID)
+	s.Require().ErrorIs(err, app.ErrNoBannersFound)
+}
+
+func (s *Suite) Test_AttachDetachBanner_SocialGroupWithCounters() {
+	slotID := s.seedSlot()
+	bannerIDs := []string{s.seedBanner(), s.seedBanner()}
+	s.Require().NoError(s.storage.AttachBanner(s.ctx, slotID, bannerIDs[0]))
+	socialGroupID := s.seedSocialGroup()
+	s.register(slotID, bannerIDs[0], socialGroupID, 1, 0)
+
+	s.Require().NoError(s.storage.AttachBanner(s.ctx, slotID, bannerIDs[1]))
+	s.Require().NoError(s.storage.DetachBanner(s.ctx, slotID, bannerIDs[0]))
+
+	stats, err := s.storage.GetSlotStats(s.ctx, slotID, socialGroupID)
+	s.Require().NoError(err)
+	s.Require().Equal([]app.BannerStats{{BannerID: bannerIDs[1]}}, stats.Banners,
+		"the attachments must reach the social groups which already have counters in the slot")
+}
+
+func (s *Suite) Test_DetachBanner_Error() {
+	slotID := s.seedSlot()
+	bannerID := s.seedBanner()
+
+	var errBannerNotAttached *app.ErrBannerNotAttached
+	s.Require().ErrorAs(s.storage.DetachBanner(s.ctx, slotID, bannerID), &errBannerNotAttached)
+	var errNotFound *app.ErrNotFound
+	s.Require().ErrorAs(s.storage.DetachBanner(s.ctx, "unknown", bannerID), &errNotFound)
+	s.Require().ErrorAs(s.storage.DetachBanner(s.ctx, slotID, "unknown"), &errNotFound)
+}
+
+func (s *Suite) Test_GetSlotStats() {
+	slotID := s.seedSlot()
+	otherSlotID := s.seedSlot()
+	bannerIDs := []string{s.seedBanner(), s.seedBanner(), s.seedBanner()}
+	for _, bannerID := range bannerIDs {
+		s.Require().NoError(s.storage.AttachBanner(s.ctx, slotID, bannerID))
+		s.Require().NoError(s.storage.AttachBanner(s.ctx, otherSlotID, bannerID))
+	}
+	socialGroupID := s.seedSocialGroup()
+	otherSocialGroupID := s.seedSocialGroup()
+	s.register(slotID, bannerIDs[0], socialGroupID, 3, 1)
+	s.register(slotID, bannerIDs[0], otherSocialGroupID, 2, 2)
+	s.register(otherSlotID, bannerIDs[0], socialGroupID, 5, 4)
+	s.register(slotID, bannerIDs[1], socialGroupID, 1, 0)
+
+	stats, err := s.storage.GetSlotStats(s.ctx, slotID, socialGroupID)
+
+	s.Require().NoError(err)
+	s.Require().Equal(app.SlotStats{
+		Banners: sortBannerStats([]app.BannerStats{
+			{
+				BannerID:      bannerIDs[0],
+				Selects:       3,
+				Clicks:        1,
+				OtherSelects:  5,
+				OtherClicks:   4,
+				PooledSelects: 5,
+				PooledClicks:  3,
+			},
+			{BannerID: bannerIDs[1], Selects: 1, PooledSelects: 1},
+			{BannerID: bannerIDs[2]},
+		}),
+		TotalSelects:       4,
+		PooledTotalSelects: 6,
+	}, stats)
+}
+
+func (s *Suite) Test_GetSlotStats_DetachedBannerSelects() {
+	slotID := s.seedSlot()
+	bannerIDs := []string{s.seedBanner(), s.seedBanner()}
+	for _, bannerID := range bannerIDs {
+		s.Require().NoError(s.storage.AttachBanner(s.ctx, slotID, bannerID))
+	}
+	socialGroupID := s.seedSocialGroup()
+	s.register(slotID, bannerIDs[0], socialGroupID, 2, 0)
+	s.register(slotID, bannerIDs[1], socialGroupID, 3, 1)
+	s.Require().NoError(s.storage.DetachBanner(s.ctx, slotID, bannerIDs[1]))
+
+	stats, err := s.storage.GetSlotStats(s.ctx, slotID, socialGroupID)
+
+	s.Require().NoError(err)
+	s.Require().Equal([]app.BannerStats{{BannerID: bannerIDs[0], Selects: 2, PooledSelects: 2}}, stats.Banners)
+	s.Require().Equal(float64(5), stats.TotalSelects, "the selects of a detached banner must stay in the total")
+}
+
+func (s *Suite) Test_GetSlotStats_Error() {
+	slotID := s.seedSlot()
+	socialGroupID := s.seedSocialGroup()
+
+	_, err := s.storage.GetSlotStats(s.ctx, slotID, socialGroupID)
+	s.Require().ErrorIs(err, app.ErrNoBannersFound)
+
+	var errNotFound *app.ErrNotFound
+	_, err = s.storage.GetSlotStats(s.ctx, "unknown", socialGroupID)
+	s.Require().ErrorAs(err, &errNotFound)
+	_, err = s.storage.GetSlotStats(s.ctx, slotID, "unknown")
+	s.Require().ErrorAs(err, &errNotFound)
+}
+
+func (s *Suite) Test_GetSlotStatsHistory() {
+	slotID := s.seedSlot()
+	bannerID := s.seedBanner()
+	s.Require().NoError(s.storage.AttachBanner(s.ctx, slotID, bannerID))
+	socialGroupID := s.seedSocialGroup()
+	s.register(slotID, bannerID, socialGroupID, 2, 1)
+	previous := time.Now().Truncate(app.StatsInterval).Add(-app.StatsInterval)
+
+	history, err := s.storage.GetSlotStatsHistory(s.ctx, slotID, socialGroupID, previous)
+
+	s.Require().NoError(err)
+	s.Require().Len(history, 2)
+	s.Require().True(previous.Equal(history[0].Start))
+	s.Require().Equal([]app.BannerStats{{BannerID: bannerID}}, history[0].Stats.Banners)
+	s.Require().Equal(app.SlotStats{
+		Banners: []app.BannerStats{
+			{BannerID: bannerID, Selects: 2, Clicks: 1, PooledSelects: 2, PooledClicks: 1},
+		},
+		TotalSelects:       2,
+		PooledTotalSelects: 2,
+	}, history[1].Stats)
+}
+
+func (s *Suite) Test_GetSlotStatsHistory_Error() {
+	slotID := s.seedSlot()
+	socialGroupID := s.seedSocialGroup()
+
+	_, err := s.storage.GetSlotStatsHistory(s.ctx, slotID, socialGroupID, time.Now())
+	s.Require().ErrorIs(err, app.ErrNoBannersFound)
+
+	var errNotFound *app.ErrNotFound
+	_, err = s.storage.GetSlotStatsHistory(s.ctx, "unknown", socialGroupID, time.Now())
+	s.Require().ErrorAs(err, &errNotFound)
+	_, err = s.storage.GetSlotStatsHistory(s.ctx, slotID, "unknown", time.Now())
+	s.Require().ErrorAs(err, &errNotFound)
+	_, err = s.storage.GetSlotStatsHistory(s.ctx, slotID, socialGroupID, time.Now().Add(time.Hour))
+	s.Require().ErrorIs(err, app.ErrInvalidStatsPeriod)
+}
+
+func (s *Suite) Test_GetSlotStatsHistory_LimitedByMaxStatsHistory() {
+	slotID := s.seedSlot()
+	bannerID := s.seedBanner()
+	s.Require().NoError(s.storage.AttachBanner(s.ctx, slotID, bannerID))
+	socialGroupID := s.seedSocialGroup()
+	oldest := time.Now().Add(-app.MaxStatsHistory).Truncate(app.StatsInterval)
+
+	history, err := s.storage.GetSlotStatsHistory(s.ctx, slotID, socialGroupID, time.Now().Add(-2*app.MaxStatsHistory))
+
+	s.Require().NoError(err)
+	s.Require().Len(history, int(app.MaxStatsHistory/app.StatsInterval)+1)
+	s.Require().False(history[0].Start.Before(oldest))
+}
+
+func (s *Suite) Test_RegisterSelectClick_Error() {
+	slotID := s.seedSlot()
+	bannerID := s.seedBanner()
+	socialGroupID := s.seedSocialGroup()
+
+	for _, register := range []func(ctx context.Context, slotID, bannerID, socialGroupID string) error{
+		s.storage.RegisterSelect,
+		s.storage.RegisterClick,
+	} {
+		var errNotFound *app.ErrNotFound
+		s.Require().ErrorAs(register(s.ctx, "unknown", bannerID, socialGroupID), &errNotFound)
+		s.Require().ErrorAs(register(s.ctx, slotID, "unknown", socialGroupID), &errNotFound)
+		var errBannerNotAttached *app.ErrBannerNotAttached
+		s.Require().ErrorAs(register(s.ctx, slotID, bannerID, socialGroupID), &errBannerNotAttached)
+		s.Require().ErrorAs(register(s.ctx, slotID, bannerID, "unknown"), &errBannerNotAttached,
+			"the attachment must be checked before the social group")
+	}
+	s.Require().NoError(s.storage.AttachBanner(s.ctx, slotID, bannerID))
+	var errNotFound *app.ErrNotFound
+	s.Require().ErrorAs(s.storage.RegisterSelect(s.ctx, slotID, bannerID, "unknown"), &errNotFound)
+	s.Require().ErrorAs(s.storage.RegisterClick(s.ctx, slotID, bannerID, "unknown"), &errNotFound)
+}
+
+func (s *Suite) Test_RegisterSelect_NotCountedOnError() {
+	slotID := s.seedSlot()
+	bannerID := s.seedBanner()
+	s.Require().NoError(s.storage.AttachBanner(s.ctx, slotID, bannerID))
+	socialGroupID := s.seedSocialGroup()
+
+	s.Require().Error(s.storage.RegisterSelect(s.ctx, slotID, bannerID, "unknown"))
+
+	stats, err := s.storage.GetSlotStats(s.ctx, slotID, socialGroupID)
+	s.Require().NoError(err)
+	s.Require().Zero(stats.PooledTotalSelects, "the failed select is registered")
+}
+
+func (s *Suite) Test_GetLinearStats() {
+	slotID := s.seedSlot()
+	bannerIDs := []string{s.seedBanner(), s.seedBanner()}
+	sort.Strings(bannerIDs)
+	for _, bannerID := range bannerIDs {
+		s.Require().NoError(s.storage.AttachBanner(s.ctx, slotID, bannerID))
+	}
+	vector := []float64{1, 0, 2}
+	s.Require().NoError(s.storage.RegisterLinearSelect(s.ctx, slotID, bannerIDs[0], vector))
+	s.Require().NoError(s.storage.RegisterLinearSelect(s.ctx, slotID, bannerIDs[0], vector))
+	s.Require().NoError(s.storage.RegisterLinearClick(s.ctx, slotID, bannerIDs[0], vector))
+
+	stats, err := s.storage.GetLinearStats(s.ctx, slotID, len(vector))
+	s.Require().NoError(err)
+	s.Require().Equal([]app.LinearStats{
+		{BannerID: bannerIDs[0], A: []float64{2, 0, 4, 0, 0, 0, 4, 0, 8}, B: []float64{1, 0, 2}},
+		{BannerID: bannerIDs[1], A: make([]float64, 9), B: make([]float64, 3)},
+	}, stats)
+
+	stats, err = s.storage.GetLinearStats(s.ctx, slotID, 1)
+	s.Require().NoError(err)
+	s.Require().Equal(app.LinearStats{BannerID: bannerIDs[0], A: []float64{2}, B: []float64{1}}, stats[0],
+		"the elements out of the dimension must be ignored")
+}
+
+func (s *Suite) Test_GetLinearStats_Error() {
+	slotID := s.seedSlot()
+
+	_, err := s.storage.GetLinearStats(s.ctx, slotID, 1)
+	s.Require().ErrorIs(err, app.ErrNoBannersFound)
+
+	var errNotFound *app.ErrNotFound
+	_, err = s.storage.GetLinearStats(s.ctx, "unknown", 1)
+	s.Require().ErrorAs(err, &errNotFound)
+}
+
+func (s *Suite) Test_RegisterLinear_Error_BannerNotAttached() {
+	slotID := s.seedSlot()
+	bannerID := s.seedBanner()
+
+	var errBannerNotAttached *app.ErrBannerNotAttached
+	s.Require().ErrorAs(s.storage.RegisterLinearSelect(s.ctx, slotID, bannerID, []float64{1}), &errBannerNotAttached)
+	s.Require().ErrorAs(s.storage.RegisterLinearClick(s.ctx, slotID, bannerID, []float64{1}), &errBannerNotAttached)
+}
+
+func (s *Suite) Test_SelectClickBanner_Concurrent() {
+	slotID := s.seedSlot()
+	bannerID := s.seedBanner()
+	s.Require().NoError(s.storage.AttachBanner(s.ctx, slotID, bannerID))
+	socialGroupID := s.seedSocialGroup()
+	workersCount := 5
+	selectsPerWorker := 20
+
+	wg := sync.WaitGroup{}
+	errs := make(chan error, workersCount*selectsPerWorker*3)
+	for i := 0; i < workersCount; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < selectsPerWorker; j++ {
+				_, err := s.storage.GetSlotStats(s.ctx, slotID, socialGroupID)
+				errs <- err
+				errs <- s.storage.RegisterSelect(s.ctx, slotID, bannerID, socialGroupID)
+				errs <- s.storage.RegisterClick(s.ctx, slotID, bannerID, socialGroupID)
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		s.Require().NoError(err)
+	}
+	stats, err := s.storage.GetSlotStats(s.ctx, slotID, socialGroupID)
+	s.Require().NoError(err)
+	s.Require().Equal(float64(workersCount*selectsPerWorker), stats.TotalSelects)
+	s.Require().Equal(float64(workersCount*selectsPerWorker), stats.Banners[0].Selects)
+	s.Require().Equal(float64(workersCount*selectsPerWorker), stats.Banners[0].Clicks)
+}
+
+func (s *Suite) Test_ExportImportSnapshot() {
+	params := app.StrategyParams{Name: "thompson_sampling", Alpha: 2, Beta: 3, Window: time.Hour}
+	format := app.SlotFormat{Sizes: []app.Size{{Width: 728, Height: 90}}, MIMETypes: []string{"image/*"}}
+	slotID, err := s.storage.CreateSlot(s.ctx, "slot", params, format)
+	s.Require().NoError(err)
+	bannerIDs := []string{s.seedBanner(), s.seedBanner()}
+	sort.Strings(bannerIDs)
+	creative := app.Creative{ImageURL: "https://cdn.example.com/banner.gif", MIMEType: "image/gif", Width: 728, Height: 90,
+		Metadata: map[string]string{"campaign": "autumn"}}
+	flight := app.Flight{Start: time.Unix(time.Now().Unix(), 0), End: time.Unix(time.Now().Add(time.Hour).Unix(), 0)}
+	creativeBannerID, err := s.storage.CreateBanner(s.ctx, "banner with creative", creative, flight)
+	s.Require().NoError(err)
+	socialGroupIDs := []string{s.seedSocialGroup(), s.seedSocialGroup()}
+	for _, bannerID := range bannerIDs {
+		s.Require().NoError(s.storage.AttachBanner(s.ctx, slotID, bannerID))
+	}
+	s.register(slotID, bannerIDs[0], socialGroupIDs[0], 3, 1)
+	s.register(slotID, bannerIDs[1], socialGroupIDs[0], 2, 0)
+	s.register(slotID, bannerIDs[1], socialGroupIDs[1], 1, 1)
+	s.Require().NoError(s.storage.RegisterLinearSelect(s.ctx, slotID, bannerIDs[0], []float64{1, 0, 2}))
+	s.Require().NoError(s.storage.RegisterLinearClick(s.ctx, slotID, bannerIDs[0], []float64{1, 0, 2}))
+	// The counters of a detached banner are kept in the totals.
+	s.Require().NoError(s.storage.DetachBanner(s.ctx, slotID, bannerIDs[1]))
+	since := time.Now().Add(-time.Hour)
+	stats, err := s.storage.GetSlotStats(s.ctx, slotID, socialGroupIDs[0])
+	s.Require().NoError(err)
+	history, err := s.storage.GetSlotStatsHistory(s.ctx, slotID, socialGroupIDs[0], since)
+	s.Require().NoError(err)
+
+	snapshot, err := s.storage.ExportSnapshot(s.ctx)
+
+	s.Require().NoError(err)
+	s.Require().NoError(snapshot.Validate(strategy.NewFactory(strategy.NewConfig(viper.New()))))
+	s.Require().Equal([]app.Slot{{ID: slotID, Description: "slot", Strategy: params, Format: format}}, snapshot.Slots)
+	s.Require().Len(snapshot.Banners, 3)
+	s.Require().Contains(snapshot.Banners,
+		app.Banner{ID: creativeBannerID, Description: "banner with creative", Creative: creative, Flight: flight})
+	s.Require().Len(snapshot.SocialGroups, 2)
+	s.Require().Len(snapshot.Attachments, 1)
+	s.Require().Equal(slotID, snapshot.Attachments[0].SlotID)
+	s.Require().Equal(bannerIDs[0], snapshot.Attachments[0].BannerID)
+	s.Require().WithinDuration(time.Now(), snapshot.Attachments[0].AttachedAt, 2*time.Second)
+	s.Require().Len(snapshot.Counters, 6, "the all-time and the interval counters of every banner and group")
+	s.Require().Equal([]app.LinearModel{{
+		SlotID:   slotID,
+		BannerID: bannerIDs[0],
+		A: []app.LinearElement{
+			{I: 0, J: 0, Value: 1}, {I: 0, J: 2, Value: 2}, {I: 2, J: 0, Value: 2}, {I: 2, J: 2, Value: 4},
+		},
+		B: []app.LinearElement{{I: 0, Value: 1}, {I: 2, Value: 2}},
+	}}, snapshot.LinearModels)
+
+	s.storage = s.newStorage(s.T(), app.DeleteModeCascade)
+	s.Require().NoError(s.storage.ImportSnapshot(s.ctx, snapshot))
+
+	imported, err := s.storage.ExportSnapshot(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(snapshot, imported)
+	importedStats, err := s.storage.GetSlotStats(s.ctx, slotID, socialGroupIDs[0])
+	s.Require().NoError(err)
+	s.Require().Equal(stats, importedStats)
+	importedHistory, err := s.storage.GetSlotStatsHistory(s.ctx, slotID, socialGroupIDs[0], since)
+	s.Require().NoError(err)
+	s.Require().Equal(history, importedHistory)
+}
+
+func (s *Suite) Test_ImportSnapshot_AddsCounters() {
+	slotID := s.seedSlot()
+	bannerID := s.seedBanner()
+	socialGroupID := s.seedSocialGroup()
+	s.Require().NoError(s.storage.AttachBanner(s.ctx, slotID, bannerID))
+	s.register(slotID, bannerID, socialGroupID, 2, 1)
+	snapshot, err := s.storage.ExportSnapshot(s.ctx)
+	s.Require().NoError(err)
+	expired := app.Counter{
+		SlotID:        slotID,
+		SocialGroupID: socialGroupID,
+		BannerID:      bannerID,
+		Start:         time.Now().Add(-app.MaxStatsHistory - 2*app.StatsInterval).Truncate(app.StatsInterval),
+		Selects:       10,
+	}
+	snapshot.Counters = append(snapshot.Counters, expired)
+
+	s.Require().NoError(s.storage.ImportSnapshot(s.ctx, snapshot))
+
+	stats, err := s.storage.GetSlotStats(s.ctx, slotID, socialGroupID)
+	s.Require().NoError(err)
+	s.Require().Equal(app.SlotStats{
+		Banners: []app.BannerStats{
+			{BannerID: bannerID, Selects: 4, Clicks: 2, PooledSelects: 4, PooledClicks: 2},
+		},
+		TotalSelects:       4,
+		PooledTotalSelects: 4,
+	}, stats)
+	imported, err := s.storage.ExportSnapshot(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Len(imported.Counters, 2, "the expired interval counter must be skipped")
+}
+
+func (s *Suite) seedBanner() string {
+	id, err := s.storage.CreateBanner(s.ctx, "banner", app.Creative{}, app.Flight{})
+	s.Require().NoError(err)
+	return id
+}
+
+func (s *Suite) seedSlot() string {
+	id, err := s.storage.CreateSlot(s.ctx, "slot", app.StrategyParams{}, app.SlotFormat{})
+	s.Require().NoError(err)
+	return id
+}
+
+func (s *Suite) seedSocialGroup() string {
+	id, err := s.storage.CreateSocialGroup(s.ctx, "social group")
+	s.Require().NoError(err)
+	return id
+}
+
+func (s *Suite) register(slotID, bannerID, socialGroupID string, selects, clicks int) {
+	for i := 0; i < selects; i++ {
+		s.Require().NoError(s.storage.RegisterSelect(s.ctx, slotID, bannerID, socialGroupID))
+	}
+	for i := 0; i < clicks; i++ {
+		s.Require().NoError(s.storage.RegisterClick(s.ctx, slotID, bannerID, socialGroupID))
+	}
+}
+
+// pickIDs returns the ids at the indexes, nil in case of no indexes.
+func pickIDs(ids []string, indexes []int) []string {
+	var picked []string
+	for _, i := range indexes {
+		picked = append(picked, ids[i])
+	}
+	return picked
+}
+
+// sortBannerStats sorts the stats by banner id as the storages return them.
+func sortBannerStats(stats []app.BannerStats) []app.BannerStats {
+	sort.Slice(stats, func(i, j int) bool {
+		return stats[i].BannerID < stats[j].BannerID
+	})
+	return stats
+}
+
+func float64Ptr(v float64) *float64 {
+	return &v
+}
@@ -19,6 +19,7 @@ import (
 	"google.golang.org/genproto/googleapis/rpc/code"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const (
@@ -106,6 +107,16 @@ func (s *rotatorSuite) Test_DeleteBanner() {
 func (s *rotatorSuite) Test_CreateSlot() {
 	s.createSlot()
 }
+func (s *rotatorSuite) Test_CreateSlot_InvalidStrategy() {
+	resp, err := s.clientGrpc.CreateSlot(s.ctx, &grpcapi.CreateSlotRequest{
+		Description: generateDescription("Slot"),
+		Strategy:    &grpcapi.SlotStrategy{Name: "unknown"},
+	})
+
+	s.Require().NoError(err)
+	s.Require().Equal(code.Code_INVALID_ARGUMENT, resp.GetStatus().GetCode())
+}
+
 func (s *rotatorSuite) Test_DeleteSlot() {
 	id := s.createSlot()
 	s.deleteSlot(id)
@@ -172,6 +183,117 @@ func (s *rotatorSuite) Test_SelectBanner() {
 	s.Require().Equal(bannerID, resp.GetBannerId())
 }
 
+func (s *rotatorSuite) Test_SelectBanner_Creative() {
+	slotID := s.createSlot()
+	groupID := s.createSocialGroup()
+	creative := &grpcapi.Creative{
+		TargetUrl: "https://example.com/landing",
+		ImageUrl:  "https://cdn.example.com/banner.png",
+		Width:     300,
+		Height:    250,
+		MimeType:  "image/png",
+		Metadata:  map[string]string{"campaign": "autumn"},
+	}
+	bannerID := s.createBannerWithCreative(creative)
+	s.attachBanner(slotID, bannerID)
+
+	resp, err := s.clientGrpc.SelectBanner(s.ctx, &grpcapi.SelectBannerRequest{
+		SlotId:        slotID,
+		SocialGroupId: groupID,
+	})
+
+	s.Require().NoError(err)
+	s.Require().Equal(code.Code_OK, resp.GetStatus().GetCode())
+	s.Require().Equal(bannerID, resp.GetBanner().GetId())
+	s.Require().Equal(creative.GetTargetUrl(), resp.GetBanner().GetCreative().GetTargetUrl())
+	s.Require().Equal(creative.GetWidth(), resp.GetBanner().GetCreative().GetWidth())
+	s.Require().Equal(creative.GetMetadata(), resp.GetBanner().GetCreative().GetMetadata())
+}
+
+func (s *rotatorSuite) Test_CreateBanner_InvalidCreative() {
+	resp, err := s.clientGrpc.CreateBanner(s.ctx, &grpcapi.CreateBannerRequest{
+		Description: "Banner with invalid creative",
+		Creative:    &grpcapi.Creative{TargetUrl: "/landing"},
+	})
+
+	s.Require().NoError(err)
+	s.Require().Equal(code.Code_INVALID_ARGUMENT, resp.GetStatus().GetCode())
+}
+
+func (s *rotatorSuite) Test_SelectBanner_Flight() {
+	slotID := s.createSlot()
+	groupID := s.createSocialGroup()
+	now := time.Now()
+	activeBannerID := s.createBannerWithFlight(timestamppb.New(now.Add(-time.Hour)), nil)
+	endedBannerID := s.createBannerWithFlight(timestamppb.New(now.Add(-2*time.Hour)), timestamppb.New(now.Add(-time.Hour)))
+	scheduledBannerID := s.createBannerWithFlight(timestamppb.New(now.Add(time.Hour)), nil)
+	for _, bannerID := range []string{activeBannerID, endedBannerID, scheduledBannerID} {
+		s.attachBanner(slotID, bannerID)
+	}
+
+	for i := 0; i < 10; i++ {
+		resp, err := s.clientGrpc.SelectBanner(s.ctx, &grpcapi.SelectBannerRequest{
+			SlotId:        slotID,
+			SocialGroupId: groupID,
+		})
+		s.Require().NoError(err)
+		s.Require().Equal(code.Code_OK, resp.GetStatus().GetCode())
+		s.Require().Equal(activeBannerID, resp.GetBannerId(), "the banners out of their flights must not be selected")
+	}
+	resp, err := s.clientGrpc.ListSlotBanners(s.ctx, &grpcapi.ListSlotBannersRequest{SlotId: slotID})
+	s.Require().NoError(err)
+	s.Require().Equal(code.Code_OK, resp.GetStatus().GetCode())
+	statuses := make(map[string]grpcapi.AttachmentStatus)
+	for _, attachment := range resp.GetAttachments() {
+		statuses[attachment.GetBannerId()] = attachment.GetStatus()
+	}
+	s.Require().Equal(map[string]grpcapi.AttachmentStatus{
+		activeBannerID:    grpcapi.AttachmentStatus_ATTACHMENT_STATUS_ACTIVE,
+		endedBannerID:     grpcapi.AttachmentStatus_ATTACHMENT_STATUS_ENDED,
+		scheduledBannerID: grpcapi.AttachmentStatus_ATTACHMENT_STATUS_SCHEDULED,
+	}, statuses)
+}
+
+func (s *rotatorSuite) Test_CreateBanner_InvalidFlight() {
+	now := time.Now()
+	resp, err := s.clientGrpc.CreateBanner(s.ctx, &grpcapi.CreateBannerRequest{
+		Description: "Banner with invalid flight",
+		StartTime:   timestamppb.New(now),
+		EndTime:     timestamppb.New(now.Add(-time.Hour)),
+	})
+
+	s.Require().NoError(err)
+	s.Require().Equal(code.Code_INVALID_ARGUMENT, resp.GetStatus().GetCode())
+}
+
+func (s *rotatorSuite) Test_AttachBanner_Format() {
+	slotID := s.createSlotWithFormat(&grpcapi.SlotFormat{
+		Sizes:     []*grpcapi.Size{{Width: 300, Height: 250}},
+		MimeTypes: []string{"image/*"},
+	})
+	fitBannerID := s.createBannerWithCreative(&grpcapi.Creative{Width: 300, Height: 250, MimeType: "image/png"})
+	leaderboardBannerID := s.createBannerWithCreative(&grpcapi.Creative{Width: 728, Height: 90, MimeType: "image/png"})
+
+	s.attachBanner(slotID, fitBannerID)
+	resp, err := s.clientGrpc.AttachBanner(s.ctx, &grpcapi.AttachBannerRequest{
+		SlotId:   slotID,
+		BannerId: leaderboardBannerID,
+	})
+
+	s.Require().NoError(err)
+	s.Require().Equal(code.Code_FAILED_PRECONDITION, resp.GetStatus().GetCode())
+}
+
+func (s *rotatorSuite) Test_CreateSlot_InvalidFormat() {
+	resp, err := s.clientGrpc.CreateSlot(s.ctx, &grpcapi.CreateSlotRequest{
+		Description: "Slot with invalid format",
+		Format:      &grpcapi.SlotFormat{MimeTypes: []string{"image/png; q=1"}},
+	})
+
+	s.Require().NoError(err)
+	s.Require().Equal(code.Code_INVALID_ARGUMENT, resp.GetStatus().GetCode())
+}
+
 func (s *rotatorSuite) Test_AllBannersSelected() {
 	slotID := s.createSlot()
 	groupID := s.createSocialGroup()
@@ -291,8 +413,21 @@ func (s *rotatorSuite) Test_QueueEvents() {
 }
 
 func (s *rotatorSuite) createBanner() string {
-	description := generateDescription("Banner")
-	resp, err := s.clientGrpc.CreateBanner(s.ctx, &grpcapi.CreateBannerRequest{Description: description})
+	return s.createBannerWithCreative(nil)
+}
+
+func (s *rotatorSuite) createBannerWithCreative(creative *grpcapi.Creative) string {
+	return s.createBannerFromRequest(&grpcapi.CreateBannerRequest{Creative: creative})
+}
+
+func (s *rotatorSuite) createBannerWithFlight(startTime, endTime *timestamppb.Timestamp) string {
+	return s.createBannerFromRequest(&grpcapi.CreateBannerRequest{StartTime: startTime, EndTime: endTime})
+}
+
+// createBannerFromRequest creates a banner with a generated description.
+func (s *rotatorSuite) createBannerFromRequest(request *grpcapi.CreateBannerRequest) string {
+	request.Description = generateDescription("Banner")
+	resp, err := s.clientGrpc.CreateBanner(s.ctx, request)
 
 	s.Require().NoError(err, "create banner error")
 	s.Require().NotNil(resp)
@@ -314,8 +449,12 @@ func (s *rotatorSuite) deleteBanner(id string) {
 }
 
 func (s *rotatorSuite) createSlot() string {
+	return s.createSlotWithFormat(nil)
+}
+
+func (s *rotatorSuite) createSlotWithFormat(format *grpcapi.SlotFormat) string {
 	description := generateDescription("Slot")
-	resp, err := s.clientGrpc.CreateSlot(s.ctx, &grpcapi.CreateSlotRequest{Description: description})
+	resp, err := s.clientGrpc.CreateSlot(s.ctx, &grpcapi.CreateSlotRequest{Description: description, Format: format})
 
 	s.Require().NoError(err, "create slot error")
 	s.Require().NotNil(resp)
@@ -32,6 +32,17 @@ type RotatorClient interface {
 	DetachBanner(ctx context.Context, in *DetachBannerRequest, opts ...grpc.CallOption) (*DetachBannerResponse, error)
 	ClickBanner(ctx context.Context, in *ClickBannerRequest, opts ...grpc.CallOption) (*ClickBannerResponse, error)
 	SelectBanner(ctx context.Context, in *SelectBannerRequest, opts ...grpc.CallOption) (*SelectBannerResponse, error)
+	GetBanner(ctx context.Context, in *GetBannerRequest, opts ...grpc.CallOption) (*GetBannerResponse, error)
+	ListBanners(ctx context.Context, in *ListBannersRequest, opts ...grpc.CallOption) (*ListBannersResponse, error)
+	UpdateBanner(ctx context.Context, in *UpdateBannerRequest, opts ...grpc.CallOption) (*UpdateBannerResponse, error)
+	GetSlot(ctx context.Context, in *GetSlotRequest, opts ...grpc.CallOption) (*GetSlotResponse, error)
+	ListSlots(ctx context.Context, in *ListSlotsRequest, opts ...grpc.CallOption) (*ListSlotsResponse, error)
+	UpdateSlot(ctx context.Context, in *UpdateSlotRequest, opts ...grpc.CallOption) (*UpdateSlotResponse, error)
+	GetSocialGroup(ctx context.Context, in *GetSocialGroupRequest, opts ...grpc.CallOption) (*GetSocialGroupResponse, error)
+	ListSocialGroups(ctx context.Context, in *ListSocialGroupsRequest, opts ...grpc.CallOption) (*ListSocialGroupsResponse, error)
+	UpdateSocialGroup(ctx context.Context, in *UpdateSocialGroupRequest, opts ...grpc.CallOption) (*UpdateSocialGroupResponse, error)
+	ListSlotBanners(ctx context.Context, in *ListSlotBannersRequest, opts ...grpc.CallOption) (*ListSlotBannersResponse, error)
+	ListBannerSlots(ctx context.Context, in *ListBannerSlotsRequest, opts ...grpc.CallOption) (*ListBannerSlotsResponse, error)
 }
 
 type rotatorClient struct {
@@ -132,6 +143,105 @@ func (c *rotatorClient) SelectBanner(ctx context.Context, in *SelectBannerReques
 	return out, nil
 }
 
+func (c *rotatorClient) GetBanner(ctx context.Context, in *GetBannerRequest, opts ...grpc.CallOption) (*GetBannerResponse, error) {
+	out := new(GetBannerResponse)
+	err := c.cc.Invoke(ctx, "/otus.rotator.v1.Rotator/GetBanner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rotatorClient) ListBanners(ctx context.Context, in *ListBannersRequest, opts ...grpc.CallOption) (*ListBannersResponse, error) {
+	out := new(ListBannersResponse)
+	err := c.cc.Invoke(ctx, "/otus.rotator.v1.Rotator/ListBanners", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rotatorClient) UpdateBanner(ctx context.Context, in *UpdateBannerRequest, opts ...grpc.CallOption) (*UpdateBannerResponse, error) {
+	out := new(UpdateBannerResponse)
+	err := c.cc.Invoke(ctx, "/otus.rotator.v1.Rotator/UpdateBanner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rotatorClient) GetSlot(ctx context.Context, in *GetSlotRequest, opts ...grpc.CallOption) (*GetSlotResponse, error) {
+	out := new(GetSlotResponse)
+	err := c.cc.Invoke(ctx, "/otus.rotator.v1.Rotator/GetSlot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rotatorClient) ListSlots(ctx context.Context, in *ListSlotsRequest, opts ...grpc.CallOption) (*ListSlotsResponse, error) {
+	out := new(ListSlotsResponse)
+	err := c.cc.Invoke(ctx, "/otus.rotator.v1.Rotator/ListSlots", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rotatorClient) UpdateSlot(ctx context.Context, in *UpdateSlotRequest, opts ...grpc.CallOption) (*UpdateSlotResponse, error) {
+	out := new(UpdateSlotResponse)
+	err := c.cc.Invoke(ctx, "/otus.rotator.v1.Rotator/UpdateSlot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rotatorClient) GetSocialGroup(ctx context.Context, in *GetSocialGroupRequest, opts ...grpc.CallOption) (*GetSocialGroupResponse, error) {
+	out := new(GetSocialGroupResponse)
+	err := c.cc.Invoke(ctx, "/otus.rotator.v1.Rotator/GetSocialGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rotatorClient) ListSocialGroups(ctx context.Context, in *ListSocialGroupsRequest, opts ...grpc.CallOption) (*ListSocialGroupsResponse, error) {
+	out := new(ListSocialGroupsResponse)
+	err := c.cc.Invoke(ctx, "/otus.rotator.v1.Rotator/ListSocialGroups", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rotatorClient) UpdateSocialGroup(ctx context.Context, in *UpdateSocialGroupRequest, opts ...grpc.CallOption) (*UpdateSocialGroupResponse, error) {
+	out := new(UpdateSocialGroupResponse)
+	err := c.cc.Invoke(ctx, "/otus.rotator.v1.Rotator/UpdateSocialGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rotatorClient) ListSlotBanners(ctx context.Context, in *ListSlotBannersRequest, opts ...grpc.CallOption) (*ListSlotBannersResponse, error) {
+	out := new(ListSlotBannersResponse)
+	err := c.cc.Invoke(ctx, "/otus.rotator.v1.Rotator/ListSlotBanners", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rotatorClient) ListBannerSlots(ctx context.Context, in *ListBannerSlotsRequest, opts ...grpc.CallOption) (*ListBannerSlotsResponse, error) {
+	out := new(ListBannerSlotsResponse)
+	err := c.cc.Invoke(ctx, "/otus.rotator.v1.Rotator/ListBannerSlots", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RotatorServer is the server API for Rotator service.
 // All implementations must embed UnimplementedRotatorServer
 // for forward compatibility
@@ -146,6 +256,17 @@ type RotatorServer interface {
 	DetachBanner(context.Context, *DetachBannerRequest) (*DetachBannerResponse, error)
 	ClickBanner(context.Context, *ClickBannerRequest) (*ClickBannerResponse, error)
 	SelectBanner(context.Context, *SelectBannerRequest) (*SelectBannerResponse, error)
+	GetBanner(context.Context, *GetBannerRequest) (*GetBannerResponse, error)
+	ListBanners(context.Context, *ListBannersRequest) (*ListBannersResponse, error)
+	UpdateBanner(context.Context, *UpdateBannerRequest) (*UpdateBannerResponse, error)
+	GetSlot(context.Context, *GetSlotRequest) (*GetSlotResponse, error)
+	ListSlots(context.Context, *ListSlotsRequest) (*ListSlotsResponse, error)
+	UpdateSlot(context.Context, *UpdateSlotRequest) (*UpdateSlotResponse, error)
+	GetSocialGroup(context.Context, *GetSocialGroupRequest) (*GetSocialGroupResponse, error)
+	ListSocialGroups(context.Context, *ListSocialGroupsRequest) (*ListSocialGroupsResponse, error)
+	UpdateSocialGroup(context.Context, *UpdateSocialGroupRequest) (*UpdateSocialGroupResponse, error)
+	ListSlotBanners(context.Context, *ListSlotBannersRequest) (*ListSlotBannersResponse, error)
+	ListBannerSlots(context.Context, *ListBannerSlotsRequest) (*ListBannerSlotsResponse, error)
 	mustEmbedUnimplementedRotatorServer()
 }
 
@@ -183,6 +304,39 @@ func (UnimplementedRotatorServer) ClickBanner(context.Context, *ClickBannerReque
 func (UnimplementedRotatorServer) SelectBanner(context.Context, *SelectBannerRequest) (*SelectBannerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SelectBanner not implemented")
 }
+func (UnimplementedRotatorServer) GetBanner(context.Context, *GetBannerRequest) (*GetBannerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBanner not implemented")
+}
+func (UnimplementedRotatorServer) ListBanners(context.Context, *ListBannersRequest) (*ListBannersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBanners not implemented")
+}
+func (UnimplementedRotatorServer) UpdateBanner(context.Context, *UpdateBannerRequest) (*UpdateBannerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBanner not implemented")
+}
+func (UnimplementedRotatorServer) GetSlot(context.Context, *GetSlotRequest) (*GetSlotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSlot not implemented")
+}
+func (UnimplementedRotatorServer) ListSlots(context.Context, *ListSlotsRequest) (*ListSlotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSlots not implemented")
+}
+func (UnimplementedRotatorServer) UpdateSlot(context.Context, *UpdateSlotRequest) (*UpdateSlotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSlot not implemented")
+}
+func (UnimplementedRotatorServer) GetSocialGroup(context.Context, *GetSocialGroupRequest) (*GetSocialGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSocialGroup not implemented")
+}
+func (UnimplementedRotatorServer) ListSocialGroups(context.Context, *ListSocialGroupsRequest) (*ListSocialGroupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSocialGroups not implemented")
+}
+func (UnimplementedRotatorServer) UpdateSocialGroup(context.Context, *UpdateSocialGroupRequest) (*UpdateSocialGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSocialGroup not implemented")
+}
+func (UnimplementedRotatorServer) ListSlotBanners(context.Context, *ListSlotBannersRequest) (*ListSlotBannersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSlotBanners not implemented")
+}
+func (UnimplementedRotatorServer) ListBannerSlots(context.Context, *ListBannerSlotsRequest) (*ListBannerSlotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBannerSlots not implemented")
+}
 func (UnimplementedRotatorServer) mustEmbedUnimplementedRotatorServer() {}
 
 // UnsafeRotatorServer may be embedded to opt out of forward compatibility for this service.
@@ -376,6 +530,204 @@ func _Rotator_SelectBanner_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Rotator_GetBanner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBannerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RotatorServer).GetBanner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/otus.rotator.v1.Rotator/GetBanner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RotatorServer).GetBanner(ctx, req.(*GetBannerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Rotator_ListBanners_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBannersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RotatorServer).ListBanners(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/otus.rotator.v1.Rotator/ListBanners",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RotatorServer).ListBanners(ctx, req.(*ListBannersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Rotator_UpdateBanner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBannerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RotatorServer).UpdateBanner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/otus.rotator.v1.Rotator/UpdateBanner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RotatorServer).UpdateBanner(ctx, req.(*UpdateBannerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Rotator_GetSlot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSlotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RotatorServer).GetSlot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/otus.rotator.v1.Rotator/GetSlot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RotatorServer).GetSlot(ctx, req.(*GetSlotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Rotator_ListSlots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSlotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RotatorServer).ListSlots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/otus.rotator.v1.Rotator/ListSlots",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RotatorServer).ListSlots(ctx, req.(*ListSlotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Rotator_UpdateSlot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSlotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RotatorServer).UpdateSlot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/otus.rotator.v1.Rotator/UpdateSlot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RotatorServer).UpdateSlot(ctx, req.(*UpdateSlotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Rotator_GetSocialGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSocialGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RotatorServer).GetSocialGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/otus.rotator.v1.Rotator/GetSocialGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RotatorServer).GetSocialGroup(ctx, req.(*GetSocialGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Rotator_ListSocialGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSocialGroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RotatorServer).ListSocialGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/otus.rotator.v1.Rotator/ListSocialGroups",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RotatorServer).ListSocialGroups(ctx, req.(*ListSocialGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Rotator_UpdateSocialGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSocialGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RotatorServer).UpdateSocialGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/otus.rotator.v1.Rotator/UpdateSocialGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RotatorServer).UpdateSocialGroup(ctx, req.(*UpdateSocialGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Rotator_ListSlotBanners_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSlotBannersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RotatorServer).ListSlotBanners(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/otus.rotator.v1.Rotator/ListSlotBanners",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RotatorServer).ListSlotBanners(ctx, req.(*ListSlotBannersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Rotator_ListBannerSlots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBannerSlotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RotatorServer).ListBannerSlots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/otus.rotator.v1.Rotator/ListBannerSlots",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RotatorServer).ListBannerSlots(ctx, req.(*ListBannerSlotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Rotator_ServiceDesc is the grpc.ServiceDesc for Rotator service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -423,6 +775,50 @@ var Rotator_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SelectBanner",
 			Handler:    _Rotator_SelectBanner_Handler,
 		},
+		{
+			MethodName: "GetBanner",
+			Handler:    _Rotator_GetBanner_Handler,
+		},
+		{
+			MethodName: "ListBanners",
+			Handler:    _Rotator_ListBanners_Handler,
+		},
+		{
+			MethodName: "UpdateBanner",
+			Handler:    _Rotator_UpdateBanner_Handler,
+		},
+		{
+			MethodName: "GetSlot",
+			Handler:    _Rotator_GetSlot_Handler,
+		},
+		{
+			MethodName: "ListSlots",
+			Handler:    _Rotator_ListSlots_Handler,
+		},
+		{
+			MethodName: "UpdateSlot",
+			Handler:    _Rotator_UpdateSlot_Handler,
+		},
+		{
+			MethodName: "GetSocialGroup",
+			Handler:    _Rotator_GetSocialGroup_Handler,
+		},
+		{
+			MethodName: "ListSocialGroups",
+			Handler:    _Rotator_ListSocialGroups_Handler,
+		},
+		{
+			MethodName: "UpdateSocialGroup",
+			Handler:    _Rotator_UpdateSocialGroup_Handler,
+		},
+		{
+			MethodName: "ListSlotBanners",
+			Handler:    _Rotator_ListSlotBanners_Handler,
+		},
+		{
+			MethodName: "ListBannerSlots",
+			Handler:    _Rotator_ListBannerSlots_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "v1/rotator.proto",